@@ -0,0 +1,451 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package buildcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/go-paths-helper"
+)
+
+var tr = i18n.Tr
+
+// buildPathPlaceholder replaces the build path in everything that is stored in the
+// cache, this allows objects compiled in a build path to be reused in another one.
+const buildPathPlaceholder = "{build.path}"
+
+// maxManifestEntries is the number of different sets of dependencies remembered
+// for the same source file and command line.
+const maxManifestEntries = 8
+
+// Cache is a content-addressed store of compiled object files shared between builds.
+//
+// Every compilation is identified by the content of the source file and by the
+// command line used to compile it. Since the headers included by the source file
+// are known only after the compilation, the cache keeps, for each compilation, a
+// manifest with the list of dependencies (and their hashes) of the objects
+// previously produced. An object is reused only if all its dependencies are
+// unchanged.
+//
+// The cache is trimmed to its max size removing the least recently used files,
+// the files are touched every time they're reused.
+type Cache struct {
+	dir     *paths.Path
+	remote  *Remote
+	maxSize int64
+
+	hashesMux sync.Mutex
+	hashes    map[string]*fileHash
+}
+
+type fileHash struct {
+	size    int64
+	modTime time.Time
+	hash    string
+}
+
+// Unit describes a single compilation of a source file into an object file
+type Unit struct {
+	// Source is the source file being compiled
+	Source *paths.Path
+	// ObjectFile is the object file produced by the compilation
+	ObjectFile *paths.Path
+	// DepFile is the dependency file (in Makefile format) produced by the compiler
+	DepFile *paths.Path
+	// CommandLine is the full command line used to compile the source file
+	CommandLine []string
+	// BuildPath is the build directory, all the occurrences of this path are made
+	// relative so the cached objects can be reused in other build directories.
+	BuildPath *paths.Path
+}
+
+type manifest struct {
+	Entries []*manifestEntry `json:"entries"`
+}
+
+type manifestEntry struct {
+	Object       string        `json:"object"`
+	Dependencies []*dependency `json:"dependencies"`
+}
+
+type dependency struct {
+	Path string `json:"path"`
+	Hash string `json:"hash"`
+}
+
+// New creates a Cache that stores its data in the given directory.
+func New(dir *paths.Path) *Cache {
+	return &Cache{
+		dir:    dir,
+		hashes: map[string]*fileHash{},
+	}
+}
+
+// SetRemote makes the Cache share its entries with the given Remote
+func (c *Cache) SetRemote(remote *Remote) {
+	c.remote = remote
+}
+
+// SetMaxSize sets the size in bytes the Cache is trimmed to by Trim, 0 means
+// no limit
+func (c *Cache) SetMaxSize(maxSize int64) {
+	c.maxSize = maxSize
+}
+
+// Restore searches the cache for an object file compatible with the given Unit.
+// If found, the object file and the dependency file are copied in the
+// locations specified in the Unit and true is returned.
+// If the Cache has a Remote, it's searched when no compatible object is found
+// locally, the downloaded entries are saved in the local cache.
+func (c *Cache) Restore(unit *Unit) (bool, error) {
+	key, err := c.unitKey(unit)
+	if err != nil {
+		return false, err
+	}
+	m, err := c.loadManifest(key)
+	if err != nil {
+		return false, err
+	}
+	if m != nil {
+		if restored, err := c.restoreFromManifest(unit, key, m, false); restored || err != nil {
+			return restored, err
+		}
+	}
+
+	if c.remote == nil {
+		return false, nil
+	}
+	var remoteManifest manifest
+	if data := c.remote.Get("manifests/" + key + ".json"); data == nil {
+		return false, nil
+	} else if err := json.Unmarshal(data, &remoteManifest); err != nil {
+		// a corrupted manifest is treated as a cache miss
+		return false, nil
+	}
+	return c.restoreFromManifest(unit, key, &remoteManifest, true)
+}
+
+func (c *Cache) restoreFromManifest(unit *Unit, key string, m *manifest, fromRemote bool) (bool, error) {
+	for _, entry := range m.Entries {
+		if !c.dependenciesMatch(unit, entry.Dependencies) {
+			continue
+		}
+		object, err := c.readObject(entry.Object, ".o", fromRemote)
+		if err != nil {
+			// the object may have been removed, try the next one
+			continue
+		}
+		deps, err := c.readObject(entry.Object, ".d", fromRemote)
+		if err != nil {
+			continue
+		}
+		if err := writeFileAtomic(unit.ObjectFile, object); err != nil {
+			return false, err
+		}
+		deps = []byte(strings.Replace(string(deps), buildPathPlaceholder, escapeDepFilePath(unit.BuildPath.String()), -1))
+		if err := writeFileAtomic(unit.DepFile, deps); err != nil {
+			return false, err
+		}
+		if fromRemote {
+			// remember the entry locally, the next builds won't need the Remote
+			if _, err := c.addManifestEntry(key, entry); err != nil {
+				return false, err
+			}
+		} else {
+			// the entry is the most recently used, it's the last to be trimmed
+			touch(c.manifestPath(key), c.objectPath(entry.Object, ".o"), c.objectPath(entry.Object, ".d"))
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// readObject reads an object file (or its dependency file) from the local cache
+// or, if fromRemote is true and it's not available locally, downloads it from
+// the Remote and saves it in the local cache.
+func (c *Cache) readObject(objectKey string, ext string, fromRemote bool) ([]byte, error) {
+	path := c.objectPath(objectKey, ext)
+	data, err := path.ReadFile()
+	if err == nil || !fromRemote {
+		return data, err
+	}
+	data = c.remote.Get("objects/" + objectKey + ext)
+	if data == nil {
+		return nil, err
+	}
+	if err := writeFileAtomic(path, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Store saves in the cache the object file produced by the compilation of the given Unit.
+// The Unit must have been compiled successfully and its dependency file must exist.
+func (c *Cache) Store(unit *Unit) error {
+	key, err := c.unitKey(unit)
+	if err != nil {
+		return err
+	}
+
+	depFileContent, err := unit.DepFile.ReadFile()
+	if err != nil {
+		return err
+	}
+	depFiles, err := ParseDepFile(depFileContent)
+	if err != nil {
+		return err
+	}
+
+	entry := &manifestEntry{}
+	objectKey := sha256.New()
+	objectKey.Write([]byte(key))
+	for _, depFile := range depFiles {
+		hash, err := c.fileHash(paths.New(depFile))
+		if err != nil {
+			return err
+		}
+		dep := &dependency{
+			Path: relativizeBuildPath(depFile, unit.BuildPath),
+			Hash: hash,
+		}
+		entry.Dependencies = append(entry.Dependencies, dep)
+		objectKey.Write([]byte(dep.Path + "\x00" + dep.Hash + "\x00"))
+	}
+	entry.Object = hex.EncodeToString(objectKey.Sum(nil))
+
+	object, err := unit.ObjectFile.ReadFile()
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(c.objectPath(entry.Object, ".o"), object); err != nil {
+		return err
+	}
+	normalizedDepFile := relativizeBuildPath(string(depFileContent), unit.BuildPath)
+	if err := writeFileAtomic(c.objectPath(entry.Object, ".d"), []byte(normalizedDepFile)); err != nil {
+		return err
+	}
+
+	manifestData, err := c.addManifestEntry(key, entry)
+	if err != nil {
+		return err
+	}
+
+	if c.remote != nil && !c.remote.ReadOnly() {
+		c.remote.Put("objects/"+entry.Object+".o", object)
+		c.remote.Put("objects/"+entry.Object+".d", []byte(normalizedDepFile))
+		c.remote.Put("manifests/"+key+".json", manifestData)
+	}
+	return nil
+}
+
+// Trim removes the least recently used files of the cache until its size is
+// below 90% of the max size, if it's over the max size. The manifests and the
+// objects are removed independently: the manifest entries whose objects are
+// missing are skipped by Restore. The files being written by other builds are
+// left alone.
+func (c *Cache) Trim() error {
+	if c.maxSize <= 0 || !c.dir.IsDir() {
+		return nil
+	}
+	files, err := c.dir.ReadDirRecursive()
+	if err != nil {
+		return err
+	}
+	type cacheFile struct {
+		path    *paths.Path
+		size    int64
+		modTime time.Time
+	}
+	cacheFiles := []*cacheFile{}
+	size := int64(0)
+	for _, file := range files {
+		if strings.HasPrefix(file.Base(), "tmp-") {
+			continue
+		}
+		info, err := file.Stat()
+		if err != nil || info.IsDir() {
+			continue
+		}
+		cacheFiles = append(cacheFiles, &cacheFile{path: file, size: info.Size(), modTime: info.ModTime()})
+		size += info.Size()
+	}
+	if size <= c.maxSize {
+		return nil
+	}
+	sort.Slice(cacheFiles, func(i, j int) bool {
+		return cacheFiles[i].modTime.Before(cacheFiles[j].modTime)
+	})
+	target := c.maxSize / 10 * 9
+	for _, file := range cacheFiles {
+		if size <= target {
+			break
+		}
+		if err := file.path.Remove(); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		size -= file.size
+	}
+	return nil
+}
+
+// touch sets the modification time of the given files to now, errors are
+// ignored: a file removed meanwhile is just missing in the next builds
+func touch(files ...*paths.Path) {
+	now := time.Now()
+	for _, file := range files {
+		os.Chtimes(file.String(), now, now)
+	}
+}
+
+// addManifestEntry adds the entry at the top of the manifest of the given key,
+// the oldest entries are dropped. The content of the updated manifest is returned.
+func (c *Cache) addManifestEntry(key string, entry *manifestEntry) ([]byte, error) {
+	m, err := c.loadManifest(key)
+	if err != nil || m == nil {
+		m = &manifest{}
+	}
+	entries := []*manifestEntry{entry}
+	for _, old := range m.Entries {
+		if old.Object != entry.Object && len(entries) < maxManifestEntries {
+			entries = append(entries, old)
+		}
+	}
+	m.Entries = entries
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return data, writeFileAtomic(c.manifestPath(key), data)
+}
+
+// unitKey returns the key that identifies the compilation of the given Unit
+// independently from the dependencies of the source file.
+func (c *Cache) unitKey(unit *Unit) (string, error) {
+	sourceHash, err := c.fileHash(unit.Source)
+	if err != nil {
+		return "", err
+	}
+	key := sha256.New()
+	key.Write([]byte(sourceHash + "\x00"))
+	for _, arg := range unit.CommandLine {
+		key.Write([]byte(relativizeBuildPath(arg, unit.BuildPath) + "\x00"))
+	}
+	return hex.EncodeToString(key.Sum(nil)), nil
+}
+
+func (c *Cache) dependenciesMatch(unit *Unit, deps []*dependency) bool {
+	for _, dep := range deps {
+		hash, err := c.fileHash(paths.New(expandBuildPath(dep.Path, unit.BuildPath)))
+		if err != nil || hash != dep.Hash {
+			return false
+		}
+	}
+	return true
+}
+
+// fileHash returns the SHA-256 of the content of the given file. Hashes are
+// memoized and recomputed only if the size or the modification time of the
+// file changes.
+func (c *Cache) fileHash(file *paths.Path) (string, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	c.hashesMux.Lock()
+	cached, ok := c.hashes[file.String()]
+	c.hashesMux.Unlock()
+	if ok && cached.size == info.Size() && cached.modTime.Equal(info.ModTime()) {
+		return cached.hash, nil
+	}
+
+	data, err := file.ReadFile()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:])
+
+	c.hashesMux.Lock()
+	c.hashes[file.String()] = &fileHash{size: info.Size(), modTime: info.ModTime(), hash: hash}
+	c.hashesMux.Unlock()
+	return hash, nil
+}
+
+func (c *Cache) loadManifest(key string) (*manifest, error) {
+	data, err := c.manifestPath(key).ReadFile()
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var m manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		// a corrupted manifest is treated as a cache miss
+		return nil, nil
+	}
+	return &m, nil
+}
+
+func (c *Cache) manifestPath(key string) *paths.Path {
+	return c.dir.Join("manifests", key[:2], key+".json")
+}
+
+func (c *Cache) objectPath(key string, ext string) *paths.Path {
+	return c.dir.Join("objects", key[:2], key+ext)
+}
+
+// writeFileAtomic writes data to a temporary file and then renames it to the
+// target, so concurrent builds never see a partially written file.
+func writeFileAtomic(target *paths.Path, data []byte) error {
+	if err := target.Parent().MkdirAll(); err != nil {
+		return err
+	}
+	tmp, err := paths.WriteToTempFile(data, target.Parent(), "tmp-"+target.Base())
+	if err != nil {
+		return err
+	}
+	if err := tmp.Rename(target); err != nil {
+		tmp.Remove()
+		return err
+	}
+	return nil
+}
+
+func relativizeBuildPath(s string, buildPath *paths.Path) string {
+	if buildPath == nil {
+		return s
+	}
+	p := buildPath.String()
+	s = strings.Replace(s, escapeDepFilePath(p), buildPathPlaceholder, -1)
+	return strings.Replace(s, p, buildPathPlaceholder, -1)
+}
+
+func expandBuildPath(s string, buildPath *paths.Path) string {
+	if buildPath == nil {
+		return s
+	}
+	return strings.Replace(s, buildPathPlaceholder, buildPath.String(), -1)
+}
@@ -0,0 +1,179 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package buildcache
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDepFile(t *testing.T) {
+	deps, err := ParseDepFile([]byte(
+		"/tmp/build/sketch/sketch.ino.cpp.o: \\\n" +
+			" /tmp/build/sketch/sketch.ino.cpp \\\n" +
+			" /home/user/My\\ Libraries/Servo/src/Servo.h /tmp/build/sketch/a$$b.h\n" +
+			"/home/user/My\\ Libraries/Servo/src/Servo.h:\n"))
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"/tmp/build/sketch/sketch.ino.cpp",
+		"/home/user/My Libraries/Servo/src/Servo.h",
+		"/tmp/build/sketch/a$b.h",
+	}, deps)
+
+	_, err = ParseDepFile([]byte("no target here\n"))
+	require.Error(t, err)
+}
+
+func TestCacheStoreAndRestore(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "buildcache_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	cache := New(tmp.Join("cache"))
+	header := tmp.Join("libraries", "Lib", "lib.h")
+	require.NoError(t, header.Parent().MkdirAll())
+	require.NoError(t, header.WriteFile([]byte("int f();")))
+
+	newUnit := func(buildPath *paths.Path) *Unit {
+		source := buildPath.Join("sketch", "sketch.ino.cpp")
+		require.NoError(t, source.Parent().MkdirAll())
+		require.NoError(t, source.WriteFile([]byte("#include <lib.h>")))
+		return &Unit{
+			Source:      source,
+			ObjectFile:  buildPath.Join("sketch", "sketch.ino.cpp.o"),
+			DepFile:     buildPath.Join("sketch", "sketch.ino.cpp.d"),
+			CommandLine: []string{"gcc", "-c", source.String(), "-o", buildPath.Join("sketch", "sketch.ino.cpp.o").String()},
+			BuildPath:   buildPath,
+		}
+	}
+
+	// Compile in the first build path and store the result
+	build1 := tmp.Join("build 1")
+	unit1 := newUnit(build1)
+	restored, err := cache.Restore(unit1)
+	require.NoError(t, err)
+	require.False(t, restored)
+	require.NoError(t, unit1.ObjectFile.WriteFile([]byte("object")))
+	require.NoError(t, unit1.DepFile.WriteFile([]byte(
+		escapeDepFilePath(unit1.ObjectFile.String())+": \\\n "+
+			escapeDepFilePath(unit1.Source.String())+" \\\n "+
+			header.String()+"\n")))
+	require.NoError(t, cache.Store(unit1))
+
+	// The object is reused in another build path
+	build2 := tmp.Join("build2")
+	unit2 := newUnit(build2)
+	restored, err = cache.Restore(unit2)
+	require.NoError(t, err)
+	require.True(t, restored)
+	object, err := unit2.ObjectFile.ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "object", string(object))
+	deps, err := unit2.DepFile.ReadFile()
+	require.NoError(t, err)
+	parsedDeps, err := ParseDepFile(deps)
+	require.NoError(t, err)
+	require.Equal(t, []string{unit2.Source.String(), header.String()}, parsedDeps)
+
+	// A change in the command line is a cache miss
+	build3 := tmp.Join("build3")
+	unit3 := newUnit(build3)
+	unit3.CommandLine = append(unit3.CommandLine, "-DNEW_DEFINE")
+	restored, err = cache.Restore(unit3)
+	require.NoError(t, err)
+	require.False(t, restored)
+
+	// A change in an included header is a cache miss
+	require.NoError(t, header.WriteFile([]byte("int f(int);")))
+	unit3 = newUnit(build3)
+	restored, err = cache.Restore(unit3)
+	require.NoError(t, err)
+	require.False(t, restored)
+}
+
+func TestCacheTrim(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "buildcache_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	cacheDir := tmp.Join("cache")
+	cache := New(cacheDir)
+	buildPath := tmp.Join("build")
+	newUnit := func(name string) *Unit {
+		source := buildPath.Join("sketch", name+".cpp")
+		require.NoError(t, source.Parent().MkdirAll())
+		require.NoError(t, source.WriteFile([]byte("int "+name+";")))
+		return &Unit{
+			Source:      source,
+			ObjectFile:  buildPath.Join("sketch", name+".cpp.o"),
+			DepFile:     buildPath.Join("sketch", name+".cpp.d"),
+			CommandLine: []string{"gcc", "-c", source.String()},
+			BuildPath:   buildPath,
+		}
+	}
+	store := func(unit *Unit) {
+		require.NoError(t, unit.ObjectFile.WriteFile(make([]byte, 1000)))
+		require.NoError(t, unit.DepFile.WriteFile([]byte(
+			escapeDepFilePath(unit.ObjectFile.String())+": "+escapeDepFilePath(unit.Source.String())+"\n")))
+		require.NoError(t, cache.Store(unit))
+	}
+	cacheSize := func() int64 {
+		files, err := cacheDir.ReadDirRecursive()
+		require.NoError(t, err)
+		size := int64(0)
+		for _, file := range files {
+			if info, err := file.Stat(); err == nil && !info.IsDir() {
+				size += info.Size()
+			}
+		}
+		return size
+	}
+
+	recent, old := newUnit("recent"), newUnit("old")
+	store(recent)
+	store(old)
+	files, err := cacheDir.ReadDirRecursive()
+	require.NoError(t, err)
+	hourAgo := time.Now().Add(-time.Hour)
+	for _, file := range files {
+		require.NoError(t, os.Chtimes(file.String(), hourAgo, hourAgo))
+	}
+
+	// Without a max size, or below it, nothing is removed
+	size := cacheSize()
+	require.NoError(t, cache.Trim())
+	cache.SetMaxSize(size)
+	require.NoError(t, cache.Trim())
+	require.Equal(t, size, cacheSize())
+
+	// The reused objects are the last to be removed
+	restored, err := cache.Restore(recent)
+	require.NoError(t, err)
+	require.True(t, restored)
+	cache.SetMaxSize(size - 1)
+	require.NoError(t, cache.Trim())
+	require.LessOrEqual(t, cacheSize(), (size-1)/10*9)
+	restored, err = cache.Restore(old)
+	require.NoError(t, err)
+	require.False(t, restored)
+	restored, err = cache.Restore(recent)
+	require.NoError(t, err)
+	require.True(t, restored)
+}
@@ -0,0 +1,93 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package buildcache
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseDepFile parses a dependency file in Makefile format, as produced by
+// gcc with the -MMD flag, and returns the list of prerequisites of all the
+// rules found in the file.
+func ParseDepFile(data []byte) ([]string, error) {
+	content := strings.Replace(string(data), "\r\n", "\n", -1)
+	content = strings.Replace(content, "\\\n", " ", -1)
+
+	res := []string{}
+	seen := map[string]bool{}
+	for _, line := range strings.Split(content, "\n") {
+		tokens := splitDepFileLine(line)
+		if len(tokens) == 0 {
+			continue
+		}
+		targetsEnd := -1
+		for i, token := range tokens {
+			if strings.HasSuffix(token, ":") {
+				targetsEnd = i
+				break
+			}
+		}
+		if targetsEnd == -1 {
+			return nil, fmt.Errorf(tr("invalid dependency file: missing target in line '%s'"), line)
+		}
+		for _, prerequisite := range tokens[targetsEnd+1:] {
+			if !seen[prerequisite] {
+				seen[prerequisite] = true
+				res = append(res, prerequisite)
+			}
+		}
+	}
+	return res, nil
+}
+
+// splitDepFileLine splits a line of a dependency file into tokens, taking
+// care of the escaping done by the compiler.
+func splitDepFileLine(line string) []string {
+	tokens := []string{}
+	current := ""
+	flush := func() {
+		if current != "" {
+			tokens = append(tokens, current)
+			current = ""
+		}
+	}
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case c == '\\' && i+1 < len(line) && (line[i+1] == ' ' || line[i+1] == '\t' || line[i+1] == '#'):
+			current += string(line[i+1])
+			i++
+		case c == '$' && i+1 < len(line) && line[i+1] == '$':
+			current += "$"
+			i++
+		case c == ' ' || c == '\t':
+			flush()
+		default:
+			current += string(c)
+		}
+	}
+	flush()
+	return tokens
+}
+
+// escapeDepFilePath escapes a path the same way the compiler does when
+// writing it in a dependency file.
+func escapeDepFilePath(path string) string {
+	path = strings.Replace(path, "$", "$$", -1)
+	path = strings.Replace(path, "#", "\\#", -1)
+	return strings.Replace(path, " ", "\\ ", -1)
+}
@@ -0,0 +1,122 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package buildcache
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/sirupsen/logrus"
+)
+
+// remoteTimeout is the maximum time allowed for a single request to the remote cache
+const remoteTimeout = 30 * time.Second
+
+// Remote is a build cache shared through an HTTP server implementing a simple
+// key/value protocol: an entry is read with `GET <url>/<key>` and written with
+// `PUT <url>/<key>`. Keys are content hashes, so entries never change once written.
+//
+// A Remote never makes a build fail: if the server is unreachable or replies with
+// an error, the entry is treated as missing and, after the first network error,
+// the Remote is disabled for the rest of the build to avoid waiting for a timeout
+// on every object file.
+type Remote struct {
+	url      string
+	readOnly bool
+	client   *http.Client
+	disabled int32
+}
+
+// NewRemote creates a Remote for the cache server at the given URL. If readOnly
+// is true the entries are only downloaded from the server and never uploaded.
+func NewRemote(remoteURL string, readOnly bool) (*Remote, error) {
+	u, err := url.Parse(remoteURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, &arduino.InvalidURLError{Cause: errors.New(tr("the remote build cache URL must be http or https: %s", remoteURL))}
+	}
+	return &Remote{
+		url:      strings.TrimSuffix(u.String(), "/"),
+		readOnly: readOnly,
+		client:   &http.Client{Timeout: remoteTimeout},
+	}, nil
+}
+
+// ReadOnly returns true if the entries are never uploaded to the server
+func (r *Remote) ReadOnly() bool {
+	return r.readOnly
+}
+
+// Get downloads the entry with the given key. It returns nil if the entry is
+// not available on the server or if the server can't be reached.
+func (r *Remote) Get(key string) []byte {
+	if atomic.LoadInt32(&r.disabled) != 0 {
+		return nil
+	}
+	resp, err := r.client.Get(r.url + "/" + key)
+	if err != nil {
+		r.disable(err)
+		return nil
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		if resp.StatusCode != http.StatusNotFound {
+			logrus.Warnf("Remote build cache: GET %s: %s", key, resp.Status)
+		}
+		return nil
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		r.disable(err)
+		return nil
+	}
+	return data
+}
+
+// Put uploads the entry with the given key. Nothing is done if the Remote is
+// read-only or has been disabled.
+func (r *Remote) Put(key string, data []byte) {
+	if r.readOnly || atomic.LoadInt32(&r.disabled) != 0 {
+		return
+	}
+	req, err := http.NewRequest(http.MethodPut, r.url+"/"+key, bytes.NewReader(data))
+	if err != nil {
+		logrus.Warnf("Remote build cache: PUT %s: %s", key, err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	resp, err := r.client.Do(req)
+	if err != nil {
+		r.disable(err)
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		logrus.Warnf("Remote build cache: PUT %s: %s", key, resp.Status)
+	}
+}
+
+func (r *Remote) disable(err error) {
+	if atomic.CompareAndSwapInt32(&r.disabled, 0, 1) {
+		logrus.Warnf("Remote build cache unreachable, continuing without it: %s", err)
+	}
+}
@@ -0,0 +1,136 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package buildcache
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestServer starts an in-memory key/value store implementing the protocol
+// of the remote build cache
+func newTestServer() (*httptest.Server, map[string][]byte) {
+	var mux sync.Mutex
+	entries := map[string][]byte{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mux.Lock()
+		defer mux.Unlock()
+		key := strings.TrimPrefix(r.URL.Path, "/cache/")
+		switch r.Method {
+		case http.MethodGet:
+			data, ok := entries[key]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write(data)
+		case http.MethodPut:
+			data, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			entries[key] = data
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	return server, entries
+}
+
+func TestRemoteCache(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "buildcache_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	server, entries := newTestServer()
+	defer server.Close()
+
+	_, err = NewRemote("ftp://example.com/cache", false)
+	require.Error(t, err)
+
+	newUnit := func(buildPath *paths.Path) *Unit {
+		source := tmp.Join("sketch.cpp")
+		require.NoError(t, source.WriteFile([]byte("int main() {}")))
+		return &Unit{
+			Source:      source,
+			ObjectFile:  buildPath.Join("sketch.cpp.o"),
+			DepFile:     buildPath.Join("sketch.cpp.d"),
+			CommandLine: []string{"gcc", "-c", source.String()},
+			BuildPath:   buildPath,
+		}
+	}
+
+	// A read-only remote doesn't receive the compiled objects
+	readOnly, err := NewRemote(server.URL+"/cache/", true)
+	require.NoError(t, err)
+	cache1 := New(tmp.Join("cache1"))
+	cache1.SetRemote(readOnly)
+	unit1 := newUnit(tmp.Join("build1"))
+	require.NoError(t, unit1.ObjectFile.Parent().MkdirAll())
+	require.NoError(t, unit1.ObjectFile.WriteFile([]byte("object")))
+	require.NoError(t, unit1.DepFile.WriteFile([]byte(escapeDepFilePath(unit1.ObjectFile.String())+": "+unit1.Source.String()+"\n")))
+	require.NoError(t, cache1.Store(unit1))
+	require.Empty(t, entries)
+
+	// A read-write remote receives the manifest and the object
+	readWrite, err := NewRemote(server.URL+"/cache", false)
+	require.NoError(t, err)
+	cache1.SetRemote(readWrite)
+	require.NoError(t, cache1.Store(unit1))
+	require.Len(t, entries, 3)
+
+	// Another machine, with an empty local cache, restores the object from the remote
+	cache2 := New(tmp.Join("cache2"))
+	cache2.SetRemote(readOnly)
+	unit2 := newUnit(tmp.Join("build2"))
+	restored, err := cache2.Restore(unit2)
+	require.NoError(t, err)
+	require.True(t, restored)
+	object, err := unit2.ObjectFile.ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "object", string(object))
+
+	// The restored entry is now in the local cache too
+	server.Close()
+	cache3 := New(tmp.Join("cache2"))
+	unit3 := newUnit(tmp.Join("build3"))
+	restored, err = cache3.Restore(unit3)
+	require.NoError(t, err)
+	require.True(t, restored)
+
+	// An unreachable remote is a cache miss, not an error
+	cache4 := New(tmp.Join("cache4"))
+	unreachable, err := NewRemote(server.URL, false)
+	require.NoError(t, err)
+	cache4.SetRemote(unreachable)
+	unit4 := newUnit(tmp.Join("build4"))
+	restored, err = cache4.Restore(unit4)
+	require.NoError(t, err)
+	require.False(t, restored)
+	require.NoError(t, unit4.ObjectFile.Parent().MkdirAll())
+	require.NoError(t, unit4.ObjectFile.WriteFile([]byte("object")))
+	require.NoError(t, unit4.DepFile.WriteFile([]byte(escapeDepFilePath(unit4.ObjectFile.String())+": "+unit4.Source.String()+"\n")))
+	require.NoError(t, cache4.Store(unit4))
+}
@@ -0,0 +1,303 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package buildmanifest describes the inputs and the outputs of a build, to
+// prove that a binary was produced from a given source tree and toolchain.
+package buildmanifest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/i18n"
+	paths "github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+)
+
+var tr = i18n.Tr
+
+// FileName is the name of the build manifest written next to the build artifacts
+const FileName = "build-manifest.json"
+
+// SignatureFileName is the name of the detached OpenPGP signature of the build
+// manifest, written next to it
+const SignatureFileName = FileName + ".sig"
+
+// currentVersion is the version of the format of the manifest
+const currentVersion = 1
+
+// Manifest lists the inputs of a build, with their SHA-256, and the produced
+// artifacts
+type Manifest struct {
+	Version               int               `json:"version"`
+	FQBN                  string            `json:"fqbn"`
+	Sketch                []*File           `json:"sketch"`
+	Libraries             []*Library        `json:"libraries"`
+	Platforms             []*Release        `json:"platforms"`
+	Tools                 []*Release        `json:"tools"`
+	BuildProperties       map[string]string `json:"build_properties"`
+	BuildPropertiesSHA256 string            `json:"build_properties_sha256"`
+	Artifacts             []*File           `json:"artifacts"`
+}
+
+// File is a file of the sketch or an artifact of the build, the path is
+// relative to the sketch folder or to the folder containing the artifacts
+type File struct {
+	Path   string `json:"path"`
+	SHA256 string `json:"sha256"`
+}
+
+// Library is a library used by the build, the SHA-256 is computed on all the
+// files of the library
+type Library struct {
+	Name     string `json:"name"`
+	Version  string `json:"version"`
+	Location string `json:"location"`
+	SHA256   string `json:"sha256"`
+}
+
+// Release is a release of a platform or of a tool. The SHA-256 is the checksum
+// of the archive of the release, as listed in the package index, or it's
+// computed on the installed files if the release doesn't come from an index.
+type Release struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	SHA256  string `json:"sha256"`
+}
+
+// New returns an empty manifest for a build of the given board
+func New(fqbn string) *Manifest {
+	return &Manifest{
+		Version:         currentVersion,
+		FQBN:            fqbn,
+		Sketch:          []*File{},
+		Libraries:       []*Library{},
+		Platforms:       []*Release{},
+		Tools:           []*Release{},
+		BuildProperties: map[string]string{},
+		Artifacts:       []*File{},
+	}
+}
+
+// Load reads a manifest from file
+func Load(file *paths.Path) (*Manifest, error) {
+	data, err := file.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf(tr("invalid build manifest %[1]s: %[2]s"), file, err)
+	}
+	if m.Version != currentVersion {
+		return nil, fmt.Errorf(tr("unsupported build manifest version: %d"), m.Version)
+	}
+	return &m, nil
+}
+
+// Save writes the manifest to file
+func (m *Manifest) Save(file *paths.Path) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return file.WriteFile(append(data, '\n'))
+}
+
+// SetBuildProperties stores the given build properties, with all the
+// references to other properties expanded
+func (m *Manifest) SetBuildProperties(buildProperties *properties.Map) {
+	m.BuildProperties = map[string]string{}
+	for _, key := range buildProperties.Keys() {
+		m.BuildProperties[key] = buildProperties.ExpandPropsInString(buildProperties.Get(key))
+	}
+	// the keys of a map are sorted by the json encoder, giving a stable hash
+	data, _ := json.Marshal(m.BuildProperties)
+	hash := sha256.Sum256(data)
+	m.BuildPropertiesSHA256 = hex.EncodeToString(hash[:])
+}
+
+// AddFile adds a sketch file, with the given content, to the manifest
+func (m *Manifest) AddFile(path string, content []byte) {
+	hash := sha256.Sum256(content)
+	m.Sketch = append(m.Sketch, &File{Path: filepath.ToSlash(path), SHA256: hex.EncodeToString(hash[:])})
+}
+
+// AddArtifact adds a file produced by the build to the manifest
+func (m *Manifest) AddArtifact(file *paths.Path) error {
+	hash, err := FileSHA256(file)
+	if err != nil {
+		return err
+	}
+	m.Artifacts = append(m.Artifacts, &File{Path: file.Base(), SHA256: hash})
+	return nil
+}
+
+// FileSHA256 returns the SHA-256 of the content of the file
+func FileSHA256(file *paths.Path) (string, error) {
+	f, err := file.Open()
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// DirSHA256 returns a SHA-256 computed on the relative paths and the contents
+// of all the files in the directory. Hidden files and directories (like the
+// ones of a version control system) are ignored.
+func DirSHA256(dir *paths.Path) (string, error) {
+	hash := sha256.New()
+	err := filepath.Walk(dir.String(), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(info.Name(), ".") && path != dir.String() {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir.String(), path)
+		if err != nil {
+			return err
+		}
+		fileHash, err := FileSHA256(paths.New(path))
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(hash, "%s %s\n", fileHash, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// DiffInputs returns a description of the inputs that differ between the two
+// manifests
+func (m *Manifest) DiffInputs(other *Manifest) []string {
+	diffs := []string{}
+	if m.FQBN != other.FQBN {
+		diffs = append(diffs, tr("board changed from %[1]s to %[2]s", m.FQBN, other.FQBN))
+	}
+	diffs = append(diffs, diffFiles(tr("sketch file"), m.Sketch, other.Sketch)...)
+	diffs = append(diffs, diffReleases(tr("library"), librariesAsReleases(m.Libraries), librariesAsReleases(other.Libraries))...)
+	diffs = append(diffs, diffReleases(tr("platform"), m.Platforms, other.Platforms)...)
+	diffs = append(diffs, diffReleases(tr("tool"), m.Tools, other.Tools)...)
+
+	if m.BuildPropertiesSHA256 != other.BuildPropertiesSHA256 {
+		keys := []string{}
+		for key := range m.BuildProperties {
+			keys = append(keys, key)
+		}
+		for key := range other.BuildProperties {
+			if _, ok := m.BuildProperties[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if m.BuildProperties[key] != other.BuildProperties[key] {
+				diffs = append(diffs, tr("build property %[1]s changed from '%[2]s' to '%[3]s'", key, m.BuildProperties[key], other.BuildProperties[key]))
+			}
+		}
+	}
+	return diffs
+}
+
+// DiffArtifacts returns a description of the artifacts that differ between
+// the two manifests
+func (m *Manifest) DiffArtifacts(other *Manifest) []string {
+	return diffFiles(tr("artifact"), m.Artifacts, other.Artifacts)
+}
+
+func diffFiles(kind string, files, otherFiles []*File) []string {
+	diffs := []string{}
+	hashes := map[string]string{}
+	for _, f := range files {
+		hashes[f.Path] = f.SHA256
+	}
+	otherHashes := map[string]string{}
+	for _, f := range otherFiles {
+		otherHashes[f.Path] = f.SHA256
+		if prev, ok := hashes[f.Path]; !ok {
+			diffs = append(diffs, tr("%[1]s %[2]s added", kind, f.Path))
+		} else if prev != f.SHA256 {
+			diffs = append(diffs, tr("%[1]s %[2]s changed", kind, f.Path))
+		}
+	}
+	for _, f := range files {
+		if _, ok := otherHashes[f.Path]; !ok {
+			diffs = append(diffs, tr("%[1]s %[2]s removed", kind, f.Path))
+		}
+	}
+	return diffs
+}
+
+func diffReleases(kind string, releases, otherReleases []*Release) []string {
+	diffs := []string{}
+	prevs := map[string]*Release{}
+	for _, r := range releases {
+		prevs[r.Name] = r
+	}
+	others := map[string]*Release{}
+	for _, r := range otherReleases {
+		others[r.Name] = r
+		if prev, ok := prevs[r.Name]; !ok {
+			diffs = append(diffs, tr("%[1]s %[2]s added", kind, r.Name))
+		} else if prev.Version != r.Version || prev.SHA256 != r.SHA256 {
+			diffs = append(diffs, tr("%[1]s %[2]s changed from %[3]s to %[4]s", kind, r.Name, prev.describe(), r.describe()))
+		}
+	}
+	for _, r := range releases {
+		if _, ok := others[r.Name]; !ok {
+			diffs = append(diffs, tr("%[1]s %[2]s removed", kind, r.Name))
+		}
+	}
+	return diffs
+}
+
+func librariesAsReleases(libs []*Library) []*Release {
+	res := []*Release{}
+	for _, lib := range libs {
+		res = append(res, &Release{Name: lib.Name, Version: lib.Version, SHA256: lib.SHA256})
+	}
+	return res
+}
+
+// describe returns the version and a short prefix of the SHA-256
+func (r *Release) describe() string {
+	hash := r.SHA256
+	if len(hash) > 12 {
+		hash = hash[:12]
+	}
+	return r.Version + " (" + hash + ")"
+}
@@ -0,0 +1,103 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package buildmanifest
+
+import (
+	"testing"
+
+	paths "github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveAndLoad(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "buildmanifest")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	m := New("arduino:avr:uno")
+	m.AddFile("sketch.ino", []byte("void setup() {}\nvoid loop() {}\n"))
+	m.Platforms = append(m.Platforms, &Release{Name: "arduino:avr", Version: "1.8.3", SHA256: "abcd"})
+	props := properties.NewMap()
+	props.Set("build.path", "/tmp/build")
+	props.Set("recipe.hex", "objcopy {build.path}/sketch.elf")
+	m.SetBuildProperties(props)
+	require.Equal(t, "objcopy /tmp/build/sketch.elf", m.BuildProperties["recipe.hex"])
+
+	file := tmp.Join(FileName)
+	require.NoError(t, m.Save(file))
+	loaded, err := Load(file)
+	require.NoError(t, err)
+	require.Equal(t, m, loaded)
+	require.Empty(t, m.DiffInputs(loaded))
+
+	require.NoError(t, file.WriteFile([]byte(`{"version": 99}`)))
+	_, err = Load(file)
+	require.Error(t, err)
+}
+
+func TestDirSHA256(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "buildmanifest")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	require.NoError(t, tmp.Join("src").MkdirAll())
+	require.NoError(t, tmp.Join("src", "lib.cpp").WriteFile([]byte("int a;")))
+	require.NoError(t, tmp.Join("library.properties").WriteFile([]byte("name=Lib")))
+	hash, err := DirSHA256(tmp)
+	require.NoError(t, err)
+
+	// hidden files are ignored
+	require.NoError(t, tmp.Join(".git").MkdirAll())
+	require.NoError(t, tmp.Join(".git", "HEAD").WriteFile([]byte("ref: refs/heads/main")))
+	same, err := DirSHA256(tmp)
+	require.NoError(t, err)
+	require.Equal(t, hash, same)
+
+	// renaming a file changes the hash
+	require.NoError(t, tmp.Join("src", "lib.cpp").Rename(tmp.Join("src", "other.cpp")))
+	renamed, err := DirSHA256(tmp)
+	require.NoError(t, err)
+	require.NotEqual(t, hash, renamed)
+}
+
+func TestDiff(t *testing.T) {
+	m := New("arduino:avr:uno")
+	m.AddFile("sketch.ino", []byte("void setup() {}"))
+	m.AddFile("other.ino", []byte("void loop() {}"))
+	m.Libraries = append(m.Libraries, &Library{Name: "Servo", Version: "1.1.8", SHA256: "1111111111111111"})
+	m.Tools = append(m.Tools, &Release{Name: "arduino:avr-gcc", Version: "7.3.0", SHA256: "2222"})
+	m.Artifacts = append(m.Artifacts, &File{Path: "sketch.ino.hex", SHA256: "3333"})
+
+	other := New("arduino:avr:uno")
+	other.AddFile("sketch.ino", []byte("void setup() { }"))
+	other.Libraries = append(other.Libraries, &Library{Name: "Servo", Version: "1.2.0", SHA256: "4444444444444444"})
+	other.Libraries = append(other.Libraries, &Library{Name: "Wire", Version: "1.0", SHA256: "5555"})
+	other.Tools = append(other.Tools, &Release{Name: "arduino:avr-gcc", Version: "7.3.0", SHA256: "2222"})
+	other.Artifacts = append(other.Artifacts, &File{Path: "sketch.ino.hex", SHA256: "6666"})
+	other.Artifacts = append(other.Artifacts, &File{Path: "sketch.ino.elf", SHA256: "7777"})
+
+	require.Equal(t, []string{
+		"sketch file sketch.ino changed",
+		"sketch file other.ino removed",
+		"library Servo changed from 1.1.8 (111111111111) to 1.2.0 (444444444444)",
+		"library Wire added",
+	}, m.DiffInputs(other))
+	require.Equal(t, []string{
+		"artifact sketch.ino.hex changed",
+		"artifact sketch.ino.elf added",
+	}, m.DiffArtifacts(other))
+}
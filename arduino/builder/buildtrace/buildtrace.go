@@ -0,0 +1,151 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package buildtrace
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// Categories of the steps of a build
+const (
+	// CategoryCommand is a command of the builder
+	CategoryCommand = "command"
+	// CategoryRecipe is a recipe hook or an objcopy recipe of the platform
+	CategoryRecipe = "recipe"
+	// CategoryIncludes is a run of the preprocessor to detect the libraries
+	// included by a source file
+	CategoryIncludes = "includes"
+	// CategoryCompile is the compilation of a source file
+	CategoryCompile = "compile"
+	// CategoryArchive is the creation of an archive of object files
+	CategoryArchive = "archive"
+	// CategoryLink is the link of the executable
+	CategoryLink = "link"
+)
+
+// Recorder collects the timing of the steps of a build. A nil Recorder is valid
+// and records nothing, so the steps can be traced unconditionally.
+type Recorder struct {
+	start time.Time
+
+	mux   sync.Mutex
+	spans []*Span
+	depth int
+	lanes []bool
+}
+
+// Span is a step of a build
+type Span struct {
+	Category string
+	Name     string
+	// Start is the time elapsed from the beginning of the build
+	Start    time.Duration
+	Duration time.Duration
+	// Lane is 0 for the steps run one after the other, possibly nested, and
+	// greater than 0 for the jobs run concurrently. Spans in the same lane
+	// greater than 0 never overlap.
+	Lane int
+	// Depth is the nesting level of the steps in lane 0
+	Depth int
+}
+
+// New creates a Recorder, the time of the spans is measured from now
+func New() *Recorder {
+	return &Recorder{start: time.Now()}
+}
+
+// Step records a step run sequentially, it may contain other steps. The
+// returned function must be called when the step ends.
+func (r *Recorder) Step(category, name string) func() {
+	if r == nil {
+		return func() {}
+	}
+	r.mux.Lock()
+	span := &Span{Category: category, Name: name, Start: time.Since(r.start), Depth: r.depth}
+	r.depth++
+	r.mux.Unlock()
+	return func() {
+		r.mux.Lock()
+		defer r.mux.Unlock()
+		span.Duration = time.Since(r.start) - span.Start
+		r.depth--
+		r.spans = append(r.spans, span)
+	}
+}
+
+// Job records a step run concurrently with the others, like a compilation. The
+// returned function must be called when the job ends.
+func (r *Recorder) Job(category, name string) func() {
+	if r == nil {
+		return func() {}
+	}
+	r.mux.Lock()
+	lane := 0
+	for i, busy := range r.lanes {
+		if !busy {
+			lane = i + 1
+			break
+		}
+	}
+	if lane == 0 {
+		r.lanes = append(r.lanes, true)
+		lane = len(r.lanes)
+	}
+	r.lanes[lane-1] = true
+	span := &Span{Category: category, Name: name, Start: time.Since(r.start), Lane: lane}
+	r.mux.Unlock()
+	return func() {
+		r.mux.Lock()
+		defer r.mux.Unlock()
+		span.Duration = time.Since(r.start) - span.Start
+		r.lanes[lane-1] = false
+		r.spans = append(r.spans, span)
+	}
+}
+
+// Spans returns the spans of the completed steps sorted by start time
+func (r *Recorder) Spans() []*Span {
+	if r == nil {
+		return nil
+	}
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	res := append([]*Span{}, r.spans...)
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].Start != res[j].Start {
+			return res[i].Start < res[j].Start
+		}
+		// the outer step first
+		return res[i].Duration > res[j].Duration
+	})
+	return res
+}
+
+// ToRPC converts the Span into a *rpc.BuildTraceSpan
+func (s *Span) ToRPC() *rpc.BuildTraceSpan {
+	return &rpc.BuildTraceSpan{
+		Category:   s.Category,
+		Name:       s.Name,
+		StartUs:    s.Start.Microseconds(),
+		DurationUs: s.Duration.Microseconds(),
+		Lane:       int32(s.Lane),
+		Depth:      int32(s.Depth),
+	}
+}
@@ -0,0 +1,58 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package buildtrace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	var nilRecorder *Recorder
+	nilRecorder.Step(CategoryCommand, "nothing")()
+	require.Empty(t, nilRecorder.Spans())
+
+	r := New()
+	endBuild := r.Step(CategoryCommand, "Builder")
+	endFind := r.Step(CategoryCommand, "ContainerFindIncludes")
+	r.Step(CategoryIncludes, "sketch.ino.cpp")()
+	endFind()
+	endA := r.Job(CategoryCompile, "a.cpp")
+	endB := r.Job(CategoryCompile, "b.cpp")
+	endA()
+	endC := r.Job(CategoryCompile, "c.cpp")
+	endC()
+	endB()
+	endBuild()
+
+	spans := r.Spans()
+	require.Len(t, spans, 6)
+	byName := map[string]*Span{}
+	for _, span := range spans {
+		byName[span.Name] = span
+	}
+	require.Equal(t, "Builder", spans[0].Name)
+	require.Equal(t, 0, byName["Builder"].Depth)
+	require.Equal(t, 1, byName["ContainerFindIncludes"].Depth)
+	require.Equal(t, 2, byName["sketch.ino.cpp"].Depth)
+	require.Equal(t, 0, byName["sketch.ino.cpp"].Lane)
+	// c.cpp reuses the lane freed by a.cpp
+	require.Equal(t, 1, byName["a.cpp"].Lane)
+	require.Equal(t, 2, byName["b.cpp"].Lane)
+	require.Equal(t, 1, byName["c.cpp"].Lane)
+	require.True(t, byName["Builder"].Duration >= byName["b.cpp"].Duration)
+}
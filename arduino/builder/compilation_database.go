@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"sync"
 
 	"github.com/arduino/go-paths-helper"
 )
@@ -28,6 +29,8 @@ import (
 type CompilationDatabase struct {
 	Contents []CompilationCommand
 	File     *paths.Path
+
+	contentsMux sync.Mutex
 }
 
 // CompilationCommand keeps track of a single run of a compile command
@@ -36,6 +39,7 @@ type CompilationCommand struct {
 	Command   string   `json:"command,omitempty"`
 	Arguments []string `json:"arguments,omitempty"`
 	File      string   `json:"file"`
+	Output    string   `json:"output,omitempty"`
 }
 
 // NewCompilationDatabase creates an empty CompilationDatabase
@@ -81,13 +85,19 @@ func dirForCommand(command *exec.Cmd) string {
 	return dir
 }
 
-// Add adds a new CompilationDatabase entry
-func (db *CompilationDatabase) Add(target *paths.Path, command *exec.Cmd) {
+// Add adds a new CompilationDatabase entry, output is the object file produced
+// by the command and may be nil
+func (db *CompilationDatabase) Add(target *paths.Path, output *paths.Path, command *exec.Cmd) {
 	entry := CompilationCommand{
 		Directory: dirForCommand(command),
 		Arguments: command.Args,
 		File:      target.String(),
 	}
+	if output != nil {
+		entry.Output = output.String()
+	}
 
+	db.contentsMux.Lock()
+	defer db.contentsMux.Unlock()
 	db.Contents = append(db.Contents, entry)
 }
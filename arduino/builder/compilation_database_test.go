@@ -30,7 +30,7 @@ func TestCompilationDatabase(t *testing.T) {
 
 	cmd := exec.Command("gcc", "arg1", "arg2")
 	db := NewCompilationDatabase(tmpfile)
-	db.Add(paths.New("test"), cmd)
+	db.Add(paths.New("test"), paths.New("test.o"), cmd)
 	db.SaveToFile()
 
 	db2, err := LoadCompilationDatabase(tmpfile)
@@ -38,6 +38,7 @@ func TestCompilationDatabase(t *testing.T) {
 	require.Equal(t, db, db2)
 	require.Len(t, db2.Contents, 1)
 	require.Equal(t, db2.Contents[0].File, "test")
+	require.Equal(t, db2.Contents[0].Output, "test.o")
 	require.Equal(t, db2.Contents[0].Command, "")
 	require.Equal(t, db2.Contents[0].Arguments, []string{"gcc", "arg1", "arg2"})
 	cwd, err := paths.Getwd()
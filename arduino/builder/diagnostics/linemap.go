@@ -0,0 +1,137 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package diagnostics
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+)
+
+var lineDirectiveRegexp = regexp.MustCompile(`^\s*#\s*line\s+(\d+)(?:\s+"((?:[^"\\]|\\.)*)")?`)
+
+// LineMap maps the lines of a generated source file (like the .ino.cpp produced by
+// merging the sketch files) back to the original files, using the #line directives
+// found in the generated file.
+type LineMap struct {
+	directives []lineDirective
+}
+
+type lineDirective struct {
+	// line of the generated file that follows the directive
+	generatedLine int
+	file          string
+	line          int
+}
+
+// NewLineMap creates a LineMap from the content of a generated source file
+func NewLineMap(source string) *LineMap {
+	res := &LineMap{}
+	currentFile := ""
+	for i, row := range strings.Split(source, "\n") {
+		m := lineDirectiveRegexp.FindStringSubmatch(row)
+		if m == nil {
+			continue
+		}
+		line, err := strconv.Atoi(m[1])
+		if err != nil {
+			continue
+		}
+		if m[2] != "" {
+			if file, err := strconv.Unquote(`"` + m[2] + `"`); err == nil {
+				currentFile = file
+			} else {
+				currentFile = m[2]
+			}
+		}
+		res.directives = append(res.directives, lineDirective{
+			generatedLine: i + 2,
+			file:          currentFile,
+			line:          line,
+		})
+	}
+	return res
+}
+
+// Map returns the original file and line corresponding to the given line of
+// the generated file. If the line is not covered by any #line directive, or the
+// directive doesn't specify a file, ok is false.
+func (m *LineMap) Map(generatedLine int) (file string, line int, ok bool) {
+	var found *lineDirective
+	for i := range m.directives {
+		if m.directives[i].generatedLine > generatedLine {
+			break
+		}
+		found = &m.directives[i]
+	}
+	if found == nil || found.file == "" {
+		return "", 0, false
+	}
+	return found.file, found.line + generatedLine - found.generatedLine, true
+}
+
+// RemapGeneratedSources changes the locations of the diagnostics pointing to a
+// generated file inside buildPath into the corresponding locations in the
+// original source files.
+func RemapGeneratedSources(diags []*Diagnostic, buildPath *paths.Path) {
+	if buildPath == nil {
+		return
+	}
+	lineMaps := map[string]*LineMap{}
+	remap := func(file string, line int) (string, int) {
+		if file == "" || line == 0 {
+			return file, line
+		}
+		if inside, err := paths.New(file).IsInsideDir(buildPath); err != nil || !inside {
+			return file, line
+		}
+		lineMap, ok := lineMaps[file]
+		if !ok {
+			if source, err := paths.New(file).ReadFile(); err == nil {
+				lineMap = NewLineMap(string(source))
+			}
+			lineMaps[file] = lineMap
+		}
+		if lineMap == nil {
+			return file, line
+		}
+		if mappedFile, mappedLine, ok := lineMap.Map(line); ok {
+			return mappedFile, mappedLine
+		}
+		return file, line
+	}
+
+	var remapDiagnostic func(d *Diagnostic)
+	remapDiagnostic = func(d *Diagnostic) {
+		d.File, d.Line = remap(d.File, d.Line)
+		for _, c := range d.Context {
+			c.File, c.Line = remap(c.File, c.Line)
+		}
+		for _, f := range d.FixIts {
+			file := f.File
+			f.File, f.StartLine = remap(file, f.StartLine)
+			_, f.EndLine = remap(file, f.EndLine)
+		}
+		for _, note := range d.Notes {
+			remapDiagnostic(note)
+		}
+	}
+	for _, d := range diags {
+		remapDiagnostic(d)
+	}
+}
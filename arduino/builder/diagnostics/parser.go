@@ -0,0 +1,200 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package diagnostics
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// Severity of a Diagnostic
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+	SeverityNote    = "note"
+)
+
+// Diagnostic is a message (error, warning or note) produced by the compiler
+type Diagnostic struct {
+	Severity string
+	Message  string
+	File     string
+	Line     int
+	Column   int
+	// Context contains the chain of locations that led to the diagnostic, for example
+	// the chain of included files or the function where the diagnostic has been raised.
+	Context []*Context
+	// Notes are additional informations attached to the diagnostic
+	Notes []*Diagnostic
+	// FixIts are the changes to the source code suggested by the compiler
+	FixIts []*FixIt
+}
+
+// Context is a location that led to a Diagnostic
+type Context struct {
+	Message string
+	File    string
+	Line    int
+	Column  int
+}
+
+// FixIt is a change to the source code suggested by the compiler to fix a Diagnostic
+type FixIt struct {
+	File        string
+	StartLine   int
+	StartColumn int
+	EndLine     int
+	EndColumn   int
+	Replacement string
+}
+
+var diagnosticRegexp = regexp.MustCompile(`^(.+?):(\d+):(?:(\d+):)? (fatal error|error|warning|note|remark): (.*)$`)
+var requiredFromRegexp = regexp.MustCompile(`^(.+?):(\d+):(?:(\d+):)?\s+(required from .*)$`)
+var includedFromRegexp = regexp.MustCompile(`^(?:In file included from|\s+from) (.+?):(\d+)(?::(\d+))?[,:]$`)
+var scopeRegexp = regexp.MustCompile(`^(.+?): ((?:In|At) .*):$`)
+var fixItRegexp = regexp.MustCompile(`^fix-it:"(.*)":\{(\d+):(\d+)-(\d+):(\d+)\}:"(.*)"$`)
+
+// ParseCompilerOutput parses the output of GCC (or Clang) and returns the diagnostics found in it.
+// Lines that are not recognized (like the source code snippets) are ignored.
+func ParseCompilerOutput(output []byte) []*Diagnostic {
+	res := []*Diagnostic{}
+	var last *Diagnostic
+	context := []*Context{}
+
+	text := strings.Replace(string(output), "\r\n", "\n", -1)
+	for _, line := range strings.Split(text, "\n") {
+		if m := includedFromRegexp.FindStringSubmatch(line); m != nil {
+			if strings.HasPrefix(line, "In file included from") {
+				context = []*Context{}
+			}
+			context = append(context, &Context{
+				Message: "included from",
+				File:    m[1],
+				Line:    atoi(m[2]),
+				Column:  atoi(m[3]),
+			})
+			continue
+		}
+		if m := diagnosticRegexp.FindStringSubmatch(line); m != nil {
+			diag := &Diagnostic{
+				Severity: normalizeSeverity(m[4]),
+				Message:  m[5],
+				File:     m[1],
+				Line:     atoi(m[2]),
+				Column:   atoi(m[3]),
+				Context:  context,
+			}
+			context = []*Context{}
+			if diag.Severity == SeverityNote && last != nil {
+				last.Notes = append(last.Notes, diag)
+				continue
+			}
+			res = append(res, diag)
+			last = diag
+			continue
+		}
+		if m := requiredFromRegexp.FindStringSubmatch(line); m != nil {
+			context = append(context, &Context{
+				Message: m[4],
+				File:    m[1],
+				Line:    atoi(m[2]),
+				Column:  atoi(m[3]),
+			})
+			continue
+		}
+		if m := scopeRegexp.FindStringSubmatch(line); m != nil {
+			context = append(context, &Context{
+				Message: m[2],
+				File:    m[1],
+			})
+			continue
+		}
+		if m := fixItRegexp.FindStringSubmatch(line); m != nil && last != nil {
+			last.FixIts = append(last.FixIts, &FixIt{
+				File:        m[1],
+				StartLine:   atoi(m[2]),
+				StartColumn: atoi(m[3]),
+				EndLine:     atoi(m[4]),
+				EndColumn:   atoi(m[5]),
+				Replacement: unquoteFixIt(m[6]),
+			})
+			continue
+		}
+	}
+	return res
+}
+
+func normalizeSeverity(severity string) string {
+	switch severity {
+	case "fatal error":
+		return SeverityError
+	case "remark":
+		return SeverityNote
+	}
+	return severity
+}
+
+func atoi(s string) int {
+	if s == "" {
+		return 0
+	}
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+// unquoteFixIt removes the escaping done by the compiler on the fix-it replacement text
+func unquoteFixIt(s string) string {
+	if unquoted, err := strconv.Unquote(`"` + s + `"`); err == nil {
+		return unquoted
+	}
+	return s
+}
+
+// ToRPC converts the Diagnostic into a *rpc.CompileDiagnostic
+func (d *Diagnostic) ToRPC() *rpc.CompileDiagnostic {
+	res := &rpc.CompileDiagnostic{
+		Severity: d.Severity,
+		Message:  d.Message,
+		File:     d.File,
+		Line:     int64(d.Line),
+		Column:   int64(d.Column),
+	}
+	for _, c := range d.Context {
+		res.Context = append(res.Context, &rpc.CompileDiagnosticContext{
+			Message: c.Message,
+			File:    c.File,
+			Line:    int64(c.Line),
+			Column:  int64(c.Column),
+		})
+	}
+	for _, note := range d.Notes {
+		res.Notes = append(res.Notes, note.ToRPC())
+	}
+	for _, f := range d.FixIts {
+		res.FixIts = append(res.FixIts, &rpc.CompileDiagnosticFixIt{
+			File:        f.File,
+			StartLine:   int64(f.StartLine),
+			StartColumn: int64(f.StartColumn),
+			EndLine:     int64(f.EndLine),
+			EndColumn:   int64(f.EndColumn),
+			Replacement: f.Replacement,
+		})
+	}
+	return res
+}
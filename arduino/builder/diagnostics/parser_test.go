@@ -0,0 +1,136 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package diagnostics
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCompilerOutput(t *testing.T) {
+	output := `In file included from /home/user/Arduino/libraries/Lib/src/Lib.h:3,
+                 from /home/user/Arduino/Blink/Blink.ino:1:
+/home/user/Arduino/libraries/Lib/src/Other.h:10:1: warning: 'int f()' declared 'static' but never defined [-Wunused-function]
+   10 | static int f();
+      | ^
+/home/user/Arduino/Blink/Blink.ino: In function 'void loop()':
+/home/user/Arduino/Blink/Blink.ino:8:3: error: 'digitalWrit' was not declared in this scope; did you mean 'digitalWrite'?
+    8 |   digitalWrit(13, HIGH);
+      |   ^~~~~~~~~~~
+      |   digitalWrite
+fix-it:"/home/user/Arduino/Blink/Blink.ino":{8:3-8:14}:"digitalWrite"
+In file included from /tmp/build/sketch/Blink.ino.cpp:1:
+/home/user/.arduino15/packages/arduino/hardware/avr/1.8.3/cores/arduino/Arduino.h:134:6: note: 'digitalWrite' declared here
+  134 | void digitalWrite(uint8_t pin, uint8_t val);
+      |      ^~~~~~~~~~~~
+C:\Users\user\Blink\Blink.ino:12: fatal error: missing.h: No such file or directory
+compilation terminated.
+`
+	diags := ParseCompilerOutput([]byte(output))
+	require.Len(t, diags, 3)
+
+	require.Equal(t, SeverityWarning, diags[0].Severity)
+	require.Equal(t, "/home/user/Arduino/libraries/Lib/src/Other.h", diags[0].File)
+	require.Equal(t, 10, diags[0].Line)
+	require.Equal(t, 1, diags[0].Column)
+	require.Equal(t, "'int f()' declared 'static' but never defined [-Wunused-function]", diags[0].Message)
+	require.Len(t, diags[0].Context, 2)
+	require.Equal(t, "/home/user/Arduino/libraries/Lib/src/Lib.h", diags[0].Context[0].File)
+	require.Equal(t, 3, diags[0].Context[0].Line)
+	require.Equal(t, "/home/user/Arduino/Blink/Blink.ino", diags[0].Context[1].File)
+	require.Equal(t, 1, diags[0].Context[1].Line)
+
+	require.Equal(t, SeverityError, diags[1].Severity)
+	require.Equal(t, "/home/user/Arduino/Blink/Blink.ino", diags[1].File)
+	require.Equal(t, 8, diags[1].Line)
+	require.Equal(t, 3, diags[1].Column)
+	require.Len(t, diags[1].Context, 1)
+	require.Equal(t, "In function 'void loop()'", diags[1].Context[0].Message)
+	require.Len(t, diags[1].FixIts, 1)
+	require.Equal(t, &FixIt{
+		File:        "/home/user/Arduino/Blink/Blink.ino",
+		StartLine:   8,
+		StartColumn: 3,
+		EndLine:     8,
+		EndColumn:   14,
+		Replacement: "digitalWrite",
+	}, diags[1].FixIts[0])
+	require.Len(t, diags[1].Notes, 1)
+	require.Equal(t, SeverityNote, diags[1].Notes[0].Severity)
+	require.Equal(t, 134, diags[1].Notes[0].Line)
+	require.Len(t, diags[1].Notes[0].Context, 1)
+
+	require.Equal(t, SeverityError, diags[2].Severity)
+	require.Equal(t, `C:\Users\user\Blink\Blink.ino`, diags[2].File)
+	require.Equal(t, 12, diags[2].Line)
+	require.Equal(t, 0, diags[2].Column)
+	require.Equal(t, "missing.h: No such file or directory", diags[2].Message)
+}
+
+func TestLineMap(t *testing.T) {
+	source := "#include <Arduino.h>\n" +
+		"#line 1 \"/home/user/Blink/Blink.ino\"\n" +
+		"void setup() {}\n" +
+		"#line 3 \"/home/user/Blink/Blink.ino\"\n" +
+		"void loop();\n" +
+		"#line 1 \"/home/user/Blink/Blink.ino\"\n" +
+		"void loop() {}\n" +
+		"#line 1 \"C:\\\\Users\\\\user\\\\Blink\\\\other.ino\"\n" +
+		"\n" +
+		"int x = 1;\n"
+	m := NewLineMap(source)
+
+	_, _, ok := m.Map(1)
+	require.False(t, ok)
+
+	file, line, ok := m.Map(3)
+	require.True(t, ok)
+	require.Equal(t, "/home/user/Blink/Blink.ino", file)
+	require.Equal(t, 1, line)
+
+	file, line, ok = m.Map(5)
+	require.True(t, ok)
+	require.Equal(t, "/home/user/Blink/Blink.ino", file)
+	require.Equal(t, 3, line)
+
+	file, line, ok = m.Map(10)
+	require.True(t, ok)
+	require.Equal(t, `C:\Users\user\Blink\other.ino`, file)
+	require.Equal(t, 2, line)
+}
+
+func TestRemapGeneratedSources(t *testing.T) {
+	buildPath, err := paths.MkTempDir("", "diagnostics_test")
+	require.NoError(t, err)
+	defer buildPath.RemoveAll()
+
+	inoCpp := buildPath.Join("sketch", "Blink.ino.cpp")
+	require.NoError(t, inoCpp.Parent().MkdirAll())
+	require.NoError(t, inoCpp.WriteFile([]byte("#include <Arduino.h>\n#line 1 \"/home/user/Blink/Blink.ino\"\nvoid setup() {}\nvoid loop() { x }\n")))
+
+	diags := []*Diagnostic{
+		{Severity: SeverityError, File: inoCpp.String(), Line: 4, Column: 15, Message: "'x' was not declared in this scope"},
+		{Severity: SeverityWarning, File: "/home/user/Arduino/libraries/Lib/Lib.h", Line: 4},
+	}
+	RemapGeneratedSources(diags, buildPath)
+	require.Equal(t, "/home/user/Blink/Blink.ino", diags[0].File)
+	require.Equal(t, 2, diags[0].Line)
+	require.Equal(t, 15, diags[0].Column)
+	require.Equal(t, "/home/user/Arduino/libraries/Lib/Lib.h", diags[1].File)
+	require.Equal(t, 4, diags[1].Line)
+}
@@ -0,0 +1,120 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package projectexport
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// CMakeListsName is the name of the CMake project file of a project
+const CMakeListsName = "CMakeLists.txt"
+
+// WriteCMakeLists writes the CMakeLists.txt building the project. The commands
+// of the build are custom commands, since the recipes of the platform can't be
+// expressed as CMake toolchain settings. The paths of the tools are cache
+// variables.
+func WriteCMakeLists(w io.Writer, p *Project) error {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "# Build of the sketch %s for the board %s, exported by arduino-cli.\n", p.Name, p.FQBN)
+	fmt.Fprintf(b, "# Configure it with `cmake -S . -B build` and run `cmake --build build`.\n")
+	fmt.Fprintf(b, "\n")
+	fmt.Fprintf(b, "cmake_minimum_required(VERSION 3.5)\n")
+	fmt.Fprintf(b, "project(%s NONE)\n", cmakeArg(Word{{Text: p.Name}}))
+	if len(p.Variables) > 0 {
+		fmt.Fprintf(b, "\n")
+	}
+	for _, v := range p.Variables {
+		fmt.Fprintf(b, "set(%s %s CACHE PATH %s)\n", v.Name, cmakeArg(Word{{Text: v.Value}}), cmakeArg(Word{{Text: v.Description}}))
+	}
+
+	for _, step := range p.Steps {
+		if len(step.Outputs) == 0 {
+			continue
+		}
+		fmt.Fprintf(b, "\n")
+		fmt.Fprintf(b, "add_custom_command(\n")
+		fmt.Fprintf(b, "  OUTPUT%s\n", cmakeArgs(step.Outputs))
+		dirs := []Word{}
+		seen := map[string]bool{}
+		for _, output := range step.Outputs {
+			if dir := output.dir(); dir != nil && !seen[dir.String()] {
+				seen[dir.String()] = true
+				dirs = append(dirs, dir)
+			}
+		}
+		if len(dirs) > 0 {
+			fmt.Fprintf(b, "  COMMAND \"${CMAKE_COMMAND}\" -E make_directory%s\n", cmakeArgs(dirs))
+		}
+		if step.RemoveOutputs {
+			fmt.Fprintf(b, "  COMMAND \"${CMAKE_COMMAND}\" -E remove%s\n", cmakeArgs(step.Outputs))
+		}
+		for _, command := range step.Commands {
+			fmt.Fprintf(b, "  COMMAND%s\n", cmakeArgs(command))
+		}
+		if len(step.Inputs) > 0 {
+			fmt.Fprintf(b, "  DEPENDS%s\n", cmakeArgs(step.Inputs))
+		}
+		fmt.Fprintf(b, "  VERBATIM\n")
+		fmt.Fprintf(b, ")\n")
+	}
+
+	fmt.Fprintf(b, "\n")
+	fmt.Fprintf(b, "add_custom_target(firmware ALL\n")
+	if p.Final != nil {
+		for _, command := range p.Final.Commands {
+			fmt.Fprintf(b, "  COMMAND%s\n", cmakeArgs(command))
+		}
+		if len(p.Final.Inputs) > 0 {
+			fmt.Fprintf(b, "  DEPENDS%s\n", cmakeArgs(p.Final.Inputs))
+		}
+	}
+	fmt.Fprintf(b, "  VERBATIM\n")
+	fmt.Fprintf(b, ")\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+var cmakeEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `$`, `\$`, `;`, `\;`)
+
+// cmakeArg returns the word as a quoted argument
+func cmakeArg(w Word) string {
+	res := ""
+	for _, p := range w {
+		switch p.Var {
+		case SourceDir:
+			res += "${CMAKE_SOURCE_DIR}"
+		case BuildDir:
+			res += "${CMAKE_BINARY_DIR}"
+		case "":
+			res += cmakeEscaper.Replace(p.Text)
+		default:
+			res += "${" + p.Var + "}"
+		}
+	}
+	return `"` + res + `"`
+}
+
+// cmakeArgs returns the words as quoted arguments, each preceded by a space
+func cmakeArgs(words []Word) string {
+	res := ""
+	for _, w := range words {
+		res += " " + cmakeArg(w)
+	}
+	return res
+}
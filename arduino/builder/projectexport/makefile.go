@@ -0,0 +1,148 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package projectexport
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// MakefileName is the name of the Makefile of a project
+const MakefileName = "Makefile"
+
+// WriteMakefile writes the Makefile building the project. The commands are run
+// from the project folder, the build folder and the paths of the tools can be
+// changed on the command line of make.
+func WriteMakefile(w io.Writer, p *Project) error {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "# Build of the sketch %s for the board %s, exported by arduino-cli.\n", p.Name, p.FQBN)
+	fmt.Fprintf(b, "# Run `make` from this folder to build it, the variables below can be changed\n")
+	fmt.Fprintf(b, "# on the command line, like `make %s=/tmp/build`.\n", BuildDir)
+	fmt.Fprintf(b, "\n")
+	fmt.Fprintf(b, "%s ?= build\n", BuildDir)
+	for _, v := range p.Variables {
+		fmt.Fprintf(b, "# %s\n", v.Description)
+		fmt.Fprintf(b, "%s ?= %s\n", v.Name, makeValue(v.Value))
+	}
+	fmt.Fprintf(b, "\n")
+	fmt.Fprintf(b, ".PHONY: all firmware clean\n")
+	fmt.Fprintf(b, "\n")
+	fmt.Fprintf(b, "all: firmware\n")
+
+	depFiles := []string{}
+	for _, step := range p.Steps {
+		if len(step.Outputs) == 0 {
+			continue
+		}
+		fmt.Fprintf(b, "\n")
+		// make 3.x doesn't support the grouped targets: only the first output
+		// is the target of the rule, the others are side effects
+		fmt.Fprintf(b, "%s:%s\n", makeTarget(step.Outputs[0]), makeTargets(step.Inputs))
+		fmt.Fprintf(b, "\t@mkdir -p $(@D)\n")
+		if step.RemoveOutputs {
+			fmt.Fprintf(b, "\trm -f%s\n", makeCommand(step.Outputs))
+		}
+		for _, command := range step.Commands {
+			fmt.Fprintf(b, "\t%s\n", strings.TrimPrefix(makeCommand(command), " "))
+		}
+		if step.DepFile != nil {
+			depFiles = append(depFiles, makeTarget(step.DepFile))
+		}
+	}
+
+	fmt.Fprintf(b, "\n")
+	if p.Final != nil {
+		fmt.Fprintf(b, "firmware:%s\n", makeTargets(p.Final.Inputs))
+		for _, command := range p.Final.Commands {
+			fmt.Fprintf(b, "\t%s\n", strings.TrimPrefix(makeCommand(command), " "))
+		}
+	} else {
+		fmt.Fprintf(b, "firmware:\n")
+	}
+	fmt.Fprintf(b, "\n")
+	fmt.Fprintf(b, "clean:\n")
+	fmt.Fprintf(b, "\trm -rf '$(%s)'\n", BuildDir)
+
+	if len(depFiles) > 0 {
+		fmt.Fprintf(b, "\n")
+		fmt.Fprintf(b, "# headers included by the sources, listed by the compiler\n")
+		for _, depFile := range depFiles {
+			fmt.Fprintf(b, "-include %s\n", depFile)
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// makeValue escapes a literal text used in the Makefile
+func makeValue(text string) string {
+	text = strings.ReplaceAll(text, "$", "$$")
+	return strings.ReplaceAll(text, "#", "\\#")
+}
+
+func makeWord(w Word) (string, bool) {
+	res := ""
+	hasVar := false
+	for _, p := range w {
+		switch {
+		case p.Var == SourceDir:
+			res += "."
+		case p.Var != "":
+			res += "$(" + p.Var + ")"
+			hasVar = true
+		default:
+			res += makeValue(p.Text)
+		}
+	}
+	return res, hasVar
+}
+
+func makeTarget(w Word) string {
+	res, _ := makeWord(w)
+	return strings.ReplaceAll(res, " ", "\\ ")
+}
+
+func makeTargets(words []Word) string {
+	res := ""
+	for _, w := range words {
+		res += " " + makeTarget(w)
+	}
+	return res
+}
+
+// makeCommand returns the command, quoted for the shell, preceded by a space
+func makeCommand(command []Word) string {
+	res := ""
+	for _, w := range command {
+		arg, hasVar := makeWord(w)
+		// the variables may contain spaces
+		if hasVar || arg == "" || strings.IndexFunc(arg, needsShellQuoting) != -1 {
+			arg = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+		}
+		res += " " + arg
+	}
+	return res
+}
+
+func needsShellQuoting(r rune) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		return false
+	}
+	return !strings.ContainsRune("-_=+/.,:@%^", r)
+}
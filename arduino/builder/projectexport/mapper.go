@@ -0,0 +1,220 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package projectexport
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/pkg/errors"
+)
+
+type rootKind int
+
+const (
+	// the files are copied in the project
+	sourcesRoot rootKind = iota
+	// the existing files are copied in the project, the others are ignored
+	existingSourcesRoot
+	// the existing files are copied in the project, the others are produced by
+	// the build in the build folder
+	buildRoot
+	// the files are referenced through a variable
+	variableRoot
+)
+
+type root struct {
+	path string
+	kind rootKind
+	dir  string
+	name string
+}
+
+// skippedDirs are the folders not copied in the project, since they are never
+// needed to build
+var skippedDirs = map[string]bool{
+	".git":         true,
+	".svn":         true,
+	"examples":     true,
+	"extras":       true,
+	".development": true,
+}
+
+// Mapper maps the absolute paths used by the commands of a build to the files
+// of the project, and keeps track of the files to copy in the project
+type Mapper struct {
+	roots  []*root
+	copies map[string]string
+}
+
+// NewMapper creates an empty Mapper
+func NewMapper() *Mapper {
+	return &Mapper{copies: map[string]string{}}
+}
+
+func (m *Mapper) add(r *root) {
+	m.roots = append(m.roots, r)
+	// the innermost folder wins
+	sort.SliceStable(m.roots, func(i, j int) bool { return len(m.roots[i].path) > len(m.roots[j].path) })
+}
+
+// AddSources copies the files of the folder, used by the build, in the given
+// folder of the project
+func (m *Mapper) AddSources(path *paths.Path, dir string) {
+	m.add(&root{path: path.String(), kind: sourcesRoot, dir: dir})
+}
+
+// AddExistingSources copies the files of the folder, used by the build, in the
+// given folder of the project. The paths of the folder not existing when the
+// project is exported are ignored, so that they can be mapped to the build
+// folder, for example the object files written next to the sources.
+func (m *Mapper) AddExistingSources(path *paths.Path, dir string) {
+	m.add(&root{path: path.String(), kind: existingSourcesRoot, dir: dir})
+}
+
+// AddBuildDir maps the folder to the build folder of the project. The files
+// already existing when the project is exported, like the ones generated by
+// the hooks of the platform, are copied in the given folder of the project.
+func (m *Mapper) AddBuildDir(path *paths.Path, generatedDir string) {
+	m.add(&root{path: path.String(), kind: buildRoot, dir: generatedDir, name: BuildDir})
+}
+
+// AddVariable maps the folder to the given variable
+func (m *Mapper) AddVariable(path *paths.Path, name string) {
+	m.add(&root{path: path.String(), kind: variableRoot, name: name})
+}
+
+// MapPath maps a path used by the build
+func (m *Mapper) MapPath(path *paths.Path) Word {
+	return m.Map(path.String())
+}
+
+// Map maps the paths contained in an argument of a command of the build. The
+// path of a folder is expected to extend to the end of the argument.
+func (m *Mapper) Map(arg string) Word {
+	res := Word{}
+	text := ""
+	for i := 0; i < len(arg); i++ {
+		if ref := m.mapPath(arg[i:]); ref != nil {
+			if text != "" {
+				res = append(res, Part{Text: text})
+			}
+			return append(res, ref...)
+		}
+		text += arg[i : i+1]
+	}
+	if text != "" {
+		res = append(res, Part{Text: text})
+	}
+	return res
+}
+
+func (m *Mapper) mapPath(path string) Word {
+	for _, r := range m.roots {
+		if !strings.HasPrefix(path, r.path) {
+			continue
+		}
+		rest := path[len(r.path):]
+		if rest != "" && rest[0] != '/' && rest[0] != '\\' {
+			continue
+		}
+		rest = filepath.ToSlash(rest)
+		switch r.kind {
+		case sourcesRoot:
+			return m.copy(path, r.dir+rest)
+		case existingSourcesRoot:
+			if paths.New(path).Exist() {
+				return m.copy(path, r.dir+rest)
+			}
+		case buildRoot:
+			if rest != "" && paths.New(path).IsNotDir() {
+				return m.copy(path, r.dir+rest)
+			}
+			return variableWord(r.name, rest)
+		case variableRoot:
+			return variableWord(r.name, rest)
+		}
+	}
+	return nil
+}
+
+func (m *Mapper) copy(path string, projectPath string) Word {
+	m.copies[path] = projectPath
+	return variableWord(SourceDir, "/"+projectPath)
+}
+
+func variableWord(name string, rest string) Word {
+	res := Word{{Var: name}}
+	if rest != "" {
+		res = append(res, Part{Text: rest})
+	}
+	return res
+}
+
+// CopyTo copies the files used by the build in the project folder. The
+// folders are copied with their subfolders.
+func (m *Mapper) CopyTo(projectDir *paths.Path) error {
+	sources := []string{}
+	for source := range m.copies {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+	for _, source := range sources {
+		target := projectDir.Join(filepath.FromSlash(m.copies[source]))
+		if err := copyTree(paths.New(source), target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func copyTree(source, target *paths.Path) error {
+	info, err := source.Stat()
+	if os.IsNotExist(err) {
+		// the build may reference missing folders, like an empty variant
+		return nil
+	} else if err != nil {
+		return errors.WithStack(err)
+	}
+	if !info.IsDir() {
+		if target.Exist() {
+			return nil
+		}
+		if err := target.Parent().MkdirAll(); err != nil {
+			return errors.WithStack(err)
+		}
+		return errors.WithStack(source.CopyTo(target))
+	}
+	files, err := source.ReadDir()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := target.MkdirAll(); err != nil {
+		return errors.WithStack(err)
+	}
+	for _, file := range files {
+		if file.IsDir() && skippedDirs[file.Base()] {
+			continue
+		}
+		if err := copyTree(file, target.Join(file.Base())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package projectexport describes the build of a sketch as a standalone
+// project, with the sources copied in the project folder and the commands of
+// the build written as a Makefile or as a CMakeLists.txt, so that the firmware
+// can be built without arduino-cli.
+package projectexport
+
+import (
+	"strings"
+)
+
+const (
+	// SourceDir is the variable referencing the root folder of the project
+	SourceDir = "SOURCE_DIR"
+	// BuildDir is the variable referencing the folder where the project is built
+	BuildDir = "BUILD_DIR"
+)
+
+// Variable is a variable of the build files of the project, like the path of
+// a tool, that can be changed when the project is built
+type Variable struct {
+	Name        string
+	Value       string
+	Description string
+}
+
+// Part is a piece of a Word: a literal text or a reference to a variable
+type Part struct {
+	Text string
+	Var  string
+}
+
+// Word is a path, or an argument of a command, of the project
+type Word []Part
+
+// String returns the word with the variables written as ${NAME}
+func (w Word) String() string {
+	res := ""
+	for _, p := range w {
+		if p.Var != "" {
+			res += "${" + p.Var + "}"
+		} else {
+			res += p.Text
+		}
+	}
+	return res
+}
+
+// IsPathIn returns true if the word is a path inside the folder referenced by
+// the given variable
+func (w Word) IsPathIn(variable string) bool {
+	return len(w) == 2 && w[0].Var == variable && strings.HasPrefix(w[1].Text, "/")
+}
+
+// dir returns the word without its last path element
+func (w Word) dir() Word {
+	if len(w) == 0 || w[len(w)-1].Var != "" {
+		return nil
+	}
+	last := w[len(w)-1].Text
+	i := strings.LastIndex(last, "/")
+	if i == -1 {
+		return nil
+	}
+	res := append(Word{}, w[:len(w)-1]...)
+	if i > 0 {
+		res = append(res, Part{Text: last[:i]})
+	}
+	return res
+}
+
+// Step is a step of the build of the project: the commands are run in order to
+// produce the outputs from the inputs
+type Step struct {
+	Inputs   []Word
+	Outputs  []Word
+	Commands [][]Word
+	// DepFile is the file, written by the compiler, listing the headers
+	// included by the source. It may be nil.
+	DepFile Word
+	// RemoveOutputs is true if the outputs must be deleted before running the
+	// commands, because the commands update them, like when the object files
+	// are added to an archive
+	RemoveOutputs bool
+}
+
+// Project is the build of a sketch exported as a standalone project
+type Project struct {
+	// Name is the name of the sketch
+	Name string
+	// FQBN is the board the project is built for
+	FQBN      string
+	Variables []*Variable
+	Steps     []*Step
+	// Final is run after all the steps, every time the project is built. Its
+	// commands, like the ones converting the executable to the format used by
+	// the upload tools, don't declare their outputs.
+	Final *Step
+}
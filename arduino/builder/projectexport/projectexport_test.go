@@ -0,0 +1,143 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package projectexport
+
+import (
+	"strings"
+	"testing"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapper(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "projectexport-test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	core := tmp.Join("hardware", "cores", "arduino")
+	require.NoError(t, core.Join("examples").MkdirAll())
+	require.NoError(t, core.Join("main.cpp").WriteFile([]byte("int main() {}")))
+	require.NoError(t, core.Join("Arduino.h").WriteFile([]byte("")))
+	require.NoError(t, core.Join("examples", "Blink.ino").WriteFile([]byte("")))
+	build := tmp.Join("build")
+	require.NoError(t, build.Join("sketch").MkdirAll())
+	require.NoError(t, build.Join("sketch", "Sketch.ino.cpp").WriteFile([]byte("")))
+	require.NoError(t, build.Join("build_opt.h").WriteFile([]byte("-DOPT")))
+
+	m := NewMapper()
+	m.AddBuildDir(build, "generated")
+	m.AddExistingSources(build.Join("sketch"), "sketch")
+	m.AddSources(tmp.Join("hardware"), "platform")
+	m.AddVariable(tmp.Join("tools", "gcc"), "TOOLS_GCC")
+
+	require.Equal(t, "${TOOLS_GCC}/bin/g++", m.Map(tmp.Join("tools", "gcc", "bin", "g++").String()).String())
+	require.Equal(t, "-I${SOURCE_DIR}/platform/cores/arduino", m.Map("-I"+core.String()).String())
+	require.Equal(t, "${SOURCE_DIR}/sketch/Sketch.ino.cpp", m.MapPath(build.Join("sketch", "Sketch.ino.cpp")).String())
+	// The object files don't exist, they are produced in the build folder
+	require.Equal(t, "${BUILD_DIR}/sketch/Sketch.ino.cpp.o", m.MapPath(build.Join("sketch", "Sketch.ino.cpp.o")).String())
+	require.Equal(t, "-L${BUILD_DIR}", m.Map("-L"+build.String()).String())
+	require.Equal(t, "@${SOURCE_DIR}/generated/build_opt.h", m.Map("@"+build.Join("build_opt.h").String()).String())
+	// Only whole path elements are mapped
+	require.Equal(t, tmp.Join("hardware2").String(), m.MapPath(tmp.Join("hardware2")).String())
+	require.Equal(t, "-Os", m.Map("-Os").String())
+
+	require.True(t, m.MapPath(build.Join("sketch", "Sketch.ino.cpp.o")).IsPathIn(BuildDir))
+	require.False(t, m.Map("-L"+build.String()).IsPathIn(BuildDir))
+
+	project := tmp.Join("project")
+	require.NoError(t, m.CopyTo(project))
+	files, err := project.ReadDirRecursive()
+	require.NoError(t, err)
+	files.FilterOutDirs()
+	files.Sort()
+	require.Equal(t, []string{
+		"generated/build_opt.h",
+		"platform/cores/arduino/Arduino.h",
+		"platform/cores/arduino/main.cpp",
+		"sketch/Sketch.ino.cpp",
+	}, relativePaths(t, files, project))
+}
+
+func relativePaths(t *testing.T, files paths.PathList, dir *paths.Path) []string {
+	res := []string{}
+	for _, file := range files {
+		rel, err := dir.RelTo(file)
+		require.NoError(t, err)
+		res = append(res, strings.ReplaceAll(rel.String(), "\\", "/"))
+	}
+	return res
+}
+
+func testProject() *Project {
+	source := Word{{Var: SourceDir}, {Text: "/sketch/My Sketch.ino.cpp"}}
+	object := Word{{Var: BuildDir}, {Text: "/sketch/My Sketch.ino.cpp.o"}}
+	elf := Word{{Var: BuildDir}, {Text: "/firmware.elf"}}
+	gcc := Word{{Var: "TOOLS_GCC"}, {Text: "/bin/gcc"}}
+	return &Project{
+		Name:      "MySketch",
+		FQBN:      "vendor:arch:board",
+		Variables: []*Variable{{Name: "TOOLS_GCC", Value: "/opt/gcc", Description: "Path of the gcc tool"}},
+		Steps: []*Step{
+			{
+				Inputs:   []Word{source},
+				Outputs:  []Word{object},
+				Commands: [][]Word{{gcc, {{Text: "-DNAME=\"$x\""}}, source, {{Text: "-o"}}, object}},
+				DepFile:  Word{{Var: BuildDir}, {Text: "/sketch/My Sketch.ino.cpp.d"}},
+			},
+			{
+				Inputs:   []Word{object},
+				Outputs:  []Word{elf},
+				Commands: [][]Word{{gcc, object, {{Text: "-o"}}, elf}},
+			},
+		},
+		Final: &Step{
+			Inputs:   []Word{elf},
+			Commands: [][]Word{{Word{{Text: "objcopy"}}, elf, {{Var: BuildDir}, {Text: "/firmware.bin"}}}},
+		},
+	}
+}
+
+func TestWriteMakefile(t *testing.T) {
+	b := &strings.Builder{}
+	require.NoError(t, WriteMakefile(b, testProject()))
+	makefile := b.String()
+	require.Contains(t, makefile, "BUILD_DIR ?= build\n")
+	require.Contains(t, makefile, "TOOLS_GCC ?= /opt/gcc\n")
+	require.Contains(t, makefile, "$(BUILD_DIR)/sketch/My\\ Sketch.ino.cpp.o: ./sketch/My\\ Sketch.ino.cpp\n"+
+		"\t@mkdir -p $(@D)\n"+
+		"\t'$(TOOLS_GCC)/bin/gcc' '-DNAME=\"$$x\"' './sketch/My Sketch.ino.cpp' -o '$(BUILD_DIR)/sketch/My Sketch.ino.cpp.o'\n")
+	require.Contains(t, makefile, "$(BUILD_DIR)/firmware.elf: $(BUILD_DIR)/sketch/My\\ Sketch.ino.cpp.o\n")
+	require.Contains(t, makefile, "firmware: $(BUILD_DIR)/firmware.elf\n\tobjcopy '$(BUILD_DIR)/firmware.elf' '$(BUILD_DIR)/firmware.bin'\n")
+	require.Contains(t, makefile, "-include $(BUILD_DIR)/sketch/My\\ Sketch.ino.cpp.d\n")
+}
+
+func TestWriteCMakeLists(t *testing.T) {
+	b := &strings.Builder{}
+	require.NoError(t, WriteCMakeLists(b, testProject()))
+	cmake := b.String()
+	require.Contains(t, cmake, `set(TOOLS_GCC "/opt/gcc" CACHE PATH "Path of the gcc tool")`)
+	require.Contains(t, cmake, "add_custom_command(\n"+
+		`  OUTPUT "${CMAKE_BINARY_DIR}/sketch/My Sketch.ino.cpp.o"`+"\n"+
+		`  COMMAND "${CMAKE_COMMAND}" -E make_directory "${CMAKE_BINARY_DIR}/sketch"`+"\n"+
+		`  COMMAND "${TOOLS_GCC}/bin/gcc" "-DNAME=\"\$x\"" "${CMAKE_SOURCE_DIR}/sketch/My Sketch.ino.cpp" "-o" "${CMAKE_BINARY_DIR}/sketch/My Sketch.ino.cpp.o"`+"\n"+
+		`  DEPENDS "${CMAKE_SOURCE_DIR}/sketch/My Sketch.ino.cpp"`+"\n"+
+		"  VERBATIM\n"+
+		")\n")
+	require.Contains(t, cmake, "add_custom_target(firmware ALL\n"+
+		`  COMMAND "objcopy" "${CMAKE_BINARY_DIR}/firmware.elf" "${CMAKE_BINARY_DIR}/firmware.bin"`+"\n"+
+		`  DEPENDS "${CMAKE_BINARY_DIR}/firmware.elf"`+"\n")
+}
@@ -0,0 +1,136 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package scheduler
+
+import (
+	"runtime"
+	"sync"
+)
+
+// Scheduler runs a graph of jobs: every job is started as soon as all its
+// dependencies are completed, running at most a fixed number of jobs at a time.
+// After the first failure the jobs not yet started are not run anymore.
+type Scheduler struct {
+	workers Workers
+	wg      sync.WaitGroup
+	errMux  sync.Mutex
+	err     error
+}
+
+// Job is a unit of work run by a Scheduler
+type Job struct {
+	done chan struct{}
+	err  error
+}
+
+// Workers is a pool of workers that may be shared by many Schedulers, to limit
+// the number of jobs running at the same time across all of them
+type Workers chan struct{}
+
+// NewWorkers creates a pool of the given number of workers. If workers is not
+// positive the number of CPUs is used.
+func NewWorkers(workers int) Workers {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	return make(Workers, workers)
+}
+
+// New creates a Scheduler running at most the given number of jobs at a time.
+// If workers is not positive the number of CPUs is used.
+func New(workers int) *Scheduler {
+	return NewWithWorkers(NewWorkers(workers))
+}
+
+// NewWithWorkers creates a Scheduler running its jobs on the given pool of
+// workers. The failure of a job stops only the Scheduler that runs it.
+func NewWithWorkers(workers Workers) *Scheduler {
+	return &Scheduler{
+		workers: workers,
+	}
+}
+
+// Add schedules run to be executed after all the dependencies are completed.
+// If a dependency fails, or the Scheduler has been stopped, run is not
+// executed and the job fails with the same error.
+func (s *Scheduler) Add(run func() error, deps ...*Job) *Job {
+	return s.add(run, deps)
+}
+
+// Barrier returns a job that completes when all the dependencies are completed.
+// The Barrier doesn't take up a worker.
+func (s *Scheduler) Barrier(deps ...*Job) *Job {
+	return s.add(nil, deps)
+}
+
+func (s *Scheduler) add(run func() error, deps []*Job) *Job {
+	job := &Job{done: make(chan struct{})}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer close(job.done)
+		for _, dep := range deps {
+			if err := dep.Wait(); err != nil {
+				job.err = err
+				return
+			}
+		}
+		if run == nil {
+			return
+		}
+
+		s.workers <- struct{}{}
+		defer func() { <-s.workers }()
+		if err := s.Err(); err != nil {
+			job.err = err
+			return
+		}
+		if err := run(); err != nil {
+			job.err = err
+			s.Stop(err)
+		}
+	}()
+	return job
+}
+
+// Stop prevents the jobs not yet started from running, they will fail with
+// the given error. If the Scheduler is already stopped it does nothing.
+func (s *Scheduler) Stop(err error) {
+	s.errMux.Lock()
+	defer s.errMux.Unlock()
+	if s.err == nil {
+		s.err = err
+	}
+}
+
+// Err returns the error that stopped the Scheduler, or nil if it's still running
+func (s *Scheduler) Err() error {
+	s.errMux.Lock()
+	defer s.errMux.Unlock()
+	return s.err
+}
+
+// Wait waits for the completion of all the scheduled jobs and returns the first error
+func (s *Scheduler) Wait() error {
+	s.wg.Wait()
+	return s.Err()
+}
+
+// Wait waits for the completion of the job and returns its error
+func (j *Job) Wait() error {
+	<-j.done
+	return j.err
+}
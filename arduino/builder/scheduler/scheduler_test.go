@@ -0,0 +1,137 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package scheduler
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedulerRespectsWorkersLimit(t *testing.T) {
+	s := New(3)
+	var running, maxRunning int32
+	for i := 0; i < 20; i++ {
+		s.Add(func() error {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				max := atomic.LoadInt32(&maxRunning)
+				if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return nil
+		})
+	}
+	require.NoError(t, s.Wait())
+	require.Equal(t, int32(3), maxRunning)
+}
+
+func TestSchedulerDependencies(t *testing.T) {
+	s := New(4)
+	var mux sync.Mutex
+	order := []string{}
+	record := func(name string) func() error {
+		return func() error {
+			time.Sleep(5 * time.Millisecond)
+			mux.Lock()
+			order = append(order, name)
+			mux.Unlock()
+			return nil
+		}
+	}
+
+	a1 := s.Add(record("a1"))
+	a2 := s.Add(record("a2"))
+	archiveA := s.Add(record("archiveA"), a1, a2)
+	b := s.Add(record("b"))
+	link := s.Add(record("link"), s.Barrier(archiveA, b))
+	require.NoError(t, link.Wait())
+	require.NoError(t, s.Wait())
+
+	require.Len(t, order, 5)
+	indexOf := func(name string) int {
+		for i, n := range order {
+			if n == name {
+				return i
+			}
+		}
+		return -1
+	}
+	require.Greater(t, indexOf("archiveA"), indexOf("a1"))
+	require.Greater(t, indexOf("archiveA"), indexOf("a2"))
+	require.Equal(t, 4, indexOf("link"))
+}
+
+func TestSchedulerStopsOnError(t *testing.T) {
+	s := New(1)
+	failure := errors.New("compile error")
+	started := make(chan struct{})
+	release := make(chan struct{})
+	failing := s.Add(func() error {
+		close(started)
+		<-release
+		return failure
+	})
+	<-started
+	executed := false
+	other := s.Add(func() error {
+		executed = true
+		return nil
+	})
+	dependent := s.Add(func() error { return nil }, failing)
+	close(release)
+
+	require.Equal(t, failure, failing.Wait())
+	require.Equal(t, failure, other.Wait())
+	require.Equal(t, failure, dependent.Wait())
+	require.Equal(t, failure, s.Wait())
+	require.False(t, executed)
+}
+
+func TestSchedulersSharingWorkers(t *testing.T) {
+	workers := NewWorkers(2)
+	s1 := NewWithWorkers(workers)
+	s2 := NewWithWorkers(workers)
+	var running, maxRunning int32
+	job := func() error {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return nil
+	}
+	failure := errors.New("compile error")
+	s1.Add(func() error { return failure })
+	for i := 0; i < 10; i++ {
+		s1.Add(job)
+		s2.Add(job)
+	}
+	require.Equal(t, failure, s1.Wait())
+	require.NoError(t, s2.Wait())
+	require.Equal(t, int32(2), maxRunning)
+}
@@ -0,0 +1,102 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sizereport
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+const archiveMagic = "!<arch>\n"
+
+// archiveMember is a file contained in a static library
+type archiveMember struct {
+	Name string
+	Data []byte
+}
+
+// isArchive returns true if data is a static library (in the common "ar" format)
+func isArchive(data []byte) bool {
+	return bytes.HasPrefix(data, []byte(archiveMagic))
+}
+
+// readArchive returns the members of a static library, both the GNU and the BSD
+// variants of the "ar" format are supported. The symbol table and the long names
+// table are not returned.
+func readArchive(data []byte) ([]*archiveMember, error) {
+	if !isArchive(data) {
+		return nil, errors.New(tr("invalid archive: missing header"))
+	}
+	res := []*archiveMember{}
+	longNames := []byte{}
+	pos := len(archiveMagic)
+	for pos < len(data) {
+		if pos+60 > len(data) {
+			return nil, errors.New(tr("invalid archive: truncated member header"))
+		}
+		header := data[pos : pos+60]
+		if string(header[58:60]) != "`\n" {
+			return nil, errors.New(tr("invalid archive: invalid member header"))
+		}
+		size, err := strconv.Atoi(strings.TrimSpace(string(header[48:58])))
+		if err != nil || size < 0 {
+			return nil, errors.New(tr("invalid archive: invalid member size"))
+		}
+		pos += 60
+		if pos+size > len(data) {
+			return nil, errors.New(tr("invalid archive: truncated member"))
+		}
+		content := data[pos : pos+size]
+		pos += size
+		if pos%2 == 1 {
+			pos++
+		}
+
+		name := strings.TrimRight(string(header[0:16]), " ")
+		switch {
+		case name == "/" || name == "/SYM64/" || strings.HasPrefix(name, "__.SYMDEF"):
+			// symbol table
+			continue
+		case name == "//":
+			longNames = content
+			continue
+		case strings.HasPrefix(name, "#1/"):
+			// BSD: the name is stored at the beginning of the data
+			nameLen, err := strconv.Atoi(name[3:])
+			if err != nil || nameLen > len(content) {
+				return nil, errors.New(tr("invalid archive: invalid member name"))
+			}
+			name = strings.TrimRight(string(content[:nameLen]), "\x00")
+			content = content[nameLen:]
+		case strings.HasPrefix(name, "/"):
+			// GNU: the name is stored in the long names table
+			offset, err := strconv.Atoi(name[1:])
+			if err != nil || offset > len(longNames) {
+				return nil, errors.New(tr("invalid archive: invalid member name"))
+			}
+			name = string(longNames[offset:])
+			if end := strings.Index(name, "/\n"); end != -1 {
+				name = name[:end]
+			}
+		default:
+			name = strings.TrimSuffix(name, "/")
+		}
+		res = append(res, &archiveMember{Name: name, Data: content})
+	}
+	return res, nil
+}
@@ -0,0 +1,218 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sizereport
+
+import (
+	"sort"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// Comparison is the difference of the memory used by two executables
+type Comparison struct {
+	PreviousFlash int
+	Flash         int
+	PreviousRAM   int
+	RAM           int
+	// Sections is the size of the groups of sections of the two executables
+	Sections []*SectionDelta
+	// Components are the components whose memory usage changed, sorted by
+	// the size of the change
+	Components []*UsageDelta
+	// Symbols are the symbols whose memory usage changed, sorted by the size
+	// of the change
+	Symbols []*UsageDelta
+}
+
+// SectionDelta is the size of a group of sections in the two executables
+type SectionDelta struct {
+	Name         string
+	PreviousSize int
+	Size         int
+}
+
+// UsageDelta is the memory used by a component, or by a symbol, in the two
+// executables
+type UsageDelta struct {
+	Name string
+	Kind string
+	// Component is the name of the component defining the symbol, empty for
+	// the components
+	Component     string
+	PreviousFlash int
+	Flash         int
+	PreviousRAM   int
+	RAM           int
+}
+
+// FlashDelta returns the change of the flash memory used
+func (d *UsageDelta) FlashDelta() int {
+	return d.Flash - d.PreviousFlash
+}
+
+// RAMDelta returns the change of the RAM used
+func (d *UsageDelta) RAMDelta() int {
+	return d.RAM - d.PreviousRAM
+}
+
+func (d *UsageDelta) changed() bool {
+	return d.FlashDelta() != 0 || d.RAMDelta() != 0
+}
+
+// Compare returns the difference of the memory used by the current executable
+// from the previous one. The components are matched by kind and name, the
+// symbols by name and component since the object files are in different
+// folders.
+func Compare(previous, current *Report) *Comparison {
+	res := &Comparison{
+		PreviousFlash: previous.Flash,
+		Flash:         current.Flash,
+		PreviousRAM:   previous.RAM,
+		RAM:           current.RAM,
+	}
+
+	sections := map[string]*SectionDelta{}
+	addSection := func(name string) *SectionDelta {
+		if s, ok := sections[name]; ok {
+			return s
+		}
+		s := &SectionDelta{Name: name}
+		sections[name] = s
+		res.Sections = append(res.Sections, s)
+		return s
+	}
+	for _, s := range previous.Sections {
+		addSection(s.Name).PreviousSize += s.Size
+	}
+	for _, s := range current.Sections {
+		addSection(s.Name).Size += s.Size
+	}
+
+	components := map[string]*UsageDelta{}
+	componentDelta := func(name, kind string) *UsageDelta {
+		key := kind + ":" + name
+		if d, ok := components[key]; ok {
+			return d
+		}
+		d := &UsageDelta{Name: name, Kind: kind}
+		components[key] = d
+		res.Components = append(res.Components, d)
+		return d
+	}
+	for _, c := range previous.Components {
+		d := componentDelta(c.Name, c.Kind)
+		d.PreviousFlash += c.Flash
+		d.PreviousRAM += c.RAM
+	}
+	for _, c := range current.Components {
+		d := componentDelta(c.Name, c.Kind)
+		d.Flash += c.Flash
+		d.RAM += c.RAM
+	}
+
+	symbols := map[string]*UsageDelta{}
+	symbolDelta := func(name, component string) *UsageDelta {
+		key := component + ":" + name
+		if d, ok := symbols[key]; ok {
+			return d
+		}
+		d := &UsageDelta{Name: name, Component: component}
+		symbols[key] = d
+		res.Symbols = append(res.Symbols, d)
+		return d
+	}
+	for _, s := range previous.Symbols {
+		d := symbolDelta(s.Name, s.Component)
+		d.PreviousFlash += s.Flash
+		d.PreviousRAM += s.RAM
+	}
+	for _, s := range current.Symbols {
+		d := symbolDelta(s.Name, s.Component)
+		d.Flash += s.Flash
+		d.RAM += s.RAM
+	}
+
+	res.Components = sortDeltas(res.Components)
+	res.Symbols = sortDeltas(res.Symbols)
+	return res
+}
+
+// sortDeltas removes the unchanged deltas and sorts the others by the size of
+// the change, the biggest first
+func sortDeltas(deltas []*UsageDelta) []*UsageDelta {
+	res := []*UsageDelta{}
+	for _, d := range deltas {
+		if d.changed() {
+			res = append(res, d)
+		}
+	}
+	size := func(d *UsageDelta) int {
+		return abs(d.FlashDelta()) + abs(d.RAMDelta())
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		if size(res[i]) != size(res[j]) {
+			return size(res[i]) > size(res[j])
+		}
+		return res[i].Name < res[j].Name
+	})
+	return res
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// ToRPC converts the Comparison into a *rpc.SizeComparison
+func (c *Comparison) ToRPC() *rpc.SizeComparison {
+	if c == nil {
+		return nil
+	}
+	res := &rpc.SizeComparison{
+		PreviousFlash: int64(c.PreviousFlash),
+		Flash:         int64(c.Flash),
+		PreviousRam:   int64(c.PreviousRAM),
+		Ram:           int64(c.RAM),
+	}
+	for _, s := range c.Sections {
+		res.Sections = append(res.Sections, &rpc.SectionSizeDelta{
+			Name:         s.Name,
+			PreviousSize: int64(s.PreviousSize),
+			Size:         int64(s.Size),
+		})
+	}
+	for _, d := range c.Components {
+		res.Components = append(res.Components, d.toRPC())
+	}
+	for _, d := range c.Symbols {
+		res.Symbols = append(res.Symbols, d.toRPC())
+	}
+	return res
+}
+
+func (d *UsageDelta) toRPC() *rpc.MemoryUsageDelta {
+	return &rpc.MemoryUsageDelta{
+		Name:          d.Name,
+		Kind:          d.Kind,
+		Component:     d.Component,
+		PreviousFlash: int64(d.PreviousFlash),
+		Flash:         int64(d.Flash),
+		PreviousRam:   int64(d.PreviousRAM),
+		Ram:           int64(d.RAM),
+	}
+}
@@ -0,0 +1,87 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sizereport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompare(t *testing.T) {
+	previous := &Report{
+		Flash: 1000,
+		RAM:   100,
+		Components: []*Usage{
+			{Name: KindSketch, Kind: KindSketch, Flash: 600, RAM: 60},
+			{Name: KindCore, Kind: KindCore, Flash: 400, RAM: 40},
+		},
+		Symbols: []*Symbol{
+			{Name: "setup", Component: KindSketch, Flash: 100},
+			{Name: "buffer", Component: KindSketch, RAM: 60},
+			{Name: "init", Component: KindCore, Flash: 400, RAM: 40},
+		},
+		Sections: []*Section{{Name: SectionText, Size: 900}, {Name: SectionData, Size: 100}},
+	}
+	current := &Report{
+		Flash: 2200,
+		RAM:   120,
+		Components: []*Usage{
+			{Name: KindSketch, Kind: KindSketch, Flash: 400, RAM: 60},
+			{Name: "ArduinoJson", Kind: KindLibrary, Flash: 1400, RAM: 20},
+			{Name: KindCore, Kind: KindCore, Flash: 400, RAM: 40},
+		},
+		Symbols: []*Symbol{
+			{Name: "setup", Component: KindSketch, Flash: 150},
+			{Name: "buffer", Component: KindSketch, RAM: 60},
+			{Name: "parse", Component: "ArduinoJson", Flash: 1400, RAM: 20},
+			{Name: "init", Component: KindCore, Flash: 400, RAM: 40},
+		},
+		Sections: []*Section{{Name: SectionText, Size: 2100}, {Name: SectionData, Size: 100}, {Name: SectionBSS, Size: 20}},
+	}
+
+	c := Compare(previous, current)
+	require.Equal(t, 1000, c.PreviousFlash)
+	require.Equal(t, 2200, c.Flash)
+	require.Equal(t, 100, c.PreviousRAM)
+	require.Equal(t, 120, c.RAM)
+	require.Equal(t, []*SectionDelta{
+		{Name: SectionText, PreviousSize: 900, Size: 2100},
+		{Name: SectionData, PreviousSize: 100, Size: 100},
+		{Name: SectionBSS, PreviousSize: 0, Size: 20},
+	}, c.Sections)
+
+	// The unchanged components and symbols are not listed
+	require.Len(t, c.Components, 2)
+	require.Equal(t, "ArduinoJson", c.Components[0].Name)
+	require.Equal(t, KindLibrary, c.Components[0].Kind)
+	require.Equal(t, 1400, c.Components[0].FlashDelta())
+	require.Equal(t, 20, c.Components[0].RAMDelta())
+	require.Equal(t, KindSketch, c.Components[1].Name)
+	require.Equal(t, -200, c.Components[1].FlashDelta())
+
+	require.Len(t, c.Symbols, 2)
+	require.Equal(t, "parse", c.Symbols[0].Name)
+	require.Equal(t, "ArduinoJson", c.Symbols[0].Component)
+	require.Equal(t, 0, c.Symbols[0].PreviousFlash)
+	require.Equal(t, "setup", c.Symbols[1].Name)
+	require.Equal(t, 50, c.Symbols[1].FlashDelta())
+
+	rpcComparison := c.ToRPC()
+	require.Equal(t, int64(2200), rpcComparison.GetFlash())
+	require.Len(t, rpcComparison.GetSections(), 3)
+	require.Equal(t, int64(1400), rpcComparison.GetComponents()[0].GetFlash())
+}
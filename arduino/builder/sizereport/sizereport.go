@@ -0,0 +1,462 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sizereport
+
+import (
+	"bytes"
+	"debug/dwarf"
+	"debug/elf"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/i18n"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/pkg/errors"
+)
+
+var tr = i18n.Tr
+
+// Kind of a Component
+const (
+	KindSketch  = "sketch"
+	KindLibrary = "library"
+	KindCore    = "core"
+	KindOther   = "other"
+)
+
+// Component is a part of the build that owns some symbols: the sketch,
+// a library, the core or anything else linked in the executable.
+type Component struct {
+	Name string
+	Kind string
+}
+
+// ClassifyFunc returns the Component owning the given file. The file may be
+// either a source file or an object file (or static library) given to the linker.
+type ClassifyFunc func(file *paths.Path) *Component
+
+// Report is the memory usage of an executable
+type Report struct {
+	// Flash is the total amount of flash memory used, computed from the sections of the executable
+	Flash int
+	// RAM is the total amount of RAM used, computed from the sections of the executable
+	RAM int
+	// Components is the memory used by each component, sorted by size
+	Components []*Usage
+	// Objects is the memory used by each object file (or source file), sorted by size
+	Objects []*Usage
+	// Symbols is the memory used by each symbol, sorted by size
+	Symbols []*Symbol
+	// Sections is the size of the sections of the executable, grouped as the
+	// `size` tool does: text, data, bss and eeprom
+	Sections []*Section
+}
+
+// Group of sections of the executable
+const (
+	SectionText   = "text"
+	SectionData   = "data"
+	SectionBSS    = "bss"
+	SectionEEPROM = "eeprom"
+)
+
+// Section is the total size of a group of sections of the executable
+type Section struct {
+	Name string
+	Size int
+}
+
+// Usage is the memory used by a component or by an object file
+type Usage struct {
+	Name  string
+	Kind  string
+	Flash int
+	RAM   int
+}
+
+// Symbol is the memory used by a symbol of the executable
+type Symbol struct {
+	Name      string
+	Section   string
+	Object    string
+	Component string
+	Flash     int
+	RAM       int
+}
+
+type owner struct {
+	object    string
+	component *Component
+}
+
+// Analyze reads the ELF executable and computes the memory used by each symbol,
+// attributing it to the object file and the Component that defined it.
+// The owner of a symbol is found using the debugging informations of the
+// executable, when available, or searching the symbol in the inputs (the object
+// files and static libraries given to the linker).
+func Analyze(executable *paths.Path, inputs paths.PathList, classify ClassifyFunc) (*Report, error) {
+	f, err := elf.Open(executable.String())
+	if err != nil {
+		return nil, errors.Errorf(tr("reading executable %[1]s: %[2]s"), executable, err)
+	}
+	defer f.Close()
+
+	res := &Report{}
+	sections := map[string]*Section{}
+	for _, name := range []string{SectionText, SectionData, SectionBSS, SectionEEPROM} {
+		sections[name] = &Section{Name: name}
+		res.Sections = append(res.Sections, sections[name])
+	}
+	for _, section := range f.Sections {
+		if group := sectionGroup(section); group != "" {
+			sections[group].Size += int(section.Size)
+		}
+		flash, ram := sectionUsage(section)
+		if flash {
+			res.Flash += int(section.Size)
+		}
+		if ram {
+			res.RAM += int(section.Size)
+		}
+	}
+
+	symbols, err := f.Symbols()
+	if err != nil {
+		if err == elf.ErrNoSymbols {
+			return res, nil
+		}
+		return nil, errors.Errorf(tr("reading symbols of %[1]s: %[2]s"), executable, err)
+	}
+
+	debugInfo := newDebugInfo(f)
+	var objectsSymbols *symbolOwners
+	findOwner := func(symbol elf.Symbol) *owner {
+		addr := symbol.Value
+		if f.Machine == elf.EM_ARM && elf.ST_TYPE(symbol.Info) == elf.STT_FUNC {
+			// Clear the Thumb bit
+			addr &^= 1
+		}
+		if file := debugInfo.fileAt(addr); file != "" {
+			return &owner{object: file, component: classify(paths.New(file))}
+		}
+		if objectsSymbols == nil {
+			objectsSymbols = loadSymbolOwners(inputs, classify)
+		}
+		return objectsSymbols.find(symbol.Name)
+	}
+
+	type symbolKey struct {
+		section int
+		addr    uint64
+		size    uint64
+	}
+	seen := map[symbolKey]bool{}
+	components := map[string]*Usage{}
+	objects := map[string]*Usage{}
+	for _, symbol := range symbols {
+		if symbol.Size == 0 || symbol.Name == "" {
+			continue
+		}
+		if t := elf.ST_TYPE(symbol.Info); t != elf.STT_FUNC && t != elf.STT_OBJECT {
+			continue
+		}
+		if symbol.Section == elf.SHN_UNDEF || int(symbol.Section) >= len(f.Sections) {
+			continue
+		}
+		section := f.Sections[symbol.Section]
+		flash, ram := sectionUsage(section)
+		if !flash && !ram {
+			continue
+		}
+
+		// Skip aliases
+		key := symbolKey{section: int(symbol.Section), addr: symbol.Value, size: symbol.Size}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		sym := &Symbol{Name: symbol.Name, Section: section.Name}
+		if flash {
+			sym.Flash = int(symbol.Size)
+		}
+		if ram {
+			sym.RAM = int(symbol.Size)
+		}
+		component := &Component{Name: KindOther, Kind: KindOther}
+		if o := findOwner(symbol); o != nil {
+			sym.Object = o.object
+			if o.component != nil {
+				component = o.component
+			}
+		}
+		sym.Component = component.Name
+		res.Symbols = append(res.Symbols, sym)
+
+		c, ok := components[component.Kind+":"+component.Name]
+		if !ok {
+			c = &Usage{Name: component.Name, Kind: component.Kind}
+			components[component.Kind+":"+component.Name] = c
+			res.Components = append(res.Components, c)
+		}
+		c.Flash += sym.Flash
+		c.RAM += sym.RAM
+
+		if sym.Object != "" {
+			o, ok := objects[sym.Object]
+			if !ok {
+				o = &Usage{Name: sym.Object, Kind: component.Kind}
+				objects[sym.Object] = o
+				res.Objects = append(res.Objects, o)
+			}
+			o.Flash += sym.Flash
+			o.RAM += sym.RAM
+		}
+	}
+
+	sort.SliceStable(res.Symbols, func(i, j int) bool {
+		return res.Symbols[i].Flash+res.Symbols[i].RAM > res.Symbols[j].Flash+res.Symbols[j].RAM
+	})
+	sortUsages(res.Components)
+	sortUsages(res.Objects)
+	return res, nil
+}
+
+func sortUsages(usages []*Usage) {
+	sort.SliceStable(usages, func(i, j int) bool {
+		return usages[i].Flash+usages[i].RAM > usages[j].Flash+usages[j].RAM
+	})
+}
+
+// sectionUsage returns which memories are used by the section: the initialized
+// sections are stored in flash, the writable ones are copied to (or allocated in) RAM.
+func sectionUsage(section *elf.Section) (flash bool, ram bool) {
+	if section.Flags&elf.SHF_ALLOC == 0 || section.Size == 0 {
+		return false, false
+	}
+	if strings.HasPrefix(section.Name, ".eeprom") {
+		return false, false
+	}
+	flash = section.Type != elf.SHT_NOBITS
+	ram = section.Flags&elf.SHF_WRITE != 0
+	return
+}
+
+// sectionGroup returns the group of the section (text, data, bss or eeprom),
+// or an empty string if the section is not loaded in the memory of the board
+func sectionGroup(section *elf.Section) string {
+	switch {
+	case strings.HasPrefix(section.Name, ".eeprom"):
+		return SectionEEPROM
+	case section.Flags&elf.SHF_ALLOC == 0:
+		return ""
+	case section.Type == elf.SHT_NOBITS:
+		return SectionBSS
+	case section.Flags&elf.SHF_WRITE != 0:
+		return SectionData
+	default:
+		return SectionText
+	}
+}
+
+// debugInfo maps the addresses of the executable to the source files that
+// defined them, using the DWARF informations.
+type debugInfo struct {
+	ranges    []addressRange
+	variables map[uint64]string
+}
+
+type addressRange struct {
+	low, high uint64
+	file      string
+}
+
+func newDebugInfo(f *elf.File) *debugInfo {
+	res := &debugInfo{variables: map[uint64]string{}}
+	data, err := f.DWARF()
+	if err != nil {
+		return res
+	}
+	addrSize := 4
+	if f.Class == elf.ELFCLASS64 {
+		addrSize = 8
+	}
+
+	reader := data.Reader()
+	currentFile := ""
+	for {
+		entry, err := reader.Next()
+		if err != nil || entry == nil {
+			break
+		}
+		switch entry.Tag {
+		case dwarf.TagCompileUnit:
+			currentFile = ""
+			name, _ := entry.Val(dwarf.AttrName).(string)
+			if name == "" {
+				continue
+			}
+			file := paths.New(name)
+			if compDir, ok := entry.Val(dwarf.AttrCompDir).(string); ok && !file.IsAbs() && !strings.HasPrefix(name, "/") {
+				file = paths.New(compDir, name)
+			}
+			currentFile = file.Clean().String()
+			if ranges, err := data.Ranges(entry); err == nil {
+				for _, r := range ranges {
+					if r[1] > r[0] {
+						res.ranges = append(res.ranges, addressRange{low: r[0], high: r[1], file: currentFile})
+					}
+				}
+			}
+		case dwarf.TagVariable:
+			if currentFile == "" {
+				continue
+			}
+			location, ok := entry.Val(dwarf.AttrLocation).([]byte)
+			// Only static variables are interesting: DW_OP_addr <address>
+			if !ok || len(location) != 1+addrSize || location[0] != 0x03 {
+				continue
+			}
+			if addrSize == 8 {
+				res.variables[f.ByteOrder.Uint64(location[1:])] = currentFile
+			} else {
+				res.variables[uint64(f.ByteOrder.Uint32(location[1:]))] = currentFile
+			}
+		}
+	}
+	sort.Slice(res.ranges, func(i, j int) bool { return res.ranges[i].low < res.ranges[j].low })
+	return res
+}
+
+// fileAt returns the source file that defined the given address, or an empty
+// string if not found
+func (d *debugInfo) fileAt(addr uint64) string {
+	if file, ok := d.variables[addr]; ok {
+		return file
+	}
+	// ranges are sorted by start address, the nearest one is the most likely
+	i := sort.Search(len(d.ranges), func(i int) bool { return d.ranges[i].low > addr })
+	for i--; i >= 0; i-- {
+		if r := d.ranges[i]; addr < r.high {
+			return r.file
+		}
+	}
+	return ""
+}
+
+// symbolOwners maps the global symbols defined in the inputs of the linker to
+// the object file that defined them
+type symbolOwners struct {
+	owners map[string]*owner
+	weak   map[string]bool
+}
+
+func loadSymbolOwners(inputs paths.PathList, classify ClassifyFunc) *symbolOwners {
+	res := &symbolOwners{owners: map[string]*owner{}, weak: map[string]bool{}}
+	for _, input := range inputs {
+		data, err := input.ReadFile()
+		if err != nil {
+			continue
+		}
+		component := classify(input)
+		if !isArchive(data) {
+			res.addObject(data, &owner{object: input.String(), component: component})
+			continue
+		}
+		members, err := readArchive(data)
+		if err != nil {
+			continue
+		}
+		for _, member := range members {
+			res.addObject(member.Data, &owner{
+				object:    input.String() + "(" + member.Name + ")",
+				component: component,
+			})
+		}
+	}
+	return res
+}
+
+func (s *symbolOwners) addObject(data []byte, o *owner) {
+	f, err := elf.NewFile(bytes.NewReader(data))
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	symbols, err := f.Symbols()
+	if err != nil {
+		return
+	}
+	for _, symbol := range symbols {
+		if symbol.Section == elf.SHN_UNDEF || symbol.Section == elf.SHN_COMMON && symbol.Size == 0 {
+			continue
+		}
+		bind := elf.ST_BIND(symbol.Info)
+		if bind != elf.STB_GLOBAL && bind != elf.STB_WEAK {
+			continue
+		}
+		if _, exists := s.owners[symbol.Name]; exists {
+			// A strong definition overrides a weak one
+			if !s.weak[symbol.Name] || bind == elf.STB_WEAK {
+				continue
+			}
+		}
+		s.owners[symbol.Name] = o
+		s.weak[symbol.Name] = bind == elf.STB_WEAK
+	}
+}
+
+func (s *symbolOwners) find(name string) *owner {
+	return s.owners[name]
+}
+
+// ToRPC converts the Report into a *rpc.MemoryUsageReport
+func (r *Report) ToRPC() *rpc.MemoryUsageReport {
+	if r == nil {
+		return nil
+	}
+	res := &rpc.MemoryUsageReport{
+		Flash: int64(r.Flash),
+		Ram:   int64(r.RAM),
+	}
+	for _, c := range r.Components {
+		res.Components = append(res.Components, c.toRPC())
+	}
+	for _, o := range r.Objects {
+		res.Objects = append(res.Objects, o.toRPC())
+	}
+	for _, s := range r.Symbols {
+		res.Symbols = append(res.Symbols, &rpc.SymbolMemoryUsage{
+			Name:      s.Name,
+			Section:   s.Section,
+			Object:    s.Object,
+			Component: s.Component,
+			Flash:     int64(s.Flash),
+			Ram:       int64(s.RAM),
+		})
+	}
+	return res
+}
+
+func (u *Usage) toRPC() *rpc.MemoryUsage {
+	return &rpc.MemoryUsage{
+		Name:  u.Name,
+		Kind:  u.Kind,
+		Flash: int64(u.Flash),
+		Ram:   int64(u.RAM),
+	}
+}
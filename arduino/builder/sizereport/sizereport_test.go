@@ -0,0 +1,161 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sizereport
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+// The test executables are generated with testdata/build.sh
+
+func classifyTestFile(file *paths.Path) *Component {
+	switch {
+	case file.Base() == "sketch.c" || file.Base() == "sketch.c.o":
+		return &Component{Name: KindSketch, Kind: KindSketch}
+	case file.Base() == "core.c" || file.Base() == "core.a":
+		return &Component{Name: KindCore, Kind: KindCore}
+	}
+	return &Component{Name: KindOther, Kind: KindOther}
+}
+
+func findSymbol(report *Report, name string) *Symbol {
+	for _, s := range report.Symbols {
+		if s.Name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+func findUsage(usages []*Usage, name string) *Usage {
+	for _, u := range usages {
+		if u.Name == name {
+			return u
+		}
+	}
+	return nil
+}
+
+func testAnalyze(t *testing.T, variant string) *Report {
+	dir := paths.New("testdata", variant)
+	report, err := Analyze(dir.Join("sketch.elf"), paths.NewPathList(dir.Join("sketch.c.o").String(), dir.Join("core.a").String()), classifyTestFile)
+	require.NoError(t, err)
+
+	table := findSymbol(report, "table")
+	require.NotNil(t, table)
+	require.Equal(t, ".data", table.Section)
+	require.Equal(t, 64, table.Flash)
+	require.Equal(t, 64, table.RAM)
+	require.Equal(t, KindSketch, table.Component)
+
+	counter := findSymbol(report, "counter")
+	require.NotNil(t, counter)
+	require.Equal(t, 0, counter.Flash)
+	require.Equal(t, 4, counter.RAM)
+	require.Equal(t, KindSketch, counter.Component)
+
+	buffer := findSymbol(report, "core_buffer")
+	require.NotNil(t, buffer)
+	require.Equal(t, 0, buffer.Flash)
+	require.Equal(t, 64, buffer.RAM)
+	require.Equal(t, KindCore, buffer.Component)
+
+	message := findSymbol(report, "core_message")
+	require.NotNil(t, message)
+	require.Equal(t, 20, message.Flash)
+	require.Equal(t, 0, message.RAM)
+	require.Equal(t, KindCore, message.Component)
+
+	coreFunction := findSymbol(report, "core_function")
+	require.NotNil(t, coreFunction)
+	require.Equal(t, KindCore, coreFunction.Component)
+	mainFunction := findSymbol(report, "main")
+	require.NotNil(t, mainFunction)
+	require.Equal(t, KindSketch, mainFunction.Component)
+
+	sketch := findUsage(report.Components, KindSketch)
+	require.NotNil(t, sketch)
+	require.Equal(t, table.Flash+mainFunction.Flash, sketch.Flash)
+	require.Equal(t, table.RAM+counter.RAM, sketch.RAM)
+	core := findUsage(report.Components, KindCore)
+	require.NotNil(t, core)
+	require.Equal(t, message.Flash+coreFunction.Flash, core.Flash)
+	require.Equal(t, buffer.RAM, core.RAM)
+
+	require.GreaterOrEqual(t, report.Flash, sketch.Flash+core.Flash)
+	require.GreaterOrEqual(t, report.RAM, sketch.RAM+core.RAM)
+
+	sections := map[string]int{}
+	for _, s := range report.Sections {
+		sections[s.Name] = s.Size
+	}
+	require.GreaterOrEqual(t, sections[SectionText], mainFunction.Flash+coreFunction.Flash+message.Flash)
+	require.GreaterOrEqual(t, sections[SectionData], table.RAM)
+	require.GreaterOrEqual(t, sections[SectionBSS], counter.RAM+buffer.RAM)
+	require.Equal(t, 0, sections[SectionEEPROM])
+	require.Equal(t, report.RAM, sections[SectionData]+sections[SectionBSS])
+
+	// Symbols are sorted by size
+	for i := 1; i < len(report.Symbols); i++ {
+		prev, curr := report.Symbols[i-1], report.Symbols[i]
+		require.GreaterOrEqual(t, prev.Flash+prev.RAM, curr.Flash+curr.RAM)
+	}
+	return report
+}
+
+func TestAnalyzeWithDebugInformations(t *testing.T) {
+	report := testAnalyze(t, "debug")
+	// The owners are the source files found in the debugging informations
+	require.Equal(t, "core.c", paths.New(findSymbol(report, "core_buffer").Object).Base())
+	require.Equal(t, "core.c", paths.New(findSymbol(report, "core_function").Object).Base())
+	require.Equal(t, "sketch.c", paths.New(findSymbol(report, "counter").Object).Base())
+	require.Equal(t, "sketch.c", paths.New(findSymbol(report, "main").Object).Base())
+	require.NotNil(t, findUsage(report.Objects, findSymbol(report, "main").Object))
+}
+
+func TestAnalyzeWithoutDebugInformations(t *testing.T) {
+	report := testAnalyze(t, "nodebug")
+	require.Equal(t, paths.New("testdata", "nodebug", "core.a").String()+"(core.c.o)", findSymbol(report, "core_buffer").Object)
+	require.Equal(t, paths.New("testdata", "nodebug", "sketch.c.o").String(), findSymbol(report, "main").Object)
+}
+
+func TestReadArchive(t *testing.T) {
+	header := func(name string, size int) string {
+		return fmt.Sprintf("%-16s%-12s%-6s%-6s%-8s%-10d`\n", name, "0", "0", "0", "644", size)
+	}
+	data := []byte("!<arch>\n" +
+		header("/", 4) + "\x00\x00\x00\x00" +
+		header("//", 20) + "a_very_long_name.o/\n" +
+		header("short.o/", 3) + "abc\n" +
+		header("/0", 4) + "defg" +
+		header("#1/8", 10) + "bsd.o\x00\x00\x00hi")
+	members, err := readArchive(data)
+	require.NoError(t, err)
+	require.Len(t, members, 3)
+	require.Equal(t, "short.o", members[0].Name)
+	require.Equal(t, "abc", string(members[0].Data))
+	require.Equal(t, "a_very_long_name.o", members[1].Name)
+	require.Equal(t, "defg", string(members[1].Data))
+	require.Equal(t, "bsd.o", members[2].Name)
+	require.Equal(t, "hi", string(members[2].Data))
+
+	_, err = readArchive([]byte("not an archive"))
+	require.Error(t, err)
+}
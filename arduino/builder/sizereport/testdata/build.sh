@@ -0,0 +1,15 @@
+#!/bin/sh
+# Regenerates the test executables used by sizereport_test.go
+set -e
+cd "$(dirname "$0")"
+for variant in debug nodebug; do
+  flags="-O1 -fno-pie -fno-asynchronous-unwind-tables"
+  if [ $variant = debug ]; then flags="$flags -g"; fi
+  mkdir -p $variant
+  gcc $flags -c sketch/sketch.c -o $variant/sketch.c.o
+  gcc $flags -c core/core.c -o $variant/core.c.o
+  rm -f $variant/core.a
+  ar rcs $variant/core.a $variant/core.c.o
+  rm $variant/core.c.o
+  gcc -nostdlib -static -no-pie -Wl,-e,main -Wl,--build-id=none -o $variant/sketch.elf $variant/sketch.c.o $variant/core.a
+done
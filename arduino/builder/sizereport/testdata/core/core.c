@@ -0,0 +1,7 @@
+char core_buffer[64];
+const char core_message[] = "hello from the core";
+
+int core_function(int x) {
+  core_buffer[x % 64] = core_message[x % 19];
+  return core_buffer[0];
+}
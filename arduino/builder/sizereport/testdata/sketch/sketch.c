@@ -0,0 +1,13 @@
+int counter;
+int table[16] = {1, 2, 3};
+
+int core_function(int);
+
+static int helper(int x) {
+  return x * 3 + table[x % 16];
+}
+
+int main(void) {
+  counter = helper(counter);
+  return core_function(counter);
+}
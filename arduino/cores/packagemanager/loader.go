@@ -154,6 +154,48 @@ func (pm *PackageManager) LoadHardwareFromDirectory(path *paths.Path) []*status.
 	return statuses
 }
 
+// LoadPinnedPlatformReleases loads the given platform releases, and all the tools,
+// from the packages directory. Unlike LoadHardwareFromDirectory the other releases
+// installed for the same platforms are not loaded, so the given releases are the ones
+// used to build. The tools don't need to be filtered since platforms refer to them
+// by exact version.
+// A list of gRPC Status error is returned for each Platform or Tool failed to load.
+func (pm *PackageManager) LoadPinnedPlatformReleases(refs []*PlatformReference) []*status.Status {
+	statuses := []*status.Status{}
+	for _, ref := range refs {
+		platformPath := pm.PackagesDir.Join(ref.Package, "hardware", ref.PlatformArchitecture, ref.PlatformVersion.String())
+		if platformPath.NotExist() {
+			s := status.Newf(codes.NotFound, tr("platform %s is not installed"), ref)
+			statuses = append(statuses, s)
+			continue
+		}
+		release := pm.Packages.GetOrCreatePackage(ref.Package).
+			GetOrCreatePlatform(ref.PlatformArchitecture).
+			GetOrCreateRelease(ref.PlatformVersion)
+		if err := pm.loadPlatformRelease(release, platformPath); err != nil {
+			s := status.Newf(codes.FailedPrecondition, tr("loading platform release %[1]s: %[2]s"), release, err)
+			statuses = append(statuses, s)
+			continue
+		}
+		pm.Log.WithField("platform", release).Infof("Loaded platform")
+	}
+
+	packagersPaths, err := pm.PackagesDir.ReadDir()
+	if err != nil {
+		s := status.Newf(codes.FailedPrecondition, tr("reading %[1]s directory: %[2]s"), pm.PackagesDir, err)
+		return append(statuses, s)
+	}
+	packagersPaths.FilterDirs()
+	packagersPaths.FilterOutHiddenFiles()
+	for _, packagerPath := range packagersPaths {
+		if toolsPath := packagerPath.Join("tools"); toolsPath.IsDir() {
+			targetPackage := pm.Packages.GetOrCreatePackage(packagerPath.Base())
+			statuses = append(statuses, pm.loadToolsFromPackage(targetPackage, toolsPath)...)
+		}
+	}
+	return statuses
+}
+
 // loadPlatforms load plaftorms from the specified directory assuming that they belongs
 // to the targetPackage object passed as parameter.
 // A list of gRPC Status error is returned for each Platform failed to load.
@@ -254,3 +254,29 @@ program.extra_params=-P{serial.port}
 
 	require.Equal(t, expectedProps.AsMap(), props.AsMap())
 }
+
+func TestLoadPinnedPlatformReleases(t *testing.T) {
+	packagesDir, err := paths.MkTempDir("", "pinned_platforms")
+	require.NoError(t, err)
+	defer packagesDir.RemoveAll()
+
+	// Install two releases of the same platform
+	avr := paths.New("testdata", "data_dir_1", "packages", "arduino", "hardware", "avr", "1.8.3")
+	require.NoError(t, packagesDir.Join("arduino", "hardware", "avr").MkdirAll())
+	require.NoError(t, avr.CopyDirTo(packagesDir.Join("arduino", "hardware", "avr", "1.8.3")))
+	require.NoError(t, avr.CopyDirTo(packagesDir.Join("arduino", "hardware", "avr", "1.8.5")))
+	require.NoError(t, packagesDir.Join("arduino", "tools", "bossac", "1.7.0").MkdirAll())
+
+	pm := NewPackageManager(packagesDir, packagesDir, packagesDir, packagesDir)
+	errs := pm.LoadPinnedPlatformReleases([]*PlatformReference{
+		{Package: "arduino", PlatformArchitecture: "avr", PlatformVersion: semver.MustParse("1.8.3")},
+		{Package: "arduino", PlatformArchitecture: "samd", PlatformVersion: semver.MustParse("1.8.6")},
+	})
+	require.Len(t, errs, 1)
+	require.Contains(t, errs[0].Message(), "arduino:samd@1.8.6")
+
+	platform := pm.Packages["arduino"].Platforms["avr"]
+	require.Len(t, platform.GetAllInstalled(), 1)
+	require.Equal(t, "1.8.3", pm.GetInstalledPlatformRelease(platform).Version.String())
+	require.True(t, pm.Packages["arduino"].Tools["bossac"].Releases["1.7.0"].IsInstalled())
+}
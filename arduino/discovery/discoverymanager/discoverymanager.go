@@ -159,6 +159,17 @@ func (dm *DiscoveryManager) StartSyncAll() (<-chan *discovery.Event, []error) {
 	return eventSink, errs
 }
 
+// IsSyncing returns true if any of the discoveries is already syncing, the
+// events of those discoveries are sent only to the first StartSyncAll caller
+func (dm *DiscoveryManager) IsSyncing() bool {
+	for _, d := range dm.discoveries {
+		if d.State() == discovery.Syncing {
+			return true
+		}
+	}
+	return false
+}
+
 // StopAll the discoveries for this DiscoveryManager,
 // returns an error for each discovery failing to stop
 func (dm *DiscoveryManager) StopAll() []error {
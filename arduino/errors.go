@@ -17,6 +17,7 @@ package arduino
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/arduino/arduino-cli/i18n"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
@@ -323,6 +324,25 @@ func (e *LibraryNotFoundError) Unwrap() error {
 	return e.Cause
 }
 
+// ProfileNotFoundError is returned when a build profile is not found in the sketch project file
+type ProfileNotFoundError struct {
+	Profile string
+	Cause   error
+}
+
+func (e *ProfileNotFoundError) Error() string {
+	return composeErrorMsg(tr("Profile '%s' not found", e.Profile), e.Cause)
+}
+
+// ToRPCStatus converts the error into a *status.Status
+func (e *ProfileNotFoundError) ToRPCStatus() *status.Status {
+	return status.New(codes.NotFound, e.Error())
+}
+
+func (e *ProfileNotFoundError) Unwrap() error {
+	return e.Cause
+}
+
 // LibraryDependenciesResolutionFailedError is returned when an inconsistency is found in library dependencies
 // or a solution cannot be found.
 type LibraryDependenciesResolutionFailedError struct {
@@ -552,6 +572,55 @@ func (e *CompileFailedError) ToRPCStatus() *status.Status {
 	return status.New(codes.Internal, e.Error())
 }
 
+// BuildManifestMismatchError is returned when the binaries of a rebuild don't
+// match the ones listed in a build manifest
+type BuildManifestMismatchError struct {
+	Differences []string
+}
+
+func (e *BuildManifestMismatchError) Error() string {
+	return tr("The rebuilt binaries don't match the build manifest:") + "\n  " + strings.Join(e.Differences, "\n  ")
+}
+
+// ToRPCStatus converts the error into a *status.Status
+func (e *BuildManifestMismatchError) ToRPCStatus() *status.Status {
+	return status.New(codes.FailedPrecondition, e.Error())
+}
+
+// SizeBudgetExceededError is returned when the memory used by the build grew,
+// from the previous build, more than allowed
+type SizeBudgetExceededError struct {
+	Exceeded []string
+}
+
+func (e *SizeBudgetExceededError) Error() string {
+	return tr("The memory used by the build grew more than allowed:") + "\n  " + strings.Join(e.Exceeded, "\n  ")
+}
+
+// ToRPCStatus converts the error into a *status.Status
+func (e *SizeBudgetExceededError) ToRPCStatus() *status.Status {
+	return status.New(codes.FailedPrecondition, e.Error())
+}
+
+// FailedTestRunError is returned when the unit tests can't be run
+type FailedTestRunError struct {
+	Message string
+	Cause   error
+}
+
+func (e *FailedTestRunError) Error() string {
+	return composeErrorMsg(e.Message, e.Cause)
+}
+
+func (e *FailedTestRunError) Unwrap() error {
+	return e.Cause
+}
+
+// ToRPCStatus converts the error into a *status.Status
+func (e *FailedTestRunError) ToRPCStatus() *status.Status {
+	return status.New(codes.Internal, e.Error())
+}
+
 // InvalidArgumentError is returned when an invalid argument is passed to the command
 type InvalidArgumentError struct {
 	Message string
@@ -628,6 +697,25 @@ func (e *UnavailableError) ToRPCStatus() *status.Status {
 	return status.New(codes.Unavailable, e.Error())
 }
 
+// CanceledError is returned when an operation is canceled by the client
+type CanceledError struct {
+	Message string
+	Cause   error
+}
+
+func (e *CanceledError) Error() string {
+	return composeErrorMsg(e.Message, e.Cause)
+}
+
+func (e *CanceledError) Unwrap() error {
+	return e.Cause
+}
+
+// ToRPCStatus converts the error into a *status.Status
+func (e *CanceledError) ToRPCStatus() *status.Status {
+	return status.New(codes.Canceled, e.Error())
+}
+
 // TempDirCreationFailedError is returned if a temp dir could not be created
 type TempDirCreationFailedError struct {
 	Cause error
@@ -0,0 +1,264 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package firmware reads, merges and writes the firmware images flashed on
+// the boards, in the Intel HEX, raw binary and UF2 formats.
+package firmware
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/uf2"
+	"github.com/arduino/arduino-cli/i18n"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/marcinbor85/gohex"
+)
+
+var tr = i18n.Tr
+
+// The formats of the images
+const (
+	// FormatHex is the Intel HEX format
+	FormatHex = "hex"
+	// FormatBin is the raw binary format, the data starts at a base address
+	// and the gaps are filled with a fill byte
+	FormatBin = "bin"
+	// FormatUF2 is the format of the bootloaders exposing the flash as a drive
+	FormatUF2 = "uf2"
+)
+
+// Formats are all the supported formats
+var Formats = []string{FormatHex, FormatBin, FormatUF2}
+
+// MaxBinSize is the max size of a raw binary image, the data of an image
+// spread on distant addresses would make a huge binary
+const MaxBinSize = 64 * 1024 * 1024
+
+// DefaultFillByte is the byte filling the gaps of the raw binary images, the
+// value of the erased flash
+const DefaultFillByte = 0xFF
+
+// Image is a firmware image: the data written in the flash, at its addresses
+type Image struct {
+	memory *gohex.Memory
+	// FamilyID is the family ID of the UF2 image the image was read from
+	FamilyID uint32
+}
+
+// New returns an empty image
+func New() *Image {
+	return &Image{memory: gohex.NewMemory()}
+}
+
+// CheckFormat returns an error if the format isn't supported
+func CheckFormat(format string) error {
+	for _, f := range Formats {
+		if f == format {
+			return nil
+		}
+	}
+	return fmt.Errorf(tr("invalid image format %[1]s, expected one of: %[2]s"), format, strings.Join(Formats, ", "))
+}
+
+// FormatFromPath returns the format of the image file from its extension
+func FormatFromPath(path *paths.Path) (string, error) {
+	format := strings.TrimPrefix(strings.ToLower(path.Ext()), ".")
+	if err := CheckFormat(format); err != nil {
+		return "", fmt.Errorf(tr("unknown format of image %s, the extension must be one of: %s"), path, strings.Join(Formats, ", "))
+	}
+	return format, nil
+}
+
+// Parse reads an image in the given format, the data of raw binaries is
+// placed at baseAddress
+func Parse(data []byte, format string, baseAddress uint32) (*Image, error) {
+	img := New()
+	switch format {
+	case FormatHex:
+		if err := img.memory.ParseIntelHex(bytes.NewReader(data)); err != nil {
+			return nil, err
+		}
+	case FormatBin:
+		if len(data) > 0 {
+			if err := img.addData(baseAddress, data); err != nil {
+				return nil, err
+			}
+		}
+	case FormatUF2:
+		segments, familyID, err := uf2.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+		for _, segment := range segments {
+			if err := img.addData(segment.Address, segment.Data); err != nil {
+				return nil, err
+			}
+		}
+		img.FamilyID = familyID
+	default:
+		return nil, CheckFormat(format)
+	}
+	return img, nil
+}
+
+// Load reads the image file, the format is given by the extension of the file
+// if empty. The data of raw binaries is placed at baseAddress.
+func Load(path *paths.Path, format string, baseAddress uint32) (*Image, error) {
+	if format == "" {
+		var err error
+		if format, err = FormatFromPath(path); err != nil {
+			return nil, err
+		}
+	}
+	data, err := path.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	img, err := Parse(data, format, baseAddress)
+	if err != nil {
+		return nil, fmt.Errorf(tr("reading image %[1]s: %[2]s"), path, err)
+	}
+	return img, nil
+}
+
+// Merge adds the data of other to the image, moved by offset. The data can't
+// overlap the data already in the image.
+func (img *Image) Merge(other *Image, offset uint32) error {
+	for _, segment := range other.memory.GetDataSegments() {
+		address := segment.Address + offset
+		if uint64(segment.Address)+uint64(offset)+uint64(len(segment.Data)) > 1<<32 {
+			return fmt.Errorf(tr("address out of range: 0x%X + 0x%X"), segment.Address, offset)
+		}
+		if err := img.addData(address, segment.Data); err != nil {
+			return fmt.Errorf(tr("data at 0x%[1]X-0x%[2]X overlaps the image"), address, address+uint32(len(segment.Data)))
+		}
+	}
+	if img.FamilyID == 0 {
+		img.FamilyID = other.FamilyID
+	}
+	return nil
+}
+
+// addData adds a copy of data at address, the memory appends to the slices
+// given to it
+func (img *Image) addData(address uint32, data []byte) error {
+	return img.memory.AddBinary(address, append([]byte{}, data...))
+}
+
+// Bounds returns the lowest address of the data and the address after the
+// data. ok is false if the image is empty.
+func (img *Image) Bounds() (start, end uint32, ok bool) {
+	segments := img.memory.GetDataSegments()
+	if len(segments) == 0 {
+		return 0, 0, false
+	}
+	last := segments[len(segments)-1]
+	return segments[0].Address, last.Address + uint32(len(last.Data)), true
+}
+
+// Segments returns the contiguous blocks of data of the image, sorted by address
+func (img *Image) Segments() []uf2.Segment {
+	res := []uf2.Segment{}
+	for _, segment := range img.memory.GetDataSegments() {
+		res = append(res, uf2.Segment{Address: segment.Address, Data: segment.Data})
+	}
+	return res
+}
+
+// EncodeOptions are the options of the encoding of an image
+type EncodeOptions struct {
+	// FillByte fills the gaps between the data of raw binaries
+	FillByte byte
+	// BaseAddress is the address of the first byte of raw binaries, if nil
+	// the lowest address of the data is used
+	BaseAddress *uint32
+	// FamilyID is written in the blocks of UF2 images, if nil the FamilyID of
+	// the image is used
+	FamilyID *uint32
+}
+
+// Encode returns the image in the given format, nil opts means the default options
+func (img *Image) Encode(format string, opts *EncodeOptions) ([]byte, error) {
+	if opts == nil {
+		opts = &EncodeOptions{FillByte: DefaultFillByte}
+	}
+	switch format {
+	case FormatHex:
+		var buffer bytes.Buffer
+		if err := img.memory.DumpIntelHex(&buffer, 16); err != nil {
+			return nil, err
+		}
+		return buffer.Bytes(), nil
+	case FormatBin:
+		start, end, ok := img.Bounds()
+		if !ok {
+			return []byte{}, nil
+		}
+		if opts.BaseAddress != nil {
+			if *opts.BaseAddress > start {
+				return nil, fmt.Errorf(tr("the image has data at 0x%[1]X, before the base address 0x%[2]X"), start, *opts.BaseAddress)
+			}
+			start = *opts.BaseAddress
+		}
+		if end-start > MaxBinSize {
+			return nil, fmt.Errorf(tr("the binary image from 0x%[1]X to 0x%[2]X is too big"), start, end)
+		}
+		data := make([]byte, end-start)
+		for i := range data {
+			data[i] = opts.FillByte
+		}
+		for _, segment := range img.memory.GetDataSegments() {
+			copy(data[segment.Address-start:], segment.Data)
+		}
+		return data, nil
+	case FormatUF2:
+		familyID := img.FamilyID
+		if opts.FamilyID != nil {
+			familyID = *opts.FamilyID
+		}
+		return uf2.Encode(img.Segments(), familyID), nil
+	default:
+		return nil, CheckFormat(format)
+	}
+}
+
+// Save writes the image file, the format is given by the extension of the file
+// if empty
+func (img *Image) Save(path *paths.Path, format string, opts *EncodeOptions) error {
+	if format == "" {
+		var err error
+		if format, err = FormatFromPath(path); err != nil {
+			return err
+		}
+	}
+	data, err := img.Encode(format, opts)
+	if err != nil {
+		return err
+	}
+	return path.WriteFile(data)
+}
+
+// ParseAddress parses an address, or an offset, in decimal or hexadecimal
+// with the 0x prefix
+func ParseAddress(s string) (uint32, error) {
+	address, err := strconv.ParseUint(strings.TrimSpace(s), 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf(tr("invalid address %s"), s)
+	}
+	return uint32(address), nil
+}
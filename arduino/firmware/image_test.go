@@ -0,0 +1,165 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package firmware
+
+import (
+	"testing"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func encode(t *testing.T, img *Image, format string, opts *EncodeOptions) []byte {
+	data, err := img.Encode(format, opts)
+	require.NoError(t, err)
+	return data
+}
+
+func TestFormatFromPath(t *testing.T) {
+	format, err := FormatFromPath(paths.New("sketch.ino.HEX"))
+	require.NoError(t, err)
+	require.Equal(t, FormatHex, format)
+	format, err = FormatFromPath(paths.New("build", "sketch.ino.uf2"))
+	require.NoError(t, err)
+	require.Equal(t, FormatUF2, format)
+	_, err = FormatFromPath(paths.New("sketch.ino.elf"))
+	require.Error(t, err)
+	require.Error(t, CheckFormat("srec"))
+}
+
+func TestBinary(t *testing.T) {
+	img, err := Parse([]byte{1, 2, 3, 4}, FormatBin, 0x2000)
+	require.NoError(t, err)
+	start, end, ok := img.Bounds()
+	require.True(t, ok)
+	require.Equal(t, uint32(0x2000), start)
+	require.Equal(t, uint32(0x2004), end)
+
+	other, err := Parse([]byte{5, 6}, FormatBin, 0)
+	require.NoError(t, err)
+	require.NoError(t, img.Merge(other, 0x2006))
+	require.Equal(t, []byte{1, 2, 3, 4, 0xFF, 0xFF, 5, 6}, encode(t, img, FormatBin, nil))
+	require.Equal(t, []byte{1, 2, 3, 4, 0, 0, 5, 6}, encode(t, img, FormatBin, &EncodeOptions{}))
+
+	baseAddress := uint32(0x1FFE)
+	opts := &EncodeOptions{FillByte: 0xAA, BaseAddress: &baseAddress}
+	require.Equal(t, []byte{0xAA, 0xAA, 1, 2, 3, 4, 0xAA, 0xAA, 5, 6}, encode(t, img, FormatBin, opts))
+
+	// The data can't be before the base address
+	baseAddress = 0x2001
+	_, err = img.Encode(FormatBin, opts)
+	require.Error(t, err)
+
+	// Huge binaries are refused
+	far, err := Parse([]byte{7}, FormatBin, 0x10000000)
+	require.NoError(t, err)
+	require.NoError(t, img.Merge(far, 0))
+	_, err = img.Encode(FormatBin, nil)
+	require.Error(t, err)
+
+	require.Equal(t, []byte{}, encode(t, New(), FormatBin, nil))
+}
+
+func TestHex(t *testing.T) {
+	hex := ":020000040001F9\n" +
+		":04100000DEADBEEFB4\n" +
+		":00000001FF\n"
+	img, err := Parse([]byte(hex), FormatHex, 0)
+	require.NoError(t, err)
+	start, end, ok := img.Bounds()
+	require.True(t, ok)
+	require.Equal(t, uint32(0x11000), start)
+	require.Equal(t, uint32(0x11004), end)
+	require.Equal(t, []byte{0xDE, 0xAD, 0xBE, 0xEF}, encode(t, img, FormatBin, nil))
+
+	// Round trip
+	res, err := Parse(encode(t, img, FormatHex, nil), FormatHex, 0)
+	require.NoError(t, err)
+	require.Equal(t, img.Segments(), res.Segments())
+
+	_, err = Parse([]byte("not an hex file"), FormatHex, 0)
+	require.Error(t, err)
+}
+
+func TestUF2(t *testing.T) {
+	img, err := Parse([]byte{1, 2, 3}, FormatBin, 0x2000)
+	require.NoError(t, err)
+	familyID := uint32(0xADA52840)
+	data := encode(t, img, FormatUF2, &EncodeOptions{FamilyID: &familyID})
+
+	res, err := Parse(data, FormatUF2, 0)
+	require.NoError(t, err)
+	require.Equal(t, familyID, res.FamilyID)
+	start, end, ok := res.Bounds()
+	require.True(t, ok)
+	require.Equal(t, uint32(0x2000), start)
+	// The blocks of the UF2 are padded to the size of the payload
+	require.Equal(t, uint32(0x2100), end)
+	require.Equal(t, []byte{1, 2, 3, 0xFF}, encode(t, res, FormatBin, nil)[:4])
+
+	// The family ID is kept by the merge
+	merged := New()
+	require.NoError(t, merged.Merge(res, 0x1000))
+	require.Equal(t, familyID, merged.FamilyID)
+	start, _, _ = merged.Bounds()
+	require.Equal(t, uint32(0x3000), start)
+}
+
+func TestMergeOverlap(t *testing.T) {
+	img, err := Parse([]byte{1, 2, 3, 4}, FormatBin, 0x100)
+	require.NoError(t, err)
+	other, err := Parse([]byte{5, 6}, FormatBin, 0)
+	require.NoError(t, err)
+	require.Error(t, img.Merge(other, 0x102))
+	require.Error(t, img.Merge(other, 0xFFFFFFFF))
+	require.NoError(t, img.Merge(other, 0x104))
+	require.Equal(t, []byte{1, 2, 3, 4, 5, 6}, encode(t, img, FormatBin, nil))
+}
+
+func TestLoadSave(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "firmware")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	img, err := Parse([]byte{1, 2, 3, 4}, FormatBin, 0x100)
+	require.NoError(t, err)
+	for _, format := range Formats {
+		path := tmp.Join("image." + format)
+		require.NoError(t, img.Save(path, "", nil))
+		res, err := Load(path, "", 0x100)
+		require.NoError(t, err)
+		start, _, ok := res.Bounds()
+		require.True(t, ok)
+		require.Equal(t, uint32(0x100), start, format)
+		require.Equal(t, []byte{1, 2, 3, 4}, encode(t, res, FormatBin, nil)[:4], format)
+	}
+	require.Error(t, img.Save(tmp.Join("image.elf"), "", nil))
+	_, err = Load(tmp.Join("missing.hex"), "", 0)
+	require.Error(t, err)
+}
+
+func TestParseAddress(t *testing.T) {
+	address, err := ParseAddress("0x2000")
+	require.NoError(t, err)
+	require.Equal(t, uint32(0x2000), address)
+	address, err = ParseAddress("4096")
+	require.NoError(t, err)
+	require.Equal(t, uint32(4096), address)
+	_, err = ParseAddress("0x100000000")
+	require.Error(t, err)
+	_, err = ParseAddress("addr")
+	require.Error(t, err)
+}
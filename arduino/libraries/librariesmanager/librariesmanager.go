@@ -241,6 +241,19 @@ func (lm *LibrariesManager) LoadLibraryFromDir(libRootDir *paths.Path, location
 	return nil
 }
 
+// AddLibraries adds libraries already loaded, for example by another
+// LibrariesManager, without scanning their directories again.
+func (lm *LibrariesManager) AddLibraries(libs ...*libraries.Library) {
+	for _, library := range libs {
+		alternatives, ok := lm.Libraries[library.Name]
+		if !ok {
+			alternatives = &LibraryAlternatives{}
+			lm.Libraries[library.Name] = alternatives
+		}
+		alternatives.Add(library)
+	}
+}
+
 // FindByReference return the installed library matching the Reference
 // name and version or, if the version is nil, the library installed
 // in the User folder.
@@ -18,19 +18,22 @@ package librariesresolver
 import (
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/arduino/arduino-cli/arduino/libraries"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
 	"github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/arduino-cli/i18n"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/schollz/closestmatch"
 	"github.com/sirupsen/logrus"
 )
 
 // Cpp finds libraries made for the C++ language
 type Cpp struct {
-	headers map[string]libraries.List
+	headers   map[string]libraries.List
+	overrides map[string]string
 }
 
 var tr = i18n.Tr
@@ -38,7 +41,8 @@ var tr = i18n.Tr
 // NewCppResolver creates a new Cpp resolver
 func NewCppResolver() *Cpp {
 	return &Cpp{
-		headers: map[string]libraries.List{},
+		headers:   map[string]libraries.List{},
+		overrides: map[string]string{},
 	}
 }
 
@@ -67,19 +71,131 @@ func (resolver *Cpp) ScanLibrary(lib *libraries.Library) error {
 	return nil
 }
 
+// Override forces the choice of the library with the given name for the header,
+// when the library is among the ones providing the header
+func (resolver *Cpp) Override(header, libraryName string) {
+	resolver.overrides[header] = libraryName
+}
+
 // AlternativesFor returns all the libraries that provides the specified header
 func (resolver *Cpp) AlternativesFor(header string) libraries.List {
 	return resolver.headers[header]
 }
 
+// Candidate is a library providing a header, with the priority used to choose
+// among the alternatives
+type Candidate struct {
+	Library *libraries.Library
+	// Priority is the total score of the library, the highest wins
+	Priority int
+	// ArchitectureMatch is how the library matches the architecture: one of
+	// ArchitectureOptimized, ArchitectureCompatible or ArchitectureIncompatible
+	ArchitectureMatch string
+
+	architecturePriority int
+	namePriority         int
+	locationPriority     int
+}
+
+// ToRPC converts the candidate into an rpc.LibraryCandidate
+func (c *Candidate) ToRPC() *rpc.LibraryCandidate {
+	lib := c.Library
+	res := &rpc.LibraryCandidate{
+		Name:              lib.Name,
+		Location:          lib.Location.ToRPCLibraryLocation(),
+		Priority:          int32(c.Priority),
+		ArchitectureMatch: c.ArchitectureMatch,
+	}
+	if lib.Version != nil {
+		res.Version = lib.Version.String()
+	}
+	if lib.InstallDir != nil {
+		res.InstallDir = lib.InstallDir.String()
+	}
+	return res
+}
+
+// How a library matches an architecture
+const (
+	ArchitectureOptimized    = "optimized"
+	ArchitectureCompatible   = "compatible"
+	ArchitectureIncompatible = "incompatible"
+)
+
+// Reasons for the choice of a library among the alternatives
+const (
+	// ReasonOverride the library has been chosen by the user for the header
+	ReasonOverride = "override"
+	// ReasonOnlyCandidate the library is the only one providing the header
+	ReasonOnlyCandidate = "only-candidate"
+	// ReasonArchitecture the library matches the architecture better
+	ReasonArchitecture = "architecture"
+	// ReasonName the name of the library matches the header better
+	ReasonName = "name"
+	// ReasonLocation the library is installed in a location with higher priority
+	ReasonLocation = "location"
+	// ReasonNameDistance more libraries have the same priority, the name of
+	// the library is the closest to the header
+	ReasonNameDistance = "name-distance"
+	// ReasonAlphabeticalOrder more libraries have the same priority, the library
+	// is the first in alphabetical order
+	ReasonAlphabeticalOrder = "alphabetical-order"
+)
+
+// Resolution is the choice of the library providing a header
+type Resolution struct {
+	Header string
+	// Selected is the library chosen
+	Selected *Candidate
+	// Alternatives are the other libraries providing the header, sorted by priority
+	Alternatives []*Candidate
+	// Reason is why the selected library has been chosen, one of the Reason... constants
+	Reason string
+}
+
 // ResolveFor finds the most suitable library for the specified combination of
 // header and architecture. If no libraries provides the requested header, nil is returned
 func (resolver *Cpp) ResolveFor(header, architecture string) *libraries.Library {
+	res := resolver.Resolve(header, architecture)
+	if res == nil {
+		return nil
+	}
+	return res.Selected.Library
+}
+
+// Resolve finds the most suitable library for the specified combination of
+// header and architecture, as ResolveFor, and returns all the candidates with
+// the reason of the choice. If no libraries provides the requested header, nil is returned
+func (resolver *Cpp) Resolve(header, architecture string) *Resolution {
 	logrus.Infof("Resolving include %s for arch %s", header, architecture)
+	candidates := map[*libraries.Library]*Candidate{}
+	for _, lib := range resolver.headers[header] {
+		candidates[lib] = newCandidate(lib, header, architecture)
+	}
+
+	// The choice is restricted to the libraries chosen by the user, if any
+	var reason string
+	eligible := resolver.headers[header]
+	if name, ok := resolver.overrides[header]; ok {
+		overriding := libraries.List{}
+		for _, lib := range eligible {
+			if lib.Name == name {
+				overriding.Add(lib)
+			}
+		}
+		if len(overriding) > 0 {
+			logrus.WithField("lib", name).Info("  library chosen by the user")
+			eligible = overriding
+			reason = ReasonOverride
+		} else {
+			logrus.WithField("lib", name).Warn("  the library chosen by the user doesn't provide the header")
+		}
+	}
+
 	var found libraries.List
 	var foundPriority int
-	for _, lib := range resolver.headers[header] {
-		libPriority := computePriority(lib, header, architecture)
+	for _, lib := range eligible {
+		libPriority := candidates[lib].Priority
 		msg := "  discarded"
 		if found == nil || foundPriority < libPriority {
 			found = libraries.List{}
@@ -98,20 +214,62 @@ func (resolver *Cpp) ResolveFor(header, architecture string) *libraries.Library
 	if found == nil {
 		return nil
 	}
+
+	var selected *libraries.Library
+	tieBreak := ""
 	if len(found) == 1 {
-		return found[0]
+		selected = found[0]
+	} else if best := findLibraryWithNameBestDistance(header, found); best != nil {
+		// If more than one library qualifies use the "closestmatch" algorithm to
+		// find the best matching one (instead of choosing it randomly)
+		logrus.WithField("lib", best.Name).Info("  library with the best matching name")
+		selected = best
+		tieBreak = ReasonNameDistance
+	} else {
+		found.SortByName()
+		logrus.WithField("lib", found[0].Name).Info("  first library in alphabetic order")
+		selected = found[0]
+		tieBreak = ReasonAlphabeticalOrder
+	}
+	if reason == "" {
+		reason = tieBreak
 	}
 
-	// If more than one library qualifies use the "closestmatch" algorithm to
-	// find the best matching one (instead of choosing it randomly)
-	if best := findLibraryWithNameBestDistance(header, found); best != nil {
-		logrus.WithField("lib", best.Name).Info("  library with the best matching name")
-		return best
+	res := &Resolution{
+		Header:       header,
+		Selected:     candidates[selected],
+		Alternatives: []*Candidate{},
 	}
+	for _, lib := range resolver.headers[header] {
+		if lib != selected {
+			res.Alternatives = append(res.Alternatives, candidates[lib])
+		}
+	}
+	sort.SliceStable(res.Alternatives, func(i, j int) bool {
+		return res.Alternatives[i].Priority > res.Alternatives[j].Priority
+	})
+	if reason == "" {
+		reason = ReasonOnlyCandidate
+		if len(res.Alternatives) > 0 {
+			reason = res.Selected.winsOver(res.Alternatives[0])
+		}
+	}
+	res.Reason = reason
+	return res
+}
 
-	found.SortByName()
-	logrus.WithField("lib", found[0].Name).Info("  first library in alphabetic order")
-	return found[0]
+// winsOver returns the part of the priority that made the candidate win over
+// the other one, the one with the biggest difference
+func (c *Candidate) winsOver(other *Candidate) string {
+	reason := ReasonArchitecture
+	diff := c.architecturePriority - other.architecturePriority
+	if d := c.namePriority - other.namePriority; d > diff {
+		reason, diff = ReasonName, d
+	}
+	if d := c.locationPriority - other.locationPriority; d > diff {
+		reason = ReasonLocation
+	}
+	return reason
 }
 
 func simplify(name string) string {
@@ -121,55 +279,63 @@ func simplify(name string) string {
 }
 
 func computePriority(lib *libraries.Library, header, arch string) int {
+	return newCandidate(lib, header, arch).Priority
+}
+
+func newCandidate(lib *libraries.Library, header, arch string) *Candidate {
 	header = strings.TrimSuffix(header, filepath.Ext(header))
 	header = simplify(header)
 	name := simplify(lib.Name)
 	realName := simplify(lib.RealName)
 
-	priority := 0
+	c := &Candidate{Library: lib}
 
 	// Bonus for core-optimized libraries
 	if lib.IsOptimizedForArchitecture(arch) {
 		// give a slightly better bonus for libraries that have specific optimization
 		// (it is more important than Location but less important than Name)
-		priority += 1010
+		c.architecturePriority = 1010
+		c.ArchitectureMatch = ArchitectureOptimized
 	} else if lib.IsArchitectureIndependent() {
 		// standard bonus for architecture independent (vanilla) libraries
-		priority += 1000
+		c.architecturePriority = 1000
+		c.ArchitectureMatch = ArchitectureCompatible
 	} else {
 		// the library is not architecture compatible
-		priority += 0
+		c.architecturePriority = 0
+		c.ArchitectureMatch = ArchitectureIncompatible
 	}
 
 	if realName == header && name == header {
-		priority += 600
+		c.namePriority = 600
 	} else if realName == header || name == header {
-		priority += 500
+		c.namePriority = 500
 	} else if realName == header+"-master" || name == header+"-master" {
-		priority += 400
+		c.namePriority = 400
 	} else if strings.HasPrefix(realName, header) || strings.HasPrefix(name, header) {
-		priority += 300
+		c.namePriority = 300
 	} else if strings.HasSuffix(realName, header) || strings.HasSuffix(name, header) {
-		priority += 200
+		c.namePriority = 200
 	} else if strings.Contains(realName, header) || strings.Contains(name, header) {
-		priority += 100
+		c.namePriority = 100
 	}
 
 	switch lib.Location {
 	case libraries.IDEBuiltIn:
-		priority += 0
+		c.locationPriority = 0
 	case libraries.ReferencedPlatformBuiltIn:
-		priority++
+		c.locationPriority = 1
 	case libraries.PlatformBuiltIn:
-		priority += 2
+		c.locationPriority = 2
 	case libraries.User:
-		priority += 3
+		c.locationPriority = 3
 	case libraries.Unmanaged:
-		priority += 4
+		c.locationPriority = 4
 	default:
 		panic(fmt.Sprintf("Invalid library location: %d", lib.Location))
 	}
-	return priority
+	c.Priority = c.architecturePriority + c.namePriority + c.locationPriority
+	return c
 }
 
 func findLibraryWithNameBestDistance(name string, libs libraries.List) *libraries.Library {
@@ -158,3 +158,68 @@ func TestCppHeaderResolverWithLibrariesInStrangeDirectoryNames(t *testing.T) {
 	resolver.headers["OneWire.h"] = librarylist2
 	require.Equal(t, "OneWire", resolver.ResolveFor("OneWire.h", "avr").Name)
 }
+
+func TestResolveReason(t *testing.T) {
+	resolve := func(header, arch string, libs ...*libraries.Library) *Resolution {
+		resolver := NewCppResolver()
+		librarylist := libraries.List{}
+		librarylist.Add(libs...)
+		resolver.headers[header] = librarylist
+		return resolver.Resolve(header, arch)
+	}
+	userServoNonavr := &libraries.Library{Name: "Servo", Location: libraries.User, Architectures: []string{"sam", "samd"}}
+	userServo := &libraries.Library{Name: "Servo", Location: libraries.User, Architectures: []string{"avr"}}
+	userAnotherServo := &libraries.Library{Name: "AnotherServo", Location: libraries.User, Architectures: []string{"avr"}}
+
+	require.Nil(t, resolve("Servo.h", "avr"))
+
+	res := resolve("Servo.h", "avr", bundleServo)
+	require.Equal(t, bundleServo, res.Selected.Library)
+	require.Equal(t, ReasonOnlyCandidate, res.Reason)
+	require.Equal(t, ArchitectureOptimized, res.Selected.ArchitectureMatch)
+	require.Empty(t, res.Alternatives)
+
+	res = resolve("Servo.h", "avr", userServoNonavr, bundleServo)
+	require.Equal(t, bundleServo, res.Selected.Library)
+	require.Equal(t, ReasonArchitecture, res.Reason)
+	require.Len(t, res.Alternatives, 1)
+	require.Equal(t, userServoNonavr, res.Alternatives[0].Library)
+	require.Equal(t, ArchitectureIncompatible, res.Alternatives[0].ArchitectureMatch)
+	require.Greater(t, res.Selected.Priority, res.Alternatives[0].Priority)
+
+	res = resolve("Servo.h", "avr", bundleServo, userServo)
+	require.Equal(t, userServo, res.Selected.Library)
+	require.Equal(t, ReasonLocation, res.Reason)
+
+	res = resolve("Servo.h", "avr", userAnotherServo, bundleServo)
+	require.Equal(t, bundleServo, res.Selected.Library)
+	require.Equal(t, ReasonName, res.Reason)
+
+	res = resolve("calculus_lib.h", "avr", l7, l6)
+	require.Equal(t, l6, res.Selected.Library)
+	require.Equal(t, ReasonNameDistance, res.Reason)
+	require.Equal(t, res.Selected.Priority, res.Alternatives[0].Priority)
+}
+
+func TestResolveOverride(t *testing.T) {
+	userAnotherServo := &libraries.Library{Name: "AnotherServo", Location: libraries.User, Architectures: []string{"avr"}}
+	resolver := NewCppResolver()
+	librarylist := libraries.List{}
+	librarylist.Add(bundleServo, userAnotherServo)
+	resolver.headers["Servo.h"] = librarylist
+
+	require.Equal(t, bundleServo, resolver.ResolveFor("Servo.h", "avr"))
+
+	resolver.Override("Servo.h", "AnotherServo")
+	res := resolver.Resolve("Servo.h", "avr")
+	require.Equal(t, userAnotherServo, res.Selected.Library)
+	require.Equal(t, ReasonOverride, res.Reason)
+	require.Len(t, res.Alternatives, 1)
+	require.Equal(t, bundleServo, res.Alternatives[0].Library)
+
+	// An override with a library not providing the header is ignored
+	resolver.Override("Servo.h", "NotInstalled")
+	res = resolver.Resolve("Servo.h", "avr")
+	require.Equal(t, bundleServo, res.Selected.Library)
+	require.Equal(t, ReasonName, res.Reason)
+}
@@ -16,10 +16,12 @@
 package security
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/openpgp"
 )
 
 var (
@@ -73,3 +75,42 @@ func TestVerifySignature(t *testing.T) {
 	require.Nil(t, signer)
 	require.Error(t, err)
 }
+
+func TestSignDetached(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "signature")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	entity, err := openpgp.NewEntity("Test", "", "test@example.com", nil)
+	require.NoError(t, err)
+	privateKey := tmp.Join("private.gpg.key")
+	publicKey := tmp.Join("public.gpg.key")
+	var buffer bytes.Buffer
+	require.NoError(t, entity.SerializePrivate(&buffer, nil))
+	require.NoError(t, privateKey.WriteFile(buffer.Bytes()))
+	buffer.Reset()
+	require.NoError(t, entity.Serialize(&buffer))
+	require.NoError(t, publicKey.WriteFile(buffer.Bytes()))
+
+	target := tmp.Join("build-manifest.json")
+	require.NoError(t, target.WriteFile([]byte(`{"version":1}`)))
+	signature := tmp.Join("build-manifest.json.sig")
+	signer, err := SignDetached(target, signature, privateKey)
+	require.NoError(t, err)
+	require.Equal(t, entity.PrimaryKey.KeyId, signer.PrimaryKey.KeyId)
+
+	res, signer, err := VerifyDetachedSignature(target, signature, publicKey)
+	require.NoError(t, err)
+	require.True(t, res)
+	require.Equal(t, entity.PrimaryKey.KeyId, signer.PrimaryKey.KeyId)
+
+	// A changed file doesn't match the signature
+	require.NoError(t, target.WriteFile([]byte(`{"version":2}`)))
+	res, _, err = VerifyDetachedSignature(target, signature, publicKey)
+	require.Error(t, err)
+	require.False(t, res)
+
+	// The public key can't sign
+	_, err = SignDetached(target, signature, publicKey)
+	require.Error(t, err)
+}
@@ -16,6 +16,7 @@
 package security
 
 import (
+	"bytes"
 	"embed"
 	"fmt"
 	"io"
@@ -83,3 +84,48 @@ func VerifySignature(targetPath *paths.Path, signaturePath *paths.Path, arduinoK
 	signer, err := openpgp.CheckDetachedSignature(keyRing, target, signature)
 	return (signer != nil && err == nil), signer, err
 }
+
+// SignDetached writes in signaturePath the detached GPG signature of the
+// targetPath file, made with the first private key found in the keyPath
+// keyring. The keyring can be binary or ASCII armored, the private key must
+// not be protected by a passphrase. The signature can be checked with
+// VerifyDetachedSignature using the public key.
+func SignDetached(targetPath *paths.Path, signaturePath *paths.Path, keyPath *paths.Path) (*openpgp.Entity, error) {
+	keyData, err := keyPath.ReadFile()
+	if err != nil {
+		return nil, fmt.Errorf(tr("reading private key: %s"), err)
+	}
+	keyRing, err := openpgp.ReadKeyRing(bytes.NewReader(keyData))
+	if err != nil {
+		if keyRing, err = openpgp.ReadArmoredKeyRing(bytes.NewReader(keyData)); err != nil {
+			return nil, fmt.Errorf(tr("reading private key: %s"), err)
+		}
+	}
+	var signer *openpgp.Entity
+	for _, entity := range keyRing {
+		if entity.PrivateKey != nil {
+			signer = entity
+			break
+		}
+	}
+	if signer == nil {
+		return nil, fmt.Errorf(tr("no private key found in %s"), keyPath)
+	}
+	if signer.PrivateKey.Encrypted {
+		return nil, fmt.Errorf(tr("the private key in %s is protected by a passphrase"), keyPath)
+	}
+
+	target, err := targetPath.Open()
+	if err != nil {
+		return nil, fmt.Errorf(tr("opening target file: %s"), err)
+	}
+	defer target.Close()
+	var signature bytes.Buffer
+	if err := openpgp.DetachSign(&signature, signer, target, nil); err != nil {
+		return nil, fmt.Errorf(tr("signing %[1]s: %[2]s"), targetPath, err)
+	}
+	if err := signaturePath.WriteFile(signature.Bytes()); err != nil {
+		return nil, fmt.Errorf(tr("writing signature file: %s"), err)
+	}
+	return signer, nil
+}
@@ -83,19 +83,51 @@ type ResetProgressCallbacks struct {
 	Debug func(msg string)
 }
 
+// ResetOptions contains the timings used to wait for the upload port after
+// the 1200-bps touch
+type ResetOptions struct {
+	// WaitTimeout is the max time to wait for the upload port after a touch
+	WaitTimeout time.Duration
+	// SettleTime is the time a new port must stay connected before using it,
+	// some bootloaders make the port appear and disappear before settling
+	SettleTime time.Duration
+	// TouchRetries is the number of times the touch is repeated if the
+	// upload port doesn't appear
+	TouchRetries int
+}
+
+// NewResetOptions returns the default ResetOptions
+func NewResetOptions() *ResetOptions {
+	return &ResetOptions{
+		WaitTimeout:  10 * time.Second,
+		SettleTime:   time.Second,
+		TouchRetries: 0,
+	}
+}
+
+// touchSerialPort performs the 1200-bps touch, it's replaced in tests
+var touchSerialPort = TouchSerialPortAt1200bps
+
 // Reset a board using the 1200 bps port-touch and wait for new ports.
 // Both reset and wait are optional:
 // - if port is "" touch will be skipped
 // - if wait is false waiting will be skipped
 // If wait is true, this function will wait for a new port to appear and returns that
 // one, otherwise the empty string is returned if the new port can not be detected or
-// if the wait parameter is false.
+// if the wait parameter is false. The touch is repeated, if the port to touch is
+// still there, for the number of retries of the options: a nil opts means the
+// default options.
+// The ports are found polling the serial ports of the OS, ResetAndWatch should be
+// used instead when the events of the discoveries are available.
 // If dryRun is set to true this function will only emulate the port reset without actually
 // performing it, this is useful to mockup for unit-testing and CI.
 // In dryRun mode if the `portToTouch` ends with `"999"` and wait is true, Reset will
 // return a new "bootloader" port as `portToTouch+"0"`.
 // The error is set if the port listing fails.
-func Reset(portToTouch string, wait bool, cb *ResetProgressCallbacks, dryRun bool) (string, error) {
+func Reset(portToTouch string, wait bool, opts *ResetOptions, cb *ResetProgressCallbacks, dryRun bool) (string, error) {
+	if opts == nil {
+		opts = NewResetOptions()
+	}
 	getPorts := getPortMap // non dry-run default
 	if dryRun {
 		emulatedPort := portToTouch
@@ -119,30 +151,57 @@ func Reset(portToTouch string, wait bool, cb *ResetProgressCallbacks, dryRun boo
 		return "", err
 	}
 
-	if portToTouch != "" && last[portToTouch] {
-		if cb != nil && cb.Debug != nil {
-			cb.Debug(fmt.Sprintf("TOUCH: %v", portToTouch))
+	for attempt := 0; ; attempt++ {
+		if portToTouch != "" && last[portToTouch] {
+			if cb != nil && cb.Debug != nil {
+				cb.Debug(fmt.Sprintf("TOUCH: %v", portToTouch))
+			}
+			if cb != nil && cb.TouchingPort != nil {
+				cb.TouchingPort(portToTouch)
+			}
+			if dryRun {
+				// do nothing!
+			} else {
+				if err := touchSerialPort(portToTouch); err != nil {
+					fmt.Println(tr("TOUCH: error during reset: %s", err))
+				}
+			}
+		}
+
+		if !wait {
+			return "", nil
+		}
+		if cb != nil && cb.WaitingForNewSerial != nil {
+			cb.WaitingForNewSerial()
 		}
-		if cb != nil && cb.TouchingPort != nil {
-			cb.TouchingPort(portToTouch)
+
+		newPort, now, err := pollNewPort(getPorts, last, opts, cb, dryRun)
+		if err != nil {
+			return "", err
 		}
-		if dryRun {
-			// do nothing!
-		} else {
-			if err := TouchSerialPortAt1200bps(portToTouch); err != nil {
-				fmt.Println(tr("TOUCH: error during reset: %s", err))
+		if newPort != "" {
+			if cb != nil && cb.BootloaderPortFound != nil {
+				cb.BootloaderPortFound(newPort)
 			}
+			return newPort, nil // Found it!
+		}
+		if attempt >= opts.TouchRetries {
+			break
 		}
+		last = now
 	}
 
-	if !wait {
-		return "", nil
-	}
-	if cb != nil && cb.WaitingForNewSerial != nil {
-		cb.WaitingForNewSerial()
+	if cb != nil && cb.BootloaderPortFound != nil {
+		cb.BootloaderPortFound("")
 	}
+	return "", nil
+}
 
-	deadline := time.Now().Add(10 * time.Second)
+// pollNewPort polls the ports until a port not in last appears, it returns
+// the new port, or the empty string when the wait times out, and the last
+// ports listed.
+func pollNewPort(getPorts func() (map[string]bool, error), last map[string]bool, opts *ResetOptions, cb *ResetProgressCallbacks, dryRun bool) (string, map[string]bool, error) {
+	deadline := time.Now().Add(opts.WaitTimeout)
 	if dryRun {
 		// use a much lower timeout in dryRun
 		deadline = time.Now().Add(100 * time.Millisecond)
@@ -150,7 +209,7 @@ func Reset(portToTouch string, wait bool, cb *ResetProgressCallbacks, dryRun boo
 	for time.Now().Before(deadline) {
 		now, err := getPorts()
 		if err != nil {
-			return "", err
+			return "", nil, err
 		}
 		if cb != nil && cb.Debug != nil {
 			cb.Debug(fmt.Sprintf("WAIT: %v", now))
@@ -171,25 +230,22 @@ func Reset(portToTouch string, wait bool, cb *ResetProgressCallbacks, dryRun boo
 			// on OS X, if the port is opened too quickly after it is detected,
 			// a "Resource busy" error occurs, add a delay to workaround.
 			// This apply to other platforms as well.
-			time.Sleep(time.Second)
+			time.Sleep(opts.SettleTime)
 
 			// Some boards have a glitch in the bootloader: some user experienced
 			// the USB serial port appearing and disappearing rapidly before
 			// settling.
-			// This check ensure that the port is stable after one second.
+			// This check ensure that the port is stable after the settle time.
 			check, err := getPorts()
 			if err != nil {
-				return "", err
+				return "", nil, err
 			}
 			if cb != nil && cb.Debug != nil {
 				cb.Debug(fmt.Sprintf("CHECK: %v", check))
 			}
 			for p := range check {
 				if !last[p] {
-					if cb != nil && cb.BootloaderPortFound != nil {
-						cb.BootloaderPortFound(p)
-					}
-					return p, nil // Found it!
+					return p, check, nil
 				}
 			}
 			if cb != nil && cb.Debug != nil {
@@ -200,9 +256,5 @@ func Reset(portToTouch string, wait bool, cb *ResetProgressCallbacks, dryRun boo
 		last = now
 		time.Sleep(250 * time.Millisecond)
 	}
-
-	if cb != nil && cb.BootloaderPortFound != nil {
-		cb.BootloaderPortFound("")
-	}
-	return "", nil
+	return "", last, nil
 }
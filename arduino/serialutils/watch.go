@@ -0,0 +1,214 @@
+// This file is part of arduino-cli
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package serialutils
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/discovery"
+	"github.com/pkg/errors"
+)
+
+// initialSyncTimeout is the max time to wait for the discoveries to report
+// the ports already connected before the touch
+var initialSyncTimeout = time.Second
+
+// initialSyncQuietTime is the time without events after which the ports
+// already connected are considered all reported, if the port to touch is
+// among them
+var initialSyncQuietTime = 100 * time.Millisecond
+
+// ResetAndWatch resets a board using the 1200 bps port-touch and waits for the
+// upload port, the ports are detected using the events of the discoveries,
+// like the ones sent by the DiscoveryManager after a StartSyncAll. This works
+// with the ports of any discovery.
+// The upload port is a port added after the touch: a port with the same USB
+// serial number of the touched one is preferred, and the ports with a different
+// serial number are never used, if the serial numbers are known, otherwise the
+// first new port that stays connected for the settle time is used. The touch is repeated, if the port to touch is still there,
+// for the number of retries of the options: a nil opts means the default options.
+// nil is returned if the upload port can't be found.
+// The error is set if the discoveries are stopped while waiting.
+func ResetAndWatch(portToTouch *discovery.Port, events <-chan *discovery.Event, opts *ResetOptions, cb *ResetProgressCallbacks) (*discovery.Port, error) {
+	if opts == nil {
+		opts = NewResetOptions()
+	}
+	debug := func(msg string) {
+		if cb != nil && cb.Debug != nil {
+			cb.Debug(msg)
+		}
+	}
+
+	// The discoveries report the ports already connected as soon as they start
+	// syncing, they're all gathered before the touch to recognize the new
+	// ports: the burst of events ends when the events stop, after the port to
+	// touch has been reported, or at the timeout
+	knownPorts := map[string]*discovery.Port{}
+	syncTimeout := time.After(initialSyncTimeout)
+	var quiet <-chan time.Time
+	for synced := false; !synced; {
+		select {
+		case ev, ok := <-events:
+			if !ok || ev.Type == "quit" {
+				return nil, errors.New(tr("discoveries stopped while waiting for the upload port"))
+			}
+			updateKnownPorts(knownPorts, ev)
+			if knownPorts[portToTouch.Address] != nil {
+				quiet = time.After(initialSyncQuietTime)
+			}
+		case <-quiet:
+			synced = true
+		case <-syncTimeout:
+			synced = true
+		}
+	}
+	debug(fmt.Sprintf("LAST: %v", portAddresses(knownPorts)))
+	if port := knownPorts[portToTouch.Address]; port != nil && port.Properties != nil {
+		// The port reported by the discoveries has the most recent properties
+		portToTouch = port
+	}
+
+	for attempt := 0; ; attempt++ {
+		if knownPorts[portToTouch.Address] != nil {
+			debug(fmt.Sprintf("TOUCH: %v", portToTouch.Address))
+			if cb != nil && cb.TouchingPort != nil {
+				cb.TouchingPort(portToTouch.Address)
+			}
+			if err := touchSerialPort(portToTouch.Address); err != nil {
+				debug(fmt.Sprintf("TOUCH: error during reset: %s", err))
+			}
+		}
+		if cb != nil && cb.WaitingForNewSerial != nil {
+			cb.WaitingForNewSerial()
+		}
+
+		newPort, err := watchNewPort(portToTouch, events, knownPorts, opts, debug)
+		if err != nil {
+			return nil, err
+		}
+		if newPort != nil {
+			if cb != nil && cb.BootloaderPortFound != nil {
+				cb.BootloaderPortFound(newPort.Address)
+			}
+			return newPort, nil
+		}
+		if attempt >= opts.TouchRetries {
+			break
+		}
+		debug("Upload port not found, retrying")
+	}
+
+	if cb != nil && cb.BootloaderPortFound != nil {
+		cb.BootloaderPortFound("")
+	}
+	return nil, nil
+}
+
+// watchNewPort waits for a port not in knownPorts, knownPorts is kept updated
+// with the events received. nil is returned if no port is found before the
+// timeout, or if only ports of other boards are found.
+func watchNewPort(touchedPort *discovery.Port, events <-chan *discovery.Event, knownPorts map[string]*discovery.Port, opts *ResetOptions, debug func(string)) (*discovery.Port, error) {
+	serialNumber := portSerialNumber(touchedPort)
+	deadline := time.After(opts.WaitTimeout)
+	var candidate *discovery.Port
+	var settled <-chan time.Time
+	for {
+		select {
+		case ev, ok := <-events:
+			if !ok || ev.Type == "quit" {
+				return nil, errors.New(tr("discoveries stopped while waiting for the upload port"))
+			}
+			isNew := ev.Type == "add" && knownPorts[ev.Port.Address] == nil
+			updateKnownPorts(knownPorts, ev)
+			if ev.Type == "remove" && candidate != nil && candidate.Address == ev.Port.Address {
+				debug("Port check failed... still waiting")
+				candidate = nil
+				settled = nil
+			}
+			if !isNew {
+				continue
+			}
+			debug(fmt.Sprintf("New port found: %s", ev.Port.Address))
+
+			if candidate != nil && portMatch(ev.Port, serialNumber) <= portMatch(candidate, serialNumber) {
+				// The current candidate is a match at least as good
+				continue
+			}
+			candidate = ev.Port
+			if portMatch(candidate, serialNumber) == otherBoard {
+				// A port of another board is never used, it's kept only to
+				// compare the next ports with it
+				debug(fmt.Sprintf("Port %s has a different serial number", candidate.Address))
+				settled = nil
+			} else {
+				settled = time.After(opts.SettleTime)
+			}
+
+		case <-settled:
+			return candidate, nil
+
+		case <-deadline:
+			if candidate != nil && portMatch(candidate, serialNumber) == otherBoard {
+				return nil, nil
+			}
+			return candidate, nil
+		}
+	}
+}
+
+func updateKnownPorts(knownPorts map[string]*discovery.Port, ev *discovery.Event) {
+	switch ev.Type {
+	case "add":
+		knownPorts[ev.Port.Address] = ev.Port
+	case "remove":
+		delete(knownPorts, ev.Port.Address)
+	}
+}
+
+// The matches between a new port and the touched one
+const (
+	otherBoard = iota
+	unknownBoard
+	sameBoard
+)
+
+// portMatch tells if the port belongs to the board with the given serial number
+func portMatch(port *discovery.Port, serialNumber string) int {
+	portSerialNumber := portSerialNumber(port)
+	if serialNumber == "" || portSerialNumber == "" {
+		return unknownBoard
+	}
+	if portSerialNumber == serialNumber {
+		return sameBoard
+	}
+	return otherBoard
+}
+
+func portSerialNumber(port *discovery.Port) string {
+	if port.Properties == nil {
+		return ""
+	}
+	return port.Properties.Get("serialNumber")
+}
+
+func portAddresses(ports map[string]*discovery.Port) []string {
+	res := []string{}
+	for address := range ports {
+		res = append(res, address)
+	}
+	return res
+}
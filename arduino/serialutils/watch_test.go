@@ -0,0 +1,151 @@
+// This file is part of arduino-cli
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package serialutils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/discovery"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+)
+
+func newPort(address, serialNumber string) *discovery.Port {
+	props := properties.NewMap()
+	if serialNumber != "" {
+		props.Set("serialNumber", serialNumber)
+	}
+	return &discovery.Port{Address: address, Protocol: "serial", Properties: props}
+}
+
+// resetAndWatch runs ResetAndWatch with the given events, the events sent after
+// each touch are given by onTouch
+func resetAndWatch(t *testing.T, portToTouch *discovery.Port, initialPorts []*discovery.Port, onTouch func(touch int) []*discovery.Event, opts *ResetOptions) (*discovery.Port, []string) {
+	events := make(chan *discovery.Event, 20)
+	for _, port := range initialPorts {
+		events <- &discovery.Event{Type: "add", Port: port}
+	}
+	touched := []string{}
+	touchSerialPort = func(port string) error {
+		touched = append(touched, port)
+		for _, ev := range onTouch(len(touched)) {
+			events <- ev
+		}
+		return nil
+	}
+	defer func() { touchSerialPort = TouchSerialPortAt1200bps }()
+
+	port, err := ResetAndWatch(portToTouch, events, opts, nil)
+	require.NoError(t, err)
+	return port, touched
+}
+
+func TestResetAndWatch(t *testing.T) {
+	opts := &ResetOptions{WaitTimeout: 200 * time.Millisecond, SettleTime: 10 * time.Millisecond}
+	board := newPort("/dev/ttyACM0", "ABC")
+	otherBoard := newPort("/dev/ttyACM1", "XYZ")
+
+	// The bootloader port with the same serial number is preferred
+	port, touched := resetAndWatch(t, board, []*discovery.Port{board}, func(int) []*discovery.Event {
+		return []*discovery.Event{
+			{Type: "remove", Port: board},
+			{Type: "add", Port: newPort("/dev/ttyACM2", "OTHER")},
+			{Type: "add", Port: newPort("/dev/ttyACM3", "ABC")},
+		}
+	}, opts)
+	require.Equal(t, []string{"/dev/ttyACM0"}, touched)
+	require.Equal(t, "/dev/ttyACM3", port.Address)
+
+	// The ports without serial number are used, even at the same address
+	port, _ = resetAndWatch(t, newPort("/dev/ttyACM0", ""), []*discovery.Port{otherBoard, newPort("/dev/ttyACM0", "")}, func(int) []*discovery.Event {
+		return []*discovery.Event{
+			{Type: "remove", Port: newPort("/dev/ttyACM0", "")},
+			{Type: "add", Port: newPort("/dev/ttyACM0", "")},
+		}
+	}, opts)
+	require.Equal(t, "/dev/ttyACM0", port.Address)
+
+	// A port that doesn't settle is ignored
+	port, _ = resetAndWatch(t, board, []*discovery.Port{board}, func(int) []*discovery.Event {
+		return []*discovery.Event{
+			{Type: "remove", Port: board},
+			{Type: "add", Port: newPort("/dev/ttyACM4", "")},
+			{Type: "remove", Port: newPort("/dev/ttyACM4", "")},
+			{Type: "add", Port: newPort("/dev/ttyACM5", "")},
+		}
+	}, opts)
+	require.Equal(t, "/dev/ttyACM5", port.Address)
+
+	// The touch is retried while the port is still there
+	retryOpts := *opts
+	retryOpts.TouchRetries = 2
+	port, touched = resetAndWatch(t, board, []*discovery.Port{board}, func(touch int) []*discovery.Event {
+		if touch < 2 {
+			return nil
+		}
+		return []*discovery.Event{
+			{Type: "remove", Port: board},
+			{Type: "add", Port: newPort("/dev/ttyACM3", "ABC")},
+		}
+	}, &retryOpts)
+	require.Equal(t, []string{"/dev/ttyACM0", "/dev/ttyACM0"}, touched)
+	require.Equal(t, "/dev/ttyACM3", port.Address)
+
+	// No port found
+	port, touched = resetAndWatch(t, board, []*discovery.Port{board}, func(int) []*discovery.Event {
+		return nil
+	}, opts)
+	require.Equal(t, []string{"/dev/ttyACM0"}, touched)
+	require.Nil(t, port)
+}
+
+func TestResetAndWatchInitialPorts(t *testing.T) {
+	opts := &ResetOptions{WaitTimeout: 200 * time.Millisecond, SettleTime: 10 * time.Millisecond}
+	board := newPort("/dev/ttyACM0", "ABC")
+
+	// The ports reported after the touched one in the initial burst of events
+	// are not new ports
+	port, touched := resetAndWatch(t, board, []*discovery.Port{board, newPort("/dev/ttyACM1", ""), newPort("/dev/ttyACM2", "XYZ")}, func(int) []*discovery.Event {
+		return nil
+	}, opts)
+	require.Equal(t, []string{"/dev/ttyACM0"}, touched)
+	require.Nil(t, port)
+
+	port, _ = resetAndWatch(t, board, []*discovery.Port{board, newPort("/dev/ttyACM1", "")}, func(int) []*discovery.Event {
+		return []*discovery.Event{
+			{Type: "remove", Port: board},
+			{Type: "add", Port: newPort("/dev/ttyACM3", "ABC")},
+		}
+	}, opts)
+	require.Equal(t, "/dev/ttyACM3", port.Address)
+
+	// The ports of other boards are not used, if the serial number is known
+	port, _ = resetAndWatch(t, board, []*discovery.Port{board}, func(int) []*discovery.Event {
+		return []*discovery.Event{
+			{Type: "remove", Port: board},
+			{Type: "add", Port: newPort("/dev/ttyACM2", "XYZ")},
+		}
+	}, opts)
+	require.Nil(t, port)
+}
+
+func TestResetAndWatchQuit(t *testing.T) {
+	events := make(chan *discovery.Event, 1)
+	events <- &discovery.Event{Type: "quit"}
+	_, err := ResetAndWatch(newPort("/dev/ttyACM0", ""), events, nil, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,198 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+	semver "go.bug.st/relaxed-semver"
+	"gopkg.in/yaml.v2"
+)
+
+// ProjectFileName is the name of the project file of a sketch
+const ProjectFileName = "sketch.yaml"
+
+// Project is the content of the sketch.yaml project file
+type Project struct {
+	Profiles       Profiles        `yaml:"profiles"`
+	DefaultProfile string          `yaml:"default_profile"`
+	WarningsPolicy *WarningsPolicy `yaml:"warnings_policy"`
+}
+
+// WarningsPolicy sets the warning level used to compile the sketch, the
+// libraries installed by the user and the code of the platform
+type WarningsPolicy struct {
+	Sketch                 string `yaml:"sketch"`
+	Libraries              string `yaml:"libraries"`
+	Platform               string `yaml:"platform"`
+	SketchWarningsAsErrors bool   `yaml:"sketch_warnings_as_errors"`
+}
+
+// Profiles is the ordered list of build profiles of a project
+type Profiles []*Profile
+
+// UnmarshalYAML decodes the profiles keeping the order in which they are
+// written in the project file
+func (p *Profiles) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	profiles := map[string]*Profile{}
+	if err := unmarshal(&profiles); err != nil {
+		return err
+	}
+	order := yaml.MapSlice{}
+	if err := unmarshal(&order); err != nil {
+		return err
+	}
+	for _, item := range order {
+		name := fmt.Sprint(item.Key)
+		profile := profiles[name]
+		if profile == nil {
+			return fmt.Errorf(tr("empty profile %s"), name)
+		}
+		profile.Name = name
+		*p = append(*p, profile)
+	}
+	return nil
+}
+
+// Profile is a build profile: it pins the board, the platforms and the
+// libraries to use to build the sketch
+type Profile struct {
+	Name            string                      `yaml:"-"`
+	Notes           string                      `yaml:"notes"`
+	FQBN            string                      `yaml:"fqbn"`
+	BoardOptions    map[string]string           `yaml:"board_options"`
+	BuildProperties []string                    `yaml:"build_properties"`
+	Platforms       []*ProfilePlatformReference `yaml:"platforms"`
+	Libraries       []*ProfileLibraryReference  `yaml:"libraries"`
+}
+
+// ProfilePlatformReference is a reference to a platform release, together
+// with the URL of the package index listing it
+type ProfilePlatformReference struct {
+	Packager         string
+	Architecture     string
+	Version          *semver.Version
+	PlatformIndexURL *url.URL
+}
+
+func (p *ProfilePlatformReference) String() string {
+	return fmt.Sprintf("%s:%s@%s", p.Packager, p.Architecture, p.Version)
+}
+
+// UnmarshalYAML decodes a platform reference like:
+//
+//   - platform: arduino:avr (1.8.3)
+//     platform_index_url: https://downloads.arduino.cc/packages/package_index.json
+func (p *ProfilePlatformReference) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	data := map[string]string{}
+	if err := unmarshal(&data); err != nil {
+		return err
+	}
+	platformID, ok := data["platform"]
+	if !ok {
+		return fmt.Errorf(tr("missing 'platform' in platform reference"))
+	}
+	name, version, err := parseNameAndVersion(platformID)
+	if err != nil {
+		return fmt.Errorf(tr("invalid platform reference '%[1]s': %[2]s"), platformID, err)
+	}
+	split := strings.Split(name, ":")
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
+		return fmt.Errorf(tr("invalid platform reference '%[1]s': %[2]s"), platformID, tr("expected PACKAGER:ARCH"))
+	}
+	p.Packager, p.Architecture, p.Version = split[0], split[1], version
+
+	if rawURL, ok := data["platform_index_url"]; ok {
+		indexURL, err := url.Parse(rawURL)
+		if err != nil {
+			return fmt.Errorf(tr("invalid platform index URL '%[1]s': %[2]s"), rawURL, err)
+		}
+		p.PlatformIndexURL = indexURL
+	}
+	return nil
+}
+
+// ProfileLibraryReference is a reference to a library release
+type ProfileLibraryReference struct {
+	Library string
+	Version *semver.Version
+}
+
+func (l *ProfileLibraryReference) String() string {
+	return fmt.Sprintf("%s@%s", l.Library, l.Version)
+}
+
+// UnmarshalYAML decodes a library reference like "ArduinoJson (6.18.5)"
+func (l *ProfileLibraryReference) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var data string
+	if err := unmarshal(&data); err != nil {
+		return err
+	}
+	name, version, err := parseNameAndVersion(data)
+	if err != nil {
+		return fmt.Errorf(tr("invalid library reference '%[1]s': %[2]s"), data, err)
+	}
+	l.Library, l.Version = name, version
+	return nil
+}
+
+// parseNameAndVersion splits a reference in the form "NAME (VERSION)". The
+// version must be exact, version ranges are not allowed.
+func parseNameAndVersion(in string) (string, *semver.Version, error) {
+	in = strings.TrimSpace(in)
+	open := strings.LastIndex(in, "(")
+	if open == -1 || !strings.HasSuffix(in, ")") {
+		return "", nil, fmt.Errorf(tr("expected NAME (VERSION)"))
+	}
+	name := strings.TrimSpace(in[:open])
+	if name == "" {
+		return "", nil, fmt.Errorf(tr("missing name"))
+	}
+	version, err := semver.Parse(strings.TrimSpace(in[open+1 : len(in)-1]))
+	if err != nil {
+		return "", nil, fmt.Errorf(tr("invalid version: %s"), err)
+	}
+	return name, version, nil
+}
+
+// LoadProject reads a project file
+func LoadProject(file *paths.Path) (*Project, error) {
+	data, err := file.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	project := &Project{}
+	if err := yaml.UnmarshalStrict(data, project); err != nil {
+		return nil, err
+	}
+	if project.DefaultProfile != "" && project.GetProfile(project.DefaultProfile) == nil {
+		return nil, fmt.Errorf(tr("default profile %s not found"), project.DefaultProfile)
+	}
+	return project, nil
+}
+
+// GetProfile returns the profile with the given name or nil if not found
+func (p *Project) GetProfile(name string) *Profile {
+	for _, profile := range p.Profiles {
+		if profile.Name == name {
+			return profile
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSketchWithProfiles(t *testing.T) {
+	sketch, err := New(paths.New("testdata", "SketchWithProfiles"))
+	require.NoError(t, err)
+	require.NotNil(t, sketch.Project)
+
+	project := sketch.Project
+	require.Equal(t, "avr", project.DefaultProfile)
+	require.Len(t, project.Profiles, 3)
+	require.Equal(t, "nanorp", project.Profiles[0].Name)
+	require.Equal(t, "avr", project.Profiles[1].Name)
+	require.Equal(t, "esp", project.Profiles[2].Name)
+
+	nanorp := project.GetProfile("nanorp")
+	require.Equal(t, "Nano RP2040 Connect with pinned libraries", nanorp.Notes)
+	require.Equal(t, "arduino:mbed_nano:nanorp2040connect", nanorp.FQBN)
+	require.Len(t, nanorp.Platforms, 1)
+	require.Equal(t, "arduino:mbed_nano@2.1.0", nanorp.Platforms[0].String())
+	require.Nil(t, nanorp.Platforms[0].PlatformIndexURL)
+	require.Len(t, nanorp.Libraries, 2)
+	require.Equal(t, "ArduinoIoTCloud@1.0.2", nanorp.Libraries[0].String())
+	require.Equal(t, "Arduino_ConnectionHandler", nanorp.Libraries[1].Library)
+
+	avr := project.GetProfile("avr")
+	require.Equal(t, []string{"build.extra_flags=-DPROFILE_AVR"}, avr.BuildProperties)
+
+	esp := project.GetProfile("esp")
+	require.Equal(t, map[string]string{"xtal": "160"}, esp.BoardOptions)
+	require.Equal(t, "https://arduino.esp8266.com/stable/package_esp8266com_index.json", esp.Platforms[0].PlatformIndexURL.String())
+
+	require.Nil(t, project.GetProfile("missing"))
+
+	require.Equal(t, &WarningsPolicy{Sketch: "all", SketchWarningsAsErrors: true, Platform: "none"}, project.WarningsPolicy)
+}
+
+func TestLoadInvalidProject(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "sketch_project")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	projectFile := tmp.Join(ProjectFileName)
+
+	invalidProjects := []string{
+		"profiles:\n  uno:\n    fqbn: arduino:avr:uno\n    unknown: field\n",
+		"profiles:\n  uno:\n    platforms:\n      - platform: arduino:avr\n",
+		"profiles:\n  uno:\n    platforms:\n      - platform: arduino (1.8.3)\n",
+		"profiles:\n  uno:\n    libraries:\n      - Servo (>=1.1.0)\n",
+		"profiles:\n  uno:\n    fqbn: arduino:avr:uno\ndefault_profile: mega\n",
+	}
+	for _, content := range invalidProjects {
+		require.NoError(t, projectFile.WriteFile([]byte(content)))
+		_, err := LoadProject(projectFile)
+		require.Error(t, err, content)
+	}
+}
+
+func TestSketchWithInvalidProject(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "sketch_project")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	sketchPath := tmp.Join("Sketch")
+	require.NoError(t, sketchPath.MkdirAll())
+	require.NoError(t, sketchPath.Join("Sketch.ino").WriteFile([]byte("void setup() {}\nvoid loop() {}\n")))
+	require.NoError(t, sketchPath.Join(ProjectFileName).WriteFile([]byte("profiles: [\n")))
+
+	// The sketch can be used without profiles
+	sketch, err := New(sketchPath)
+	require.NoError(t, err)
+	require.Nil(t, sketch.Project)
+	require.Error(t, sketch.ProjectError)
+}
@@ -39,6 +39,8 @@ type Sketch struct {
 	AdditionalFiles  paths.PathList
 	RootFolderFiles  paths.PathList // All files that are in the Sketch root
 	Metadata         *Metadata
+	Project          *Project // Project is the content of the sketch.yaml file, nil if missing or invalid
+	ProjectError     error    // ProjectError is the error loading the sketch.yaml file, if it's invalid
 }
 
 // Metadata is the kind of data associated to a project such as the connected board
@@ -154,6 +156,15 @@ func New(path *paths.Path) (*Sketch, error) {
 	if err := sketch.importMetadata(); err != nil {
 		return nil, fmt.Errorf(tr("importing sketch metadata: %s"), err)
 	}
+	if projectFile := path.Join(ProjectFileName); projectFile.Exist() {
+		// An invalid project file doesn't prevent the use of the sketch, the
+		// error is reported when a profile is requested
+		if project, err := LoadProject(projectFile); err != nil {
+			sketch.ProjectError = fmt.Errorf(tr("loading sketch project file %[1]s: %[2]s"), projectFile, err)
+		} else {
+			sketch.Project = project
+		}
+	}
 	return sketch, nil
 }
 
@@ -0,0 +1,94 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package uf2
+
+import (
+	"os"
+	"runtime"
+	"strings"
+
+	paths "github.com/arduino/go-paths-helper"
+)
+
+const (
+	// InfoFile is the file in the root folder of the drive of a UF2 bootloader
+	InfoFile = "INFO_UF2.TXT"
+	// DriveProtocol is the protocol of the ports that are the folder where the
+	// drive of a UF2 bootloader is mounted, they aren't found by discoveries
+	DriveProtocol = "drive"
+)
+
+// IsDrive returns true if dir is the drive of a UF2 bootloader: it contains
+// the INFO_UF2.TXT file or it's mounted in a folder named after the given
+// volume label.
+func IsDrive(dir *paths.Path, label string) bool {
+	if !dir.IsDir() {
+		return false
+	}
+	if label != "" && strings.EqualFold(dir.Base(), label) {
+		return true
+	}
+	return dir.Join(InfoFile).Exist()
+}
+
+// FindDrives returns the mounted drives of UF2 bootloaders. On Windows the
+// volume label isn't checked, the drives are recognized by the INFO_UF2.TXT
+// file.
+func FindDrives(label string) paths.PathList {
+	return findDrives(label, mountPoints())
+}
+
+func findDrives(label string, mountPoints paths.PathList) paths.PathList {
+	res := paths.PathList{}
+	for _, mountPoint := range mountPoints {
+		if IsDrive(mountPoint, label) {
+			res.Add(mountPoint)
+		}
+	}
+	return res
+}
+
+// mountPoints returns the folders where the removable drives are mounted
+func mountPoints() paths.PathList {
+	res := paths.PathList{}
+	switch runtime.GOOS {
+	case "windows":
+		for letter := 'D'; letter <= 'Z'; letter++ {
+			res.Add(paths.New(string(letter) + ":\\"))
+		}
+		return res
+	case "darwin":
+		return readDirs(paths.New("/Volumes"))
+	}
+	user := os.Getenv("USER")
+	if user != "" {
+		res.AddAll(readDirs(paths.New("/media", user)))
+		res.AddAll(readDirs(paths.New("/run/media", user)))
+	}
+	res.AddAll(readDirs(paths.New("/media")))
+	res.AddAll(readDirs(paths.New("/mnt")))
+	return res
+}
+
+func readDirs(dir *paths.Path) paths.PathList {
+	dirs, err := dir.ReadDir()
+	if err != nil {
+		return paths.PathList{}
+	}
+	dirs.FilterDirs()
+	dirs.Sort()
+	return dirs
+}
@@ -0,0 +1,212 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package uf2 converts binaries to the UF2 format, the one accepted by the
+// bootloaders exposing the flash of the board as a mass storage drive.
+// See https://github.com/microsoft/uf2 for the specification.
+package uf2
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/arduino/arduino-cli/i18n"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/marcinbor85/gohex"
+)
+
+var tr = i18n.Tr
+
+const (
+	magicStart0 = 0x0A324655 // "UF2\n"
+	magicStart1 = 0x9E5D5157
+	magicEnd    = 0x0AB16F30
+
+	flagNotMainFlash    = 0x00000001
+	flagFamilyIDPresent = 0x00002000
+
+	blockSize   = 512
+	payloadSize = 256
+	dataSize    = 476
+)
+
+const (
+	// FamilyIDProperty is the board property with the family ID written in
+	// the UF2 blocks, it enables the conversion of the build to UF2
+	FamilyIDProperty = "build.uf2.family_id"
+	// BaseAddressProperty is the board property with the address where the
+	// .bin file of the build is written, if missing the .hex file is converted
+	BaseAddressProperty = "build.uf2.base_address"
+)
+
+// Segment is a block of contiguous data written at Address
+type Segment struct {
+	Address uint32
+	Data    []byte
+}
+
+// Encode returns the UF2 file writing the given segments. The data is split
+// in blocks of 256 bytes aligned to 256 bytes, the bytes of a block not
+// covered by the segments are set to 0xFF. The family ID is written in the
+// blocks if not zero.
+func Encode(segments []Segment, familyID uint32) []byte {
+	pages := map[uint32][]byte{}
+	for _, segment := range segments {
+		for i, b := range segment.Data {
+			address := segment.Address + uint32(i)
+			pageAddress := address &^ (payloadSize - 1)
+			page, ok := pages[pageAddress]
+			if !ok {
+				page = make([]byte, payloadSize)
+				for j := range page {
+					page[j] = 0xFF
+				}
+				pages[pageAddress] = page
+			}
+			page[address-pageAddress] = b
+		}
+	}
+	addresses := []uint32{}
+	for address := range pages {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool { return addresses[i] < addresses[j] })
+
+	flags := uint32(0)
+	if familyID != 0 {
+		flags |= flagFamilyIDPresent
+	}
+	res := make([]byte, len(addresses)*blockSize)
+	for n, address := range addresses {
+		block := res[n*blockSize : (n+1)*blockSize]
+		binary.LittleEndian.PutUint32(block[0:], magicStart0)
+		binary.LittleEndian.PutUint32(block[4:], magicStart1)
+		binary.LittleEndian.PutUint32(block[8:], flags)
+		binary.LittleEndian.PutUint32(block[12:], address)
+		binary.LittleEndian.PutUint32(block[16:], payloadSize)
+		binary.LittleEndian.PutUint32(block[20:], uint32(n))
+		binary.LittleEndian.PutUint32(block[24:], uint32(len(addresses)))
+		binary.LittleEndian.PutUint32(block[28:], familyID)
+		copy(block[32:], pages[address])
+		binary.LittleEndian.PutUint32(block[blockSize-4:], magicEnd)
+	}
+	return res
+}
+
+// Decode returns the segments written by a UF2 file, merging the contiguous
+// blocks, and the family ID of the blocks. The blocks not meant for the main
+// flash are skipped.
+func Decode(data []byte) ([]Segment, uint32, error) {
+	if len(data)%blockSize != 0 {
+		return nil, 0, fmt.Errorf(tr("invalid UF2 file size: %d is not a multiple of %d"), len(data), blockSize)
+	}
+	familyID := uint32(0)
+	segments := []Segment{}
+	for n := 0; n < len(data)/blockSize; n++ {
+		block := data[n*blockSize : (n+1)*blockSize]
+		if binary.LittleEndian.Uint32(block[0:]) != magicStart0 ||
+			binary.LittleEndian.Uint32(block[4:]) != magicStart1 ||
+			binary.LittleEndian.Uint32(block[blockSize-4:]) != magicEnd {
+			return nil, 0, fmt.Errorf(tr("invalid UF2 block %d"), n)
+		}
+		flags := binary.LittleEndian.Uint32(block[8:])
+		if flags&flagNotMainFlash != 0 {
+			continue
+		}
+		if flags&flagFamilyIDPresent != 0 {
+			familyID = binary.LittleEndian.Uint32(block[28:])
+		}
+		address := binary.LittleEndian.Uint32(block[12:])
+		size := binary.LittleEndian.Uint32(block[16:])
+		if size > dataSize {
+			return nil, 0, fmt.Errorf(tr("invalid UF2 block %d"), n)
+		}
+		payload := block[32 : 32+size]
+		if last := len(segments) - 1; last >= 0 && segments[last].Address+uint32(len(segments[last].Data)) == address {
+			segments[last].Data = append(segments[last].Data, payload...)
+			continue
+		}
+		segments = append(segments, Segment{Address: address, Data: append([]byte{}, payload...)})
+	}
+	return segments, familyID, nil
+}
+
+// FromBinary returns the UF2 file writing a raw binary at baseAddress
+func FromBinary(data []byte, baseAddress, familyID uint32) []byte {
+	return Encode([]Segment{{Address: baseAddress, Data: data}}, familyID)
+}
+
+// FromHex returns the UF2 file writing the data of an Intel HEX file
+func FromHex(hex io.Reader, familyID uint32) ([]byte, error) {
+	mem := gohex.NewMemory()
+	if err := mem.ParseIntelHex(hex); err != nil {
+		return nil, err
+	}
+	segments := []Segment{}
+	for _, segment := range mem.GetDataSegments() {
+		segments = append(segments, Segment{Address: segment.Address, Data: segment.Data})
+	}
+	return Encode(segments, familyID), nil
+}
+
+// FromBuild returns the UF2 file of the build of a sketch, with the family ID
+// and the base address given by the build.uf2.family_id and
+// build.uf2.base_address properties: the {build.project_name}.bin file is
+// converted if the base address is set, the .hex file otherwise.
+func FromBuild(buildPath *paths.Path, projectName string, props *properties.Map) ([]byte, error) {
+	familyID, err := uint32Property(props, FamilyIDProperty)
+	if err != nil {
+		return nil, err
+	}
+	if props.ContainsKey(BaseAddressProperty) {
+		baseAddress, err := uint32Property(props, BaseAddressProperty)
+		if err != nil {
+			return nil, err
+		}
+		if bin := buildPath.Join(projectName + ".bin"); bin.Exist() {
+			data, err := bin.ReadFile()
+			if err != nil {
+				return nil, err
+			}
+			return FromBinary(data, baseAddress, familyID), nil
+		}
+	}
+	hex := buildPath.Join(projectName + ".hex")
+	if !hex.Exist() {
+		return nil, fmt.Errorf(tr("no binary to convert to UF2 found in %s"), buildPath)
+	}
+	file, err := hex.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return FromHex(file, familyID)
+}
+
+func uint32Property(props *properties.Map, key string) (uint32, error) {
+	value := props.Get(key)
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseUint(value, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf(tr("invalid value for %[1]s: %[2]s"), key, value)
+	}
+	return uint32(n), nil
+}
@@ -0,0 +1,146 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package uf2
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncode(t *testing.T) {
+	data := make([]byte, 300)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	res := FromBinary(data, 0x10000000, 0xe48bff56)
+	require.Len(t, res, 2*blockSize)
+
+	block := res[blockSize:]
+	require.Equal(t, uint32(magicStart0), binary.LittleEndian.Uint32(block[0:]))
+	require.Equal(t, uint32(magicStart1), binary.LittleEndian.Uint32(block[4:]))
+	require.Equal(t, uint32(flagFamilyIDPresent), binary.LittleEndian.Uint32(block[8:]))
+	require.Equal(t, uint32(0x10000100), binary.LittleEndian.Uint32(block[12:]))
+	require.Equal(t, uint32(256), binary.LittleEndian.Uint32(block[16:]))
+	require.Equal(t, uint32(1), binary.LittleEndian.Uint32(block[20:]))
+	require.Equal(t, uint32(2), binary.LittleEndian.Uint32(block[24:]))
+	require.Equal(t, uint32(0xe48bff56), binary.LittleEndian.Uint32(block[28:]))
+	require.Equal(t, data[256:], block[32:32+44])
+	require.Equal(t, bytes.Repeat([]byte{0xFF}, 256-44), block[32+44:32+256])
+	require.Equal(t, uint32(magicEnd), binary.LittleEndian.Uint32(block[blockSize-4:]))
+
+	// Unaligned data is padded to the blocks boundaries
+	res = FromBinary([]byte{1, 2, 3}, 0x2002, 0)
+	require.Len(t, res, blockSize)
+	require.Equal(t, uint32(0), binary.LittleEndian.Uint32(res[8:]))
+	require.Equal(t, uint32(0x2000), binary.LittleEndian.Uint32(res[12:]))
+	require.Equal(t, []byte{0xFF, 0xFF, 1, 2, 3, 0xFF}, res[32:38])
+}
+
+func TestDecode(t *testing.T) {
+	data := make([]byte, 512)
+	for i := range data {
+		data[i] = byte(i % 7)
+	}
+	segments, familyID, err := Decode(Encode([]Segment{{Address: 0x1000, Data: data}, {Address: 0x8000, Data: []byte{9}}}, 0x1234))
+	require.NoError(t, err)
+	require.Equal(t, uint32(0x1234), familyID)
+	require.Len(t, segments, 2)
+	require.Equal(t, uint32(0x1000), segments[0].Address)
+	require.Equal(t, data, segments[0].Data)
+	require.Equal(t, uint32(0x8000), segments[1].Address)
+	require.Equal(t, byte(9), segments[1].Data[0])
+
+	_, _, err = Decode([]byte{1, 2, 3})
+	require.Error(t, err)
+	_, _, err = Decode(make([]byte, blockSize))
+	require.Error(t, err)
+}
+
+func TestFromHex(t *testing.T) {
+	hex := ":020000041000EA\n" +
+		":0400000001020304F2\n" +
+		":00000001FF\n"
+	res, err := FromHex(strings.NewReader(hex), 0)
+	require.NoError(t, err)
+	segments, _, err := Decode(res)
+	require.NoError(t, err)
+	require.Len(t, segments, 1)
+	require.Equal(t, uint32(0x10000000), segments[0].Address)
+	require.Equal(t, []byte{1, 2, 3, 4}, segments[0].Data[:4])
+
+	_, err = FromHex(strings.NewReader("invalid"), 0)
+	require.Error(t, err)
+}
+
+func TestFromBuild(t *testing.T) {
+	buildPath, err := paths.MkTempDir("", "uf2-test")
+	require.NoError(t, err)
+	defer buildPath.RemoveAll()
+	require.NoError(t, buildPath.Join("sketch.ino.bin").WriteFile([]byte{1, 2}))
+	require.NoError(t, buildPath.Join("sketch.ino.hex").WriteFile([]byte(":0400000001020304F2\n:00000001FF\n")))
+
+	props := properties.NewMap()
+	props.Set(FamilyIDProperty, "0xe48bff56")
+	props.Set(BaseAddressProperty, "0x10000000")
+	res, err := FromBuild(buildPath, "sketch.ino", props)
+	require.NoError(t, err)
+	segments, familyID, err := Decode(res)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0xe48bff56), familyID)
+	require.Equal(t, uint32(0x10000000), segments[0].Address)
+
+	// Without the base address the .hex file is used
+	props.Remove(BaseAddressProperty)
+	res, err = FromBuild(buildPath, "sketch.ino", props)
+	require.NoError(t, err)
+	segments, _, err = Decode(res)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), segments[0].Address)
+	require.Equal(t, []byte{1, 2, 3, 4}, segments[0].Data[:4])
+
+	props.Set(FamilyIDProperty, "rp2040")
+	_, err = FromBuild(buildPath, "sketch.ino", props)
+	require.Error(t, err)
+
+	props.Set(FamilyIDProperty, "0")
+	_, err = FromBuild(buildPath, "missing.ino", props)
+	require.Error(t, err)
+}
+
+func TestFindDrives(t *testing.T) {
+	media, err := paths.MkTempDir("", "uf2-test")
+	require.NoError(t, err)
+	defer media.RemoveAll()
+	usbStick := media.Join("USBSTICK")
+	rpi := media.Join("RPI-RP2")
+	feather := media.Join("FEATHERBOOT")
+	require.NoError(t, usbStick.MkdirAll())
+	require.NoError(t, rpi.MkdirAll())
+	require.NoError(t, feather.MkdirAll())
+	require.NoError(t, feather.Join(InfoFile).WriteFile([]byte("UF2 Bootloader")))
+
+	mountPoints := paths.PathList{usbStick, rpi, feather}
+	require.Equal(t, paths.PathList{rpi, feather}, findDrives("RPI-RP2", mountPoints))
+	require.Equal(t, paths.PathList{feather}, findDrives("", mountPoints))
+	require.Empty(t, findDrives("", paths.PathList{usbStick, rpi}))
+	require.False(t, IsDrive(media.Join("missing"), "missing"))
+}
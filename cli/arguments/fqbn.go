@@ -21,7 +21,8 @@ import "github.com/spf13/cobra"
 // This is useful so all flags used by commands that need
 // this information are consistent with each other.
 type Fqbn struct {
-	fqbn string
+	fqbn  string
+	fqbns []string
 }
 
 // AddToCommand adds the flags used to set fqbn to the specified Command
@@ -32,11 +33,31 @@ func (f *Fqbn) AddToCommand(cmd *cobra.Command) {
 	})
 }
 
-// String returns the fqbn
+// AddToCommandAllowMultiple adds the flags used to set fqbn to the specified
+// Command, allowing the flag to be repeated to set many boards
+func (f *Fqbn) AddToCommandAllowMultiple(cmd *cobra.Command) {
+	cmd.Flags().StringArrayVarP(&f.fqbns, "fqbn", "b", []string{}, tr("Fully Qualified Board Name, e.g.: arduino:avr:uno. Can be used multiple times to build for many boards."))
+	cmd.RegisterFlagCompletionFunc("fqbn", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return GetInstalledBoards(), cobra.ShellCompDirectiveDefault
+	})
+}
+
+// String returns the fqbn, the first one if many have been given
 func (f *Fqbn) String() string {
+	if f.fqbn == "" && len(f.fqbns) > 0 {
+		return f.fqbns[0]
+	}
 	return f.fqbn
 }
 
+// All returns all the fqbns given
+func (f *Fqbn) All() []string {
+	if f.fqbn == "" {
+		return f.fqbns
+	}
+	return []string{f.fqbn}
+}
+
 // Set sets the fqbn
 func (f *Fqbn) Set(fqbn string) {
 	f.fqbn = fqbn
@@ -23,6 +23,7 @@ import (
 
 	"github.com/arduino/arduino-cli/arduino/discovery"
 	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/arduino/uf2"
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
 	"github.com/arduino/arduino-cli/commands"
@@ -36,14 +37,26 @@ import (
 // This is useful so all flags used by commands that need
 // this information are consistent with each other.
 type Port struct {
-	address  string
-	protocol string
-	timeout  time.Duration
+	address   string
+	addresses []string
+	protocol  string
+	timeout   time.Duration
 }
 
 // AddToCommand adds the flags used to set port and protocol to the specified Command
 func (p *Port) AddToCommand(cmd *cobra.Command) {
 	cmd.Flags().StringVarP(&p.address, "port", "p", "", tr("Upload port address, e.g.: COM3 or /dev/ttyACM2"))
+	p.addFlags(cmd)
+}
+
+// AddToCommandAllowMultiple adds the flags used to set port and protocol to the specified
+// Command, the port flag may be repeated to set many ports
+func (p *Port) AddToCommandAllowMultiple(cmd *cobra.Command) {
+	cmd.Flags().StringArrayVarP(&p.addresses, "port", "p", []string{}, tr("Upload port address, e.g.: COM3 or /dev/ttyACM2. Can be used multiple times for multiple ports."))
+	p.addFlags(cmd)
+}
+
+func (p *Port) addFlags(cmd *cobra.Command) {
 	cmd.RegisterFlagCompletionFunc("port", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return GetConnectedBoards(), cobra.ShellCompDirectiveDefault
 	})
@@ -60,7 +73,7 @@ func (p *Port) AddToCommand(cmd *cobra.Command) {
 // case the discoveries are needed to autodetect the protocol.
 func (p *Port) GetPortAddressAndProtocol(instance *rpc.Instance, sk *sketch.Sketch) (string, string, error) {
 	if p.protocol != "" {
-		return p.address, p.protocol, nil
+		return p.getAddress(), p.protocol, nil
 	}
 	port, err := p.GetPort(instance, sk)
 	if err != nil {
@@ -72,7 +85,7 @@ func (p *Port) GetPortAddressAndProtocol(instance *rpc.Instance, sk *sketch.Sket
 // GetPort returns the Port obtained by parsing command line arguments.
 // The extra metadata for the ports is obtained using the pluggable discoveries.
 func (p *Port) GetPort(instance *rpc.Instance, sk *sketch.Sketch) (*discovery.Port, error) {
-	address := p.address
+	address := p.getAddress()
 	protocol := p.protocol
 
 	if address == "" && sk != nil && sk.Metadata != nil {
@@ -84,6 +97,14 @@ func (p *Port) GetPort(instance *rpc.Instance, sk *sketch.Sketch) (*discovery.Po
 			address = deviceURI.Host + deviceURI.Path
 		}
 	}
+	if protocol == uf2.DriveProtocol {
+		// The drives of the UF2 bootloaders aren't found by the discoveries,
+		// without address the drive is searched by the upload
+		return &discovery.Port{
+			Address:  address,
+			Protocol: protocol,
+		}, nil
+	}
 	if address == "" {
 		// If no address is provided we assume the user is trying to upload
 		// to a board that supports a tool that automatically detects
@@ -96,6 +117,53 @@ func (p *Port) GetPort(instance *rpc.Instance, sk *sketch.Sketch) (*discovery.Po
 	}
 	logrus.WithField("port", address).Tracef("Upload port")
 
+	ports, err := p.discoverPorts(instance, []string{address})
+	if err != nil {
+		return nil, err
+	}
+	return ports[0], nil
+}
+
+// GetAddresses returns all the port addresses given in the command line
+func (p *Port) GetAddresses() []string {
+	if p.address != "" {
+		return []string{p.address}
+	}
+	return p.addresses
+}
+
+// GetPorts returns the Ports of all the addresses given in the command line.
+// The extra metadata for the ports is obtained using the pluggable discoveries,
+// that are started only once for all the ports.
+func (p *Port) GetPorts(instance *rpc.Instance) ([]*discovery.Port, error) {
+	addresses := p.GetAddresses()
+	if p.protocol == uf2.DriveProtocol {
+		ports := []*discovery.Port{}
+		for _, address := range addresses {
+			ports = append(ports, &discovery.Port{
+				Address:  address,
+				Protocol: p.protocol,
+			})
+		}
+		return ports, nil
+	}
+	logrus.WithField("ports", addresses).Tracef("Upload ports")
+	return p.discoverPorts(instance, addresses)
+}
+
+func (p *Port) getAddress() string {
+	if p.address == "" && len(p.addresses) > 0 {
+		return p.addresses[0]
+	}
+	return p.address
+}
+
+// discoverPorts runs the discoveries to find the ports with the given addresses,
+// the ports not found before the timeout are assumed to be serial ports if
+// no protocol has been specified.
+func (p *Port) discoverPorts(instance *rpc.Instance, addresses []string) ([]*discovery.Port, error) {
+	protocol := p.protocol
+
 	pm := commands.GetPackageManager(instance.Id)
 	if pm == nil {
 		return nil, errors.New("invalid instance")
@@ -122,29 +190,44 @@ func (p *Port) GetPort(instance *rpc.Instance, sk *sketch.Sketch) (*discovery.Po
 		}
 	}()
 
+	ports := make([]*discovery.Port, len(addresses))
+	missing := len(addresses)
 	deadline := time.After(p.timeout)
-	for {
+	for missing > 0 {
 		select {
 		case portEvent := <-eventChan:
 			if portEvent.Type != "add" {
 				continue
 			}
 			port := portEvent.Port
-			if (protocol == "" || protocol == port.Protocol) && address == port.Address {
-				return port, nil
+			if protocol != "" && protocol != port.Protocol {
+				continue
+			}
+			for i, address := range addresses {
+				if ports[i] == nil && address == port.Address {
+					ports[i] = port
+					missing--
+				}
 			}
 
 		case <-deadline:
 			// No matching port found
-			if protocol == "" {
-				return &discovery.Port{
+			for i, address := range addresses {
+				if ports[i] != nil {
+					continue
+				}
+				if protocol != "" {
+					return nil, fmt.Errorf(tr("port not found: %[1]s %[2]s"), address, protocol)
+				}
+				ports[i] = &discovery.Port{
 					Address:  address,
 					Protocol: "serial",
-				}, nil
+				}
 			}
-			return nil, fmt.Errorf(tr("port not found: %[1]s %[2]s"), address, protocol)
+			missing = 0
 		}
 	}
+	return ports, nil
 }
 
 // GetSearchTimeout returns the timeout
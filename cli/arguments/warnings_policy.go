@@ -0,0 +1,88 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package arguments
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/spf13/cobra"
+)
+
+// WarningsPolicy contains the warnings policy flag data.
+// This is useful so all flags used by commands that need
+// this information are consistent with each other.
+type WarningsPolicy struct {
+	policy string
+}
+
+// AddToCommand adds the flag used to set the warnings policy to the specified Command
+func (w *WarningsPolicy) AddToCommand(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&w.policy, "warnings-policy", "",
+		tr("Warning level of the sketch, of the user libraries and of the platform code (core, variant and bundled libraries), overriding --warnings, e.g.: %[1]s. The levels can be: %[2]s. Add %[3]s to the level of the sketch to treat its warnings as errors.",
+			"sketch=all+werror,libraries=default,platform=none", "none, default, more, all", "+werror"))
+}
+
+// ToRPC returns the warnings policy given with the flag, or nil if not set.
+// The command exits if the policy is not valid.
+func (w *WarningsPolicy) ToRPC() *rpc.WarningsPolicy {
+	if w.policy == "" {
+		return nil
+	}
+	policy, err := ParseWarningsPolicy(w.policy)
+	if err != nil {
+		feedback.Errorf(tr("Invalid warnings policy: %v"), err)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	return policy
+}
+
+// ParseWarningsPolicy parses a warnings policy in the form
+// "sketch=LEVEL[+werror],libraries=LEVEL,platform=LEVEL", every part is optional
+func ParseWarningsPolicy(policy string) (*rpc.WarningsPolicy, error) {
+	res := &rpc.WarningsPolicy{}
+	for _, item := range strings.Split(policy, ",") {
+		split := strings.SplitN(strings.TrimSpace(item), "=", 2)
+		if len(split) != 2 || split[1] == "" {
+			return nil, fmt.Errorf(tr("expected PART=LEVEL instead of '%s'"), item)
+		}
+		part, level := split[0], split[1]
+		if part == "sketch" && strings.HasSuffix(level, "+werror") {
+			level = strings.TrimSuffix(level, "+werror")
+			res.SketchWarningsAsErrors = true
+		}
+		switch level {
+		case "none", "default", "more", "all":
+		default:
+			return nil, fmt.Errorf(tr("invalid warning level '%s'"), level)
+		}
+		switch part {
+		case "sketch":
+			res.Sketch = level
+		case "libraries":
+			res.Libraries = level
+		case "platform":
+			res.Platform = level
+		default:
+			return nil, fmt.Errorf(tr("invalid part '%[1]s', it can be: %[2]s"), part, "sketch, libraries, platform")
+		}
+	}
+	return res, nil
+}
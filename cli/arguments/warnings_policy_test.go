@@ -0,0 +1,43 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package arguments_test
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/cli/arguments"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWarningsPolicy(t *testing.T) {
+	policy, err := arguments.ParseWarningsPolicy("sketch=all+werror,libraries=default, platform=none")
+	require.NoError(t, err)
+	require.Equal(t, "all", policy.GetSketch())
+	require.True(t, policy.GetSketchWarningsAsErrors())
+	require.Equal(t, "default", policy.GetLibraries())
+	require.Equal(t, "none", policy.GetPlatform())
+
+	policy, err = arguments.ParseWarningsPolicy("platform=none")
+	require.NoError(t, err)
+	require.Equal(t, "", policy.GetSketch())
+	require.False(t, policy.GetSketchWarningsAsErrors())
+	require.Equal(t, "none", policy.GetPlatform())
+
+	for _, invalid := range []string{"", "sketch", "sketch=", "sketch=loud", "core=none", "libraries=all+werror"} {
+		_, err := arguments.ParseWarningsPolicy(invalid)
+		require.Error(t, err, invalid)
+	}
+}
@@ -16,7 +16,6 @@
 package burnbootloader
 
 import (
-	"context"
 	"os"
 
 	"github.com/arduino/arduino-cli/cli/arguments"
@@ -74,7 +73,9 @@ func runBootloaderCommand(command *cobra.Command, args []string) {
 		os.Exit(errorcodes.ErrGeneric)
 	}
 
-	if _, err := upload.BurnBootloader(context.Background(), &rpc.BurnBootloaderRequest{
+	ctx, cancel := arguments.InterruptibleContext()
+	defer cancel()
+	if _, err := upload.BurnBootloader(ctx, &rpc.BurnBootloaderRequest{
 		Instance:   instance,
 		Fqbn:       fqbn.String(),
 		Port:       discoveryPort.ToRPC(),
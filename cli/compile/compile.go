@@ -19,13 +19,17 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"os"
+	"strings"
 
 	"github.com/arduino/arduino-cli/cli/arguments"
 	"github.com/arduino/arduino-cli/cli/feedback"
 	"github.com/arduino/arduino-cli/cli/output"
 	"github.com/arduino/arduino-cli/configuration"
 	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/arduino-cli/table"
 	"github.com/sirupsen/logrus"
 
 	"github.com/arduino/arduino-cli/cli/errorcodes"
@@ -35,13 +39,14 @@ import (
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/arduino/go-paths-helper"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 var (
 	fqbn                    arguments.Fqbn       // Fully Qualified Board Name, e.g.: arduino:avr:uno.
 	showProperties          bool                 // Show all build preferences used instead of compiling.
 	preprocess              bool                 // Print preprocessed code to stdout.
-	buildCachePath          string               // Builds of 'core.a' are saved into this path to be cached and reused.
+	buildCachePath          string               // Builds of 'core.a' and of the object files are saved into this path to be cached and reused.
 	buildPath               string               // Path where to save compiled files.
 	buildProperties         []string             // List of custom build properties separated by commas. Or can be used multiple times for multiple properties.
 	warnings                string               // Used to tell gcc which warning level to use.
@@ -57,6 +62,22 @@ var (
 	clean                   bool                 // Cleanup the build folder and do not use any cached build
 	compilationDatabaseOnly bool                 // Only create compilation database without actually compiling
 	sourceOverrides         string               // Path to a .json file that contains a set of replacements of the sketch source code.
+	showDiagnostics         bool                 // Print a summary of the compiler diagnostics at the end of the build.
+	sizeReport              bool                 // Print the memory used by each symbol, object file, library and core.
+	watch                   bool                 // Compile again every time a file of the sketch or of the used libraries changes.
+	reproducible            bool                 // Produce binaries that don't depend on the build path and time, and write a build manifest.
+	verifyManifest          string               // Rebuild the sketch and compare the binaries with the ones of this build manifest.
+	signManifestKey         string               // Sign the build manifest with this OpenPGP private key.
+	verifyManifestKey       string               // Check the signature of the build manifest to verify with this OpenPGP public key.
+	profile                 string               // Build with the platforms and libraries pinned by this profile of the sketch project file.
+	dumpIncludeGraph        string               // Print the #include directives resolved to a library, in "json" or "dot" format.
+	traceFile               string               // Write the timing of the steps of the build to this file, in Chrome trace-event format.
+	compareTo               string               // Compare the memory used with the executable of this previous build.
+	maxFlashIncrease        int64                // Fail if the flash used grew more than this from the previous build.
+	maxRAMIncrease          int64                // Fail if the RAM used grew more than this from the previous build.
+	exportFormats           []string             // Formats of the merged image of the sketch, the bootloader and the other images of the board.
+
+	warningsPolicy arguments.WarningsPolicy // Warning levels of the sketch, of the libraries and of the platform.
 	// library and libraries sound similar but they're actually different.
 	// library expects a path to the root folder of one single library.
 	// libraries expects a path to a directory containing multiple libraries, similarly to the <directories.user>/libraries path.
@@ -75,15 +96,16 @@ func NewCommand() *cobra.Command {
 			"  " + os.Args[0] + " compile -b arduino:avr:uno /home/user/Arduino/MySketch\n" +
 			"  " + os.Args[0] + ` compile -b arduino:avr:uno --build-property "build.extra_flags=\"-DMY_DEFINE=\"hello world\"\"" /home/user/Arduino/MySketch` + "\n" +
 			"  " + os.Args[0] + ` compile -b arduino:avr:uno --build-property "build.extra_flags=-DPIN=2 \"-DMY_DEFINE=\"hello world\"\"" /home/user/Arduino/MySketch` + "\n" +
-			"  " + os.Args[0] + ` compile -b arduino:avr:uno --build-property build.extra_flags=-DPIN=2 --build-property "compiler.cpp.extra_flags=\"-DSSID=\"hello world\"\"" /home/user/Arduino/MySketch` + "\n",
+			"  " + os.Args[0] + ` compile -b arduino:avr:uno --build-property build.extra_flags=-DPIN=2 --build-property "compiler.cpp.extra_flags=\"-DSSID=\"hello world\"\"" /home/user/Arduino/MySketch` + "\n" +
+			"  " + os.Args[0] + " compile -b arduino:avr:uno -b arduino:samd:mkr1000 -b esp32:esp32:esp32 /home/user/Arduino/MySketch\n",
 		Args: cobra.MaximumNArgs(1),
 		Run:  runCompileCommand,
 	}
 
-	fqbn.AddToCommand(compileCommand)
+	fqbn.AddToCommandAllowMultiple(compileCommand)
 	compileCommand.Flags().BoolVar(&showProperties, "show-properties", false, tr("Show all build properties used instead of compiling."))
 	compileCommand.Flags().BoolVar(&preprocess, "preprocess", false, tr("Print preprocessed code to stdout instead of compiling."))
-	compileCommand.Flags().StringVar(&buildCachePath, "build-cache-path", "", tr("Builds of 'core.a' are saved into this path to be cached and reused."))
+	compileCommand.Flags().StringVar(&buildCachePath, "build-cache-path", "", tr("Builds of 'core.a' and of the object files are saved into this path to be cached and reused."))
 	compileCommand.Flags().StringVarP(&exportDir, "output-dir", "", "", tr("Save build artifacts in this directory."))
 	compileCommand.Flags().StringVar(&buildPath, "build-path", "",
 		tr("Path where to save compiled files. If omitted, a directory will be created in the default temporary path of your OS."))
@@ -93,6 +115,7 @@ func NewCommand() *cobra.Command {
 		tr("Override a build property with a custom value. Can be used multiple times for multiple properties."))
 	compileCommand.Flags().StringVar(&warnings, "warnings", "none",
 		tr(`Optional, can be: %s. Used to tell gcc which warning level to use (-W flag).`, "none, default, more, all"))
+	warningsPolicy.AddToCommand(compileCommand)
 	compileCommand.Flags().BoolVarP(&verbose, "verbose", "v", false, tr("Optional, turns on verbose mode."))
 	compileCommand.Flags().BoolVar(&quiet, "quiet", false, tr("Optional, suppresses almost every output."))
 	compileCommand.Flags().BoolVarP(&uploadAfterCompile, "upload", "u", false, tr("Upload the binary after the compilation."))
@@ -107,6 +130,20 @@ func NewCommand() *cobra.Command {
 	programmer.AddToCommand(compileCommand)
 	compileCommand.Flags().BoolVar(&compilationDatabaseOnly, "only-compilation-database", false, tr("Just produce the compilation database, without actually compiling. All build commands are skipped except pre* hooks."))
 	compileCommand.Flags().BoolVar(&clean, "clean", false, tr("Optional, cleanup the build folder and do not use any cached build."))
+	compileCommand.Flags().BoolVar(&showDiagnostics, "show-diagnostics", false, tr("Print a summary of the errors and warnings produced by the compiler at the end of the build."))
+	compileCommand.Flags().BoolVar(&watch, "watch", false, tr("Keep watching the sketch and the libraries being developed, and compile again (and upload, if requested) every time a file changes."))
+	compileCommand.Flags().BoolVar(&sizeReport, "size-report", false, tr("Print a report of the memory used by each symbol, object file, library and core of the executable."))
+	compileCommand.Flags().BoolVar(&reproducible, "reproducible", false, tr("Produce binaries that don't depend on the build path, the user home and the build time, and write a build manifest with the SHA-256 of every input and output of the build."))
+	compileCommand.Flags().StringVar(&verifyManifest, "verify-manifest", "", tr("Rebuild the sketch from scratch and check that the binaries are identical to the ones of the given build manifest."))
+	compileCommand.Flags().StringVar(&signManifestKey, "sign-manifest-key", "", tr("Sign the build manifest of a reproducible build with the given OpenPGP private key, the signature is written next to the manifest."))
+	compileCommand.Flags().StringVar(&verifyManifestKey, "verify-manifest-key", "", tr("Check the signature of the build manifest given with --verify-manifest against the given OpenPGP public key before rebuilding."))
+	compileCommand.Flags().StringVar(&dumpIncludeGraph, "dump-include-graph", "", tr("Print the graph of the #include directives resolved to a library, with the alternatives rejected for each header and the reason of the choice. Format can be: json, dot."))
+	compileCommand.Flags().StringVar(&traceFile, "trace", "", tr("Write the timing of every step of the build to the given file, in Chrome trace-event format, and print the slowest phases and files."))
+	compileCommand.Flags().StringVar(&compareTo, "compare-to", "", tr("Compare the memory used by the build, per section, library and symbol, with a previous build. Can be a build path, a folder with the exported binaries, a build manifest or the .elf file."))
+	compileCommand.Flags().Int64Var(&maxFlashIncrease, "max-flash-increase", 0, tr("Fail if the flash used grows more than the given bytes from the build given with --compare-to."))
+	compileCommand.Flags().Int64Var(&maxRAMIncrease, "max-ram-increase", 0, tr("Fail if the RAM used grows more than the given bytes from the build given with --compare-to."))
+	compileCommand.Flags().StringSliceVar(&exportFormats, "export-format", []string{}, tr("Write a single image with the sketch merged with the bootloader and the other images of the board, in the given formats: hex, bin, uf2. Can be used multiple times."))
+	compileCommand.Flags().StringVarP(&profile, "profile", "m", "", tr("Build profile to use, as declared in the sketch.yaml file of the sketch. The platforms and libraries pinned by the profile are installed if missing."))
 	// We must use the following syntax for this flag since it's also bound to settings.
 	// This must be done because the value is set when the binding is accessed from viper. Accessing from cobra would only
 	// read the value if the flag is set explicitly by the user.
@@ -133,6 +170,11 @@ func runCompileCommand(cmd *cobra.Command, args []string) {
 
 	sketchPath := arguments.InitSketchPath(path)
 
+	if dumpIncludeGraph != "" && dumpIncludeGraph != "json" && dumpIncludeGraph != "dot" {
+		feedback.Errorf(tr("Invalid include graph format: %s"), dumpIncludeGraph)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+
 	var overrides map[string]string
 	if sourceOverrides != "" {
 		data, err := paths.New(sourceOverrides).ReadFile()
@@ -170,58 +212,72 @@ func runCompileCommand(cmd *cobra.Command, args []string) {
 		CreateCompilationDatabaseOnly: compilationDatabaseOnly,
 		SourceOverride:                overrides,
 		Library:                       library,
+		SizeReport:                    sizeReport,
+		Reproducible:                  reproducible,
+		VerifyManifest:                verifyManifest,
+		SignManifestKey:               signManifestKey,
+		VerifyManifestKey:             verifyManifestKey,
+		Profile:                       profile,
+		IncludeGraph:                  dumpIncludeGraph != "",
+		Trace:                         traceFile != "",
+		CompareTo:                     compareTo,
+		WarningsPolicy:                warningsPolicy.ToRPC(),
+		ExportFormats:                 exportFormats,
+	}
+	if cmd.Flags().Changed("max-flash-increase") {
+		compileRequest.MaxFlashIncrease = wrapperspb.Int64(maxFlashIncrease)
+	}
+	if cmd.Flags().Changed("max-ram-increase") {
+		compileRequest.MaxRamIncrease = wrapperspb.Int64(maxRAMIncrease)
+	}
+	verboseCompile := configuration.Settings.GetString("logging.level") == "debug"
+	if len(fqbn.All()) > 1 {
+		if uploadAfterCompile || watch {
+			feedback.Errorf(tr("Can't upload or watch the sketch when building for many boards"))
+			os.Exit(errorcodes.ErrBadArgument)
+		}
+		if traceFile != "" {
+			feedback.Errorf(tr("Can't trace the build when building for many boards"))
+			os.Exit(errorcodes.ErrBadArgument)
+		}
+		if compareTo != "" {
+			feedback.Errorf(tr("Can't compare the build with a previous one when building for many boards"))
+			os.Exit(errorcodes.ErrBadArgument)
+		}
+		runMultiCompile(compileRequest, verboseCompile)
+		return
 	}
+	if watch {
+		runWatch(inst, sketchPath, compileRequest, verboseCompile)
+		return
+	}
+
+	ctx, cancel := arguments.InterruptibleContext()
+	defer cancel()
 	compileStdOut := new(bytes.Buffer)
 	compileStdErr := new(bytes.Buffer)
-	verboseCompile := configuration.Settings.GetString("logging.level") == "debug"
 	var compileRes *rpc.CompileResponse
 	var compileError error
 	if output.OutputFormat == "json" {
-		compileRes, compileError = compile.Compile(context.Background(), compileRequest, compileStdOut, compileStdErr, verboseCompile)
+		compileRes, compileError = compile.Compile(ctx, compileRequest, compileStdOut, compileStdErr, nil, verboseCompile)
 	} else {
-		compileRes, compileError = compile.Compile(context.Background(), compileRequest, os.Stdout, os.Stderr, verboseCompile)
+		compileRes, compileError = compile.Compile(ctx, compileRequest, os.Stdout, os.Stderr, nil, verboseCompile)
 	}
 
-	if compileError == nil && uploadAfterCompile {
-		sk := arguments.NewSketch(sketchPath)
-		discoveryPort := port.GetDiscoveryPort(inst, sk)
-
-		userFieldRes, err := upload.SupportedUserFields(context.Background(), &rpc.SupportedUserFieldsRequest{
-			Instance: inst,
-			Fqbn:     fqbn.String(),
-			Protocol: discoveryPort.Protocol,
-		})
-		if err != nil {
-			feedback.Errorf(tr("Error during Upload: %v"), err)
-			os.Exit(errorcodes.ErrGeneric)
-		}
+	// The trace is written also when the build fails, it may tell why
+	writeTraceFile(compileRes)
 
-		fields := map[string]string{}
-		if len(userFieldRes.UserFields) > 0 {
-			feedback.Print(tr("Uploading to specified board using %s protocol requires the following info:", discoveryPort.Protocol))
-			fields = arguments.AskForUserFields(userFieldRes.UserFields)
-		}
-
-		uploadRequest := &rpc.UploadRequest{
-			Instance:   inst,
-			Fqbn:       fqbn.String(),
-			SketchPath: sketchPath.String(),
-			Port:       discoveryPort.ToRPC(),
-			Verbose:    verbose,
-			Verify:     verify,
-			ImportDir:  buildPath,
-			Programmer: programmer.String(),
-			UserFields: fields,
-		}
+	if compileError == nil && uploadAfterCompile {
+		uploadRequest := newUploadRequest(inst, sketchPath)
 
 		var uploadError error
 		if output.OutputFormat == "json" {
 			// TODO: do not print upload output in json mode
 			uploadStdOut := new(bytes.Buffer)
 			uploadStdErr := new(bytes.Buffer)
-			_, uploadError = upload.Upload(context.Background(), uploadRequest, uploadStdOut, uploadStdErr)
+			_, uploadError = upload.Upload(ctx, uploadRequest, uploadStdOut, uploadStdErr)
 		} else {
-			_, uploadError = upload.Upload(context.Background(), uploadRequest, os.Stdout, os.Stderr)
+			_, uploadError = upload.Upload(ctx, uploadRequest, os.Stdout, os.Stderr)
 		}
 		if uploadError != nil {
 			feedback.Errorf(tr("Error during Upload: %v"), uploadError)
@@ -230,10 +286,15 @@ func runCompileCommand(cmd *cobra.Command, args []string) {
 	}
 
 	feedback.PrintResult(&compileResult{
-		CompileOut:    compileStdOut.String(),
-		CompileErr:    compileStdErr.String(),
-		BuilderResult: compileRes,
-		Success:       compileError == nil,
+		CompileOut:      compileStdOut.String(),
+		CompileErr:      compileStdErr.String(),
+		BuilderResult:   compileRes,
+		Success:         compileError == nil,
+		showDiagnostics: showDiagnostics,
+		showSizeReport:  sizeReport,
+		verifyManifest:  verifyManifest != "",
+		includeGraph:    dumpIncludeGraph,
+		showTrace:       traceFile != "",
 	})
 	if compileError != nil && output.OutputFormat != "json" {
 		feedback.Errorf(tr("Error during build: %v"), compileError)
@@ -241,11 +302,226 @@ func runCompileCommand(cmd *cobra.Command, args []string) {
 	}
 }
 
+// newUploadRequest creates the request to upload the sketch after the build,
+// asking the user for the fields required by the upload tool.
+func newUploadRequest(inst *rpc.Instance, sketchPath *paths.Path) *rpc.UploadRequest {
+	sk := arguments.NewSketch(sketchPath)
+	discoveryPort := port.GetDiscoveryPort(inst, sk)
+
+	// The board of the build profile is used if not given explicitly
+	uploadFqbn := fqbn.String()
+	if uploadFqbn == "" && sk.Project != nil {
+		profileName := profile
+		if profileName == "" {
+			profileName = sk.Project.DefaultProfile
+		}
+		if p := sk.Project.GetProfile(profileName); p != nil {
+			uploadFqbn = p.FQBN
+		}
+	}
+
+	userFieldRes, err := upload.SupportedUserFields(context.Background(), &rpc.SupportedUserFieldsRequest{
+		Instance: inst,
+		Fqbn:     uploadFqbn,
+		Protocol: discoveryPort.Protocol,
+	})
+	if err != nil {
+		feedback.Errorf(tr("Error during Upload: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	fields := map[string]string{}
+	if len(userFieldRes.UserFields) > 0 {
+		feedback.Print(tr("Uploading to specified board using %s protocol requires the following info:", discoveryPort.Protocol))
+		fields = arguments.AskForUserFields(userFieldRes.UserFields)
+	}
+
+	return &rpc.UploadRequest{
+		Instance:   inst,
+		Fqbn:       uploadFqbn,
+		SketchPath: sketchPath.String(),
+		Port:       discoveryPort.ToRPC(),
+		Verbose:    verbose,
+		Verify:     verify,
+		ImportDir:  buildPath,
+		Programmer: programmer.String(),
+		UserFields: fields,
+	}
+}
+
+// writeTraceFile writes the timing of the steps of the build to the file given
+// with --trace, if any
+func writeTraceFile(res *rpc.CompileResponse) {
+	if traceFile == "" || len(res.GetTrace()) == 0 {
+		return
+	}
+	if err := writeChromeTrace(paths.New(traceFile), res.GetTrace()); err != nil {
+		feedback.Errorf(tr("Error writing the build trace: %v"), err)
+	}
+}
+
+// runWatch compiles the sketch every time a watched file changes, until the
+// process is terminated.
+func runWatch(inst *rpc.Instance, sketchPath *paths.Path, compileRequest *rpc.CompileRequest, debug bool) {
+	watchRequest := &rpc.CompileWatchRequest{Compile: compileRequest}
+	if uploadAfterCompile {
+		watchRequest.Upload = newUploadRequest(inst, sketchPath)
+	}
+
+	buildStarted := func(b *rpc.CompileWatchBuildStarted) {
+		if len(b.GetChangedFiles()) > 0 {
+			feedback.Print(tr("Changes detected in %s, compiling again...", strings.Join(b.GetChangedFiles(), ", ")))
+		}
+	}
+	var stdOut, stdErr io.Writer = os.Stdout, os.Stderr
+	compileStdOut := new(bytes.Buffer)
+	compileStdErr := new(bytes.Buffer)
+	if output.OutputFormat == "json" {
+		stdOut, stdErr = compileStdOut, compileStdErr
+	}
+	buildCompleted := func(b *rpc.CompileWatchBuildCompleted) {
+		defer compileStdOut.Reset()
+		defer compileStdErr.Reset()
+		writeTraceFile(b.GetResult())
+		feedback.PrintResult(&compileResult{
+			CompileOut:      compileStdOut.String(),
+			CompileErr:      compileStdErr.String(),
+			BuilderResult:   b.GetResult(),
+			Success:         b.GetError() == "",
+			showDiagnostics: showDiagnostics,
+			showSizeReport:  sizeReport,
+			includeGraph:    dumpIncludeGraph,
+			showTrace:       traceFile != "",
+		})
+		if b.GetError() != "" {
+			feedback.Errorf(tr("Error during build: %v"), b.GetError())
+		} else if b.GetUploadError() != "" {
+			feedback.Errorf(tr("Error during Upload: %v"), b.GetUploadError())
+		}
+		if output.OutputFormat != "json" {
+			feedback.Print(tr("Waiting for changes... (press Ctrl+C to stop)"))
+		}
+	}
+	ctx, cancel := arguments.InterruptibleContext()
+	defer cancel()
+	err := compile.Watch(ctx, watchRequest, stdOut, stdErr, nil, buildStarted, buildCompleted, debug)
+	if err != nil {
+		feedback.Errorf(tr("Error during build: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
+
+// runMultiCompile compiles the sketch for all the given boards at the same time
+func runMultiCompile(compileRequest *rpc.CompileRequest, debug bool) {
+	ctx, cancel := arguments.InterruptibleContext()
+	defer cancel()
+	res, err := compile.MultiCompile(ctx, &rpc.MultiCompileRequest{
+		Compile: compileRequest,
+		Fqbns:   fqbn.All(),
+	}, debug)
+	if err != nil {
+		feedback.Errorf(tr("Error during build: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	result := &multiCompileResult{
+		Results:         []*compileTargetResult{},
+		showDiagnostics: showDiagnostics,
+		showSizeReport:  sizeReport,
+		includeGraph:    dumpIncludeGraph,
+	}
+	failed := false
+	for _, r := range res.GetResults() {
+		result.Results = append(result.Results, &compileTargetResult{
+			Fqbn: r.GetFqbn(),
+			compileResult: compileResult{
+				CompileOut:    string(r.GetOutStream()),
+				CompileErr:    string(r.GetErrStream()),
+				BuilderResult: r.GetResult(),
+				Success:       r.GetError() == "",
+			},
+			Error: r.GetError(),
+		})
+		failed = failed || r.GetError() != ""
+	}
+	feedback.PrintResult(result)
+	if failed {
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
+
+type multiCompileResult struct {
+	Results []*compileTargetResult `json:"results"`
+
+	showDiagnostics bool
+	showSizeReport  bool
+	includeGraph    string
+}
+
+type compileTargetResult struct {
+	Fqbn string `json:"fqbn"`
+	compileResult
+	Error string `json:"error,omitempty"`
+}
+
+func (r *multiCompileResult) Data() interface{} {
+	return r
+}
+
+func (r *multiCompileResult) String() string {
+	// The output of the builds has been buffered, since they run at the same time
+	res := ""
+	for _, target := range r.Results {
+		res += tr("Build for %s:", target.Fqbn) + "\n"
+		res += target.CompileOut + target.CompileErr
+		target.showDiagnostics = r.showDiagnostics
+		target.showSizeReport = r.showSizeReport
+		target.includeGraph = r.includeGraph
+		if details := target.compileResult.String(); details != "" {
+			res += details + "\n"
+		}
+		if target.Error != "" {
+			res += tr("Error during build: %v", target.Error) + "\n"
+		}
+		res += "\n"
+	}
+
+	t := table.New()
+	t.SetHeader(tr("Board"), tr("Status"), tr("Flash"), tr("RAM"))
+	for _, target := range r.Results {
+		status := tr("OK")
+		if !target.Success {
+			status = tr("Failed")
+		}
+		flash, ram := "", ""
+		for _, section := range target.BuilderResult.GetExecutableSectionsSize() {
+			size := fmt.Sprint(section.GetSize())
+			if section.GetMaxSize() > 0 {
+				size += fmt.Sprintf(" (%d%%)", section.GetSize()*100/section.GetMaxSize())
+			}
+			switch section.GetName() {
+			case "text":
+				flash = size
+			case "data":
+				ram = size
+			}
+		}
+		t.AddRow(target.Fqbn, status, flash, ram)
+	}
+	return res + t.Render()
+}
+
 type compileResult struct {
 	CompileOut    string               `json:"compiler_out"`
 	CompileErr    string               `json:"compiler_err"`
 	BuilderResult *rpc.CompileResponse `json:"builder_result"`
 	Success       bool                 `json:"success"`
+
+	showDiagnostics bool
+	showSizeReport  bool
+	verifyManifest  bool
+	includeGraph    string
+	showTrace       bool
 }
 
 func (r *compileResult) Data() interface{} {
@@ -254,5 +530,207 @@ func (r *compileResult) Data() interface{} {
 
 func (r *compileResult) String() string {
 	// The output is already printed via os.Stdout/os.Stdin
-	return ""
+	res := ""
+	if r.showDiagnostics && len(r.BuilderResult.GetDiagnostics()) > 0 {
+		t := table.New()
+		t.SetHeader(tr("Severity"), tr("Location"), tr("Message"))
+		t.SetColumnWidthMode(2, table.Average)
+		for _, diag := range r.BuilderResult.GetDiagnostics() {
+			t.AddRow(diag.GetSeverity(), diagnosticLocation(diag), diag.GetMessage())
+			for _, note := range diag.GetNotes() {
+				t.AddRow("  "+note.GetSeverity(), diagnosticLocation(note), note.GetMessage())
+			}
+		}
+		res += t.Render()
+	}
+	if report := r.BuilderResult.GetSizeReport(); r.showSizeReport && report != nil {
+		if res != "" {
+			res += "\n"
+		}
+		res += sizeReportString(report)
+	}
+	if comparison := r.BuilderResult.GetSizeComparison(); comparison != nil {
+		if res != "" {
+			res += "\n"
+		}
+		res += sizeComparisonString(comparison)
+	}
+	if r.includeGraph != "" && r.BuilderResult != nil {
+		if res != "" {
+			res += "\n"
+		}
+		res += includeGraphString(r.includeGraph, r.BuilderResult.GetIncludeGraph())
+	}
+	if r.showTrace && len(r.BuilderResult.GetTrace()) > 0 {
+		if res != "" {
+			res += "\n"
+		}
+		res += traceSummaryString(r.BuilderResult.GetTrace())
+	}
+	if manifest := r.BuilderResult.GetBuildManifest(); manifest != "" && r.Success {
+		if res != "" {
+			res += "\n"
+		}
+		if r.verifyManifest {
+			res += tr("The rebuilt binaries match the build manifest.")
+		} else {
+			res += tr("Build manifest written to %s", manifest)
+		}
+		if signature := r.BuilderResult.GetBuildManifestSignature(); signature != "" {
+			res += "\n" + tr("Build manifest signature written to %s", signature)
+		}
+	}
+	return res
+}
+
+// maxSizeReportSymbols is the number of symbols printed in the size report
+const maxSizeReportSymbols = 20
+
+func sizeReportString(report *rpc.MemoryUsageReport) string {
+	t := table.New()
+	t.SetHeader(tr("Component"), tr("Type"), tr("Flash"), tr("RAM"))
+	for _, c := range report.GetComponents() {
+		t.AddRow(c.GetName(), c.GetKind(), fmt.Sprint(c.GetFlash()), fmt.Sprint(c.GetRam()))
+	}
+	t.AddRow(tr("Total"), "", fmt.Sprint(report.GetFlash()), fmt.Sprint(report.GetRam()))
+	res := t.Render()
+
+	if len(report.GetObjects()) > 0 {
+		t = table.New()
+		t.SetHeader(tr("Object"), tr("Type"), tr("Flash"), tr("RAM"))
+		t.SetColumnWidthMode(0, table.Average)
+		for _, o := range report.GetObjects() {
+			t.AddRow(o.GetName(), o.GetKind(), fmt.Sprint(o.GetFlash()), fmt.Sprint(o.GetRam()))
+		}
+		res += "\n" + t.Render()
+	}
+
+	if len(report.GetSymbols()) > 0 {
+		t = table.New()
+		t.SetHeader(tr("Symbol"), tr("Section"), tr("Component"), tr("Flash"), tr("RAM"))
+		t.SetColumnWidthMode(0, table.Average)
+		for i, s := range report.GetSymbols() {
+			if i == maxSizeReportSymbols {
+				break
+			}
+			t.AddRow(s.GetName(), s.GetSection(), s.GetComponent(), fmt.Sprint(s.GetFlash()), fmt.Sprint(s.GetRam()))
+		}
+		res += "\n" + tr("Biggest symbols:") + "\n" + t.Render()
+	}
+	return res
+}
+
+func sizeComparisonString(c *rpc.SizeComparison) string {
+	res := tr("Memory used compared with %s:", c.GetPreviousExecutable()) + "\n"
+	flashSummary := sizeChangeSummary(tr("flash"), c.GetFlash()-c.GetPreviousFlash(), c.GetComponents(), (*rpc.MemoryUsageDelta).GetPreviousFlash, (*rpc.MemoryUsageDelta).GetFlash)
+	ramSummary := sizeChangeSummary(tr("RAM"), c.GetRam()-c.GetPreviousRam(), c.GetComponents(), (*rpc.MemoryUsageDelta).GetPreviousRam, (*rpc.MemoryUsageDelta).GetRam)
+	if flashSummary == "" && ramSummary == "" {
+		res += tr("This change doesn't change the memory used.") + "\n"
+	}
+	for _, summary := range []string{flashSummary, ramSummary} {
+		if summary != "" {
+			res += summary + "\n"
+		}
+	}
+
+	t := table.New()
+	t.SetHeader(tr("Memory"), tr("Previous"), tr("Current"), tr("Change"))
+	t.AddRow(tr("Flash"), fmt.Sprint(c.GetPreviousFlash()), fmt.Sprint(c.GetFlash()), fmt.Sprintf("%+d", c.GetFlash()-c.GetPreviousFlash()))
+	t.AddRow(tr("RAM"), fmt.Sprint(c.GetPreviousRam()), fmt.Sprint(c.GetRam()), fmt.Sprintf("%+d", c.GetRam()-c.GetPreviousRam()))
+	for _, s := range c.GetSections() {
+		if s.GetPreviousSize() == 0 && s.GetSize() == 0 {
+			continue
+		}
+		t.AddRow("."+s.GetName(), fmt.Sprint(s.GetPreviousSize()), fmt.Sprint(s.GetSize()), fmt.Sprintf("%+d", s.GetSize()-s.GetPreviousSize()))
+	}
+	res += "\n" + t.Render()
+
+	if len(c.GetComponents()) > 0 {
+		t = table.New()
+		t.SetHeader(tr("Component"), tr("Type"), tr("Flash"), tr("RAM"))
+		for _, d := range c.GetComponents() {
+			t.AddRow(d.GetName(), d.GetKind(), fmt.Sprintf("%+d", d.GetFlash()-d.GetPreviousFlash()), fmt.Sprintf("%+d", d.GetRam()-d.GetPreviousRam()))
+		}
+		res += "\n" + t.Render()
+	}
+
+	if len(c.GetSymbols()) > 0 {
+		t = table.New()
+		t.SetHeader(tr("Symbol"), tr("Component"), tr("Flash"), tr("RAM"))
+		t.SetColumnWidthMode(0, table.Average)
+		for i, d := range c.GetSymbols() {
+			if i == maxSizeReportSymbols {
+				break
+			}
+			t.AddRow(d.GetName(), d.GetComponent(), fmt.Sprintf("%+d", d.GetFlash()-d.GetPreviousFlash()), fmt.Sprintf("%+d", d.GetRam()-d.GetPreviousRam()))
+		}
+		res += "\n" + tr("Biggest changes:") + "\n" + t.Render()
+	}
+	return res
+}
+
+// sizeChangeSummary describes the change of the given memory, and the
+// component that contributed most to it, or returns an empty string if the
+// memory used didn't change
+func sizeChangeSummary(memory string, delta int64, components []*rpc.MemoryUsageDelta, previous, current func(*rpc.MemoryUsageDelta) int64) string {
+	if delta == 0 {
+		return ""
+	}
+	var biggest *rpc.MemoryUsageDelta
+	var biggestDelta int64
+	for _, c := range components {
+		// Only the components that changed in the same direction contribute
+		if d := current(c) - previous(c); d*delta > 0 && (biggest == nil || abs(d) > abs(biggestDelta)) {
+			biggest, biggestDelta = c, d
+		}
+	}
+	if biggest == nil {
+		if delta > 0 {
+			return tr("This change adds %[1]s of %[2]s.", formatBytes(delta), memory)
+		}
+		return tr("This change saves %[1]s of %[2]s.", formatBytes(-delta), memory)
+	}
+	if delta > 0 {
+		return tr("This change adds %[1]s of %[2]s, %[3]s of it from %[4]s.", formatBytes(delta), memory, formatBytes(biggestDelta), componentDescription(biggest))
+	}
+	return tr("This change saves %[1]s of %[2]s, %[3]s of it from %[4]s.", formatBytes(-delta), memory, formatBytes(-biggestDelta), componentDescription(biggest))
+}
+
+func componentDescription(c *rpc.MemoryUsageDelta) string {
+	switch c.GetKind() {
+	case "sketch":
+		return tr("the sketch")
+	case "library":
+		return tr("the %s library", c.GetName())
+	case "core":
+		return tr("the core")
+	default:
+		return tr("other code")
+	}
+}
+
+// formatBytes returns the size in bytes, or in KB if bigger than 1 KB
+func formatBytes(size int64) string {
+	if abs(size) < 1024 {
+		return fmt.Sprintf("%d B", size)
+	}
+	return fmt.Sprintf("%.1f KB", float64(size)/1024)
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func diagnosticLocation(diag *rpc.CompileDiagnostic) string {
+	location := diag.GetFile()
+	if diag.GetLine() > 0 {
+		location += fmt.Sprintf(":%d", diag.GetLine())
+		if diag.GetColumn() > 0 {
+			location += fmt.Sprintf(":%d", diag.GetColumn())
+		}
+	}
+	return location
 }
@@ -0,0 +1,101 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// includeGraphString renders the include graph in the given format, "json" or "dot"
+func includeGraphString(format string, edges []*rpc.IncludeGraphEdge) string {
+	if format == "dot" {
+		return includeGraphDot(edges)
+	}
+	if edges == nil {
+		edges = []*rpc.IncludeGraphEdge{}
+	}
+	data, err := json.MarshalIndent(edges, "", "  ")
+	if err != nil {
+		return fmt.Sprintf("%v", err)
+	}
+	return string(data)
+}
+
+// includeGraphDot renders the include graph in the Graphviz DOT language: the
+// sketch and the libraries chosen are solid boxes, the alternatives rejected
+// are dashed boxes.
+func includeGraphDot(edges []*rpc.IncludeGraphEdge) string {
+	var b strings.Builder
+	b.WriteString("digraph includes {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box];\n")
+	b.WriteString("  " + dotQuote("sketch") + " [label=" + dotQuote("sketch") + ", style=bold];\n")
+
+	declared := map[string]bool{}
+	for _, edge := range edges {
+		from := "sketch"
+		if edge.GetSourceLibrary() != "" {
+			from = "lib:" + edge.GetSourceLibrary()
+		}
+
+		selected := edge.GetSelected()
+		to := "lib:" + selected.GetName()
+		if !declared[to] {
+			declared[to] = true
+			fmt.Fprintf(&b, "  %s [label=%s];\n", dotQuote(to), dotLabel(selected))
+		}
+		fmt.Fprintf(&b, "  %s -> %s [label=%s];\n", dotQuote(from), dotQuote(to),
+			dotQuote(filepath.Base(edge.GetSourceFile())+": "+edge.GetHeader()+" ("+edge.GetReason()+")"))
+
+		for _, alternative := range edge.GetAlternatives() {
+			id := "alt:" + alternative.GetInstallDir()
+			if !declared[id] {
+				declared[id] = true
+				fmt.Fprintf(&b, "  %s [label=%s, style=dashed, color=gray];\n", dotQuote(id), dotLabel(alternative))
+			}
+			fmt.Fprintf(&b, "  %s -> %s [label=%s, style=dashed, color=gray];\n", dotQuote(from), dotQuote(id),
+				dotQuote(edge.GetHeader()))
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func dotLabel(candidate *rpc.LibraryCandidate) string {
+	name := candidate.GetName()
+	if candidate.GetVersion() != "" {
+		name += "@" + candidate.GetVersion()
+	}
+	details := fmt.Sprintf("%s, %s, %d",
+		strings.ToLower(strings.TrimPrefix(candidate.GetLocation().String(), "LIBRARY_LOCATION_")),
+		candidate.GetArchitectureMatch(),
+		candidate.GetPriority())
+	return `"` + dotEscape(name) + `\n` + dotEscape(details) + `"`
+}
+
+func dotQuote(s string) string {
+	return `"` + dotEscape(s) + `"`
+}
+
+func dotEscape(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return strings.ReplaceAll(s, `"`, `\"`)
+}
@@ -0,0 +1,130 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// maxTraceSummaryRows is the number of phases and files printed in the summary of the build trace
+const maxTraceSummaryRows = 10
+
+// chromeTraceEvent is an event of the Chrome trace-event format, see:
+// https://docs.google.com/document/d/1CvAClvFfyA5R-PhYUmn5OOQtYMH4h6I0nSsKchNAySU
+type chromeTraceEvent struct {
+	Name     string            `json:"name"`
+	Category string            `json:"cat,omitempty"`
+	Phase    string            `json:"ph"`
+	Ts       int64             `json:"ts"`
+	Dur      int64             `json:"dur,omitempty"`
+	Pid      int               `json:"pid"`
+	Tid      int32             `json:"tid"`
+	Args     map[string]string `json:"args,omitempty"`
+}
+
+// writeChromeTrace writes the spans of the build in the Chrome trace-event
+// format, that can be loaded in chrome://tracing or https://ui.perfetto.dev
+func writeChromeTrace(file *paths.Path, spans []*rpc.BuildTraceSpan) error {
+	events := []*chromeTraceEvent{}
+	lanes := map[int32]bool{}
+	for _, span := range spans {
+		events = append(events, &chromeTraceEvent{
+			Name:     span.GetName(),
+			Category: span.GetCategory(),
+			Phase:    "X",
+			Ts:       span.GetStartUs(),
+			Dur:      span.GetDurationUs(),
+			Pid:      1,
+			Tid:      span.GetLane(),
+		})
+		lanes[span.GetLane()] = true
+	}
+	for lane := range lanes {
+		name := "builder"
+		if lane > 0 {
+			name = fmt.Sprintf("job %d", lane)
+		}
+		events = append(events, &chromeTraceEvent{
+			Name:  "thread_name",
+			Phase: "M",
+			Pid:   1,
+			Tid:   lane,
+			Args:  map[string]string{"name": name},
+		})
+	}
+	data, err := json.Marshal(map[string]interface{}{
+		"traceEvents":     events,
+		"displayTimeUnit": "ms",
+	})
+	if err != nil {
+		return err
+	}
+	return file.WriteFile(data)
+}
+
+// traceSummaryString renders the slowest phases of the build and the slowest
+// files processed
+func traceSummaryString(spans []*rpc.BuildTraceSpan) string {
+	phases := []*rpc.BuildTraceSpan{}
+	files := []*rpc.BuildTraceSpan{}
+	for _, span := range spans {
+		switch span.GetCategory() {
+		case "command":
+			if span.GetDepth() == 0 {
+				phases = append(phases, span)
+			}
+		case "includes", "compile", "archive":
+			files = append(files, span)
+		}
+	}
+	slowestFirst := func(list []*rpc.BuildTraceSpan) {
+		sort.SliceStable(list, func(i, j int) bool { return list[i].GetDurationUs() > list[j].GetDurationUs() })
+	}
+	slowestFirst(phases)
+	slowestFirst(files)
+
+	t := table.New()
+	t.SetHeader(tr("Phase"), tr("Time"))
+	for i, span := range phases {
+		if i == maxTraceSummaryRows {
+			break
+		}
+		t.AddRow(span.GetName(), traceDuration(span))
+	}
+	res := t.Render()
+
+	t = table.New()
+	t.SetHeader(tr("File"), tr("Step"), tr("Time"))
+	t.SetColumnWidthMode(0, table.Average)
+	for i, span := range files {
+		if i == maxTraceSummaryRows {
+			break
+		}
+		t.AddRow(span.GetName(), span.GetCategory(), traceDuration(span))
+	}
+	return res + "\n" + t.Render()
+}
+
+func traceDuration(span *rpc.BuildTraceSpan) string {
+	return (time.Duration(span.GetDurationUs()) * time.Microsecond).Round(time.Millisecond).String()
+}
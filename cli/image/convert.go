@@ -0,0 +1,62 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package image
+
+import (
+	"fmt"
+	"os"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var (
+	convertOutput       outputFlags
+	convertInputFormat  string
+	convertInputAddress string
+)
+
+// initConvertCommand creates a new `convert` command
+func initConvertCommand() *cobra.Command {
+	convertCommand := &cobra.Command{
+		Use:   fmt.Sprintf("convert <%s> -o <%s>", tr("image"), tr("output")),
+		Short: tr("Converts a firmware image to another format."),
+		Long:  tr("Converts a firmware image to another format."),
+		Example: "" +
+			"  " + os.Args[0] + " image convert MySketch.ino.hex -o MySketch.ino.bin\n" +
+			"  " + os.Args[0] + " image convert MySketch.ino.bin --input-address 0x2000 -o MySketch.ino.uf2 --family-id 0x68ED2B88",
+		Args: cobra.ExactArgs(1),
+		Run:  runConvertCommand,
+	}
+	convertCommand.Flags().StringVar(&convertInputFormat, "input-format", "", tr("Format of the input image, by default it's taken from the extension of the input file."))
+	convertCommand.Flags().StringVar(&convertInputAddress, "input-address", "", tr("Address of the first byte of a bin input image, e.g.: 0x2000"))
+	convertOutput.addToCommand(convertCommand)
+	return convertCommand
+}
+
+func runConvertCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino-cli image convert`")
+
+	input := &rpc.ImageInput{
+		Path:   args[0],
+		Format: convertInputFormat,
+	}
+	if convertInputAddress != "" {
+		input.Offset = parseNumber(convertInputAddress)
+	}
+	runImageMerge(convertOutput.request([]*rpc.ImageInput{input}))
+}
@@ -0,0 +1,117 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package image
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/firmware"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/commands/image"
+	"github.com/arduino/arduino-cli/i18n"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var tr = i18n.Tr
+
+// NewCommand created a new `image` command
+func NewCommand() *cobra.Command {
+	imageCommand := &cobra.Command{
+		Use:     "image",
+		Short:   tr("Arduino CLI firmware image commands."),
+		Long:    tr("Arduino CLI firmware image commands, the supported formats are: %s.", strings.Join(firmware.Formats, ", ")),
+		Example: "  " + os.Args[0] + " image convert MySketch.ino.hex -o MySketch.ino.bin",
+	}
+
+	imageCommand.AddCommand(initMergeCommand())
+	imageCommand.AddCommand(initConvertCommand())
+
+	return imageCommand
+}
+
+// outputFlags contains the flags used to write the resulting image
+type outputFlags struct {
+	path        string
+	format      string
+	fillByte    string
+	baseAddress string
+	familyID    string
+}
+
+func (o *outputFlags) addToCommand(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&o.path, "output", "o", "", tr("Path of the output image."))
+	cmd.Flags().StringVar(&o.format, "image-format", "", tr("Format of the output image, by default it's taken from the extension of the output file: %s", strings.Join(firmware.Formats, ", ")))
+	cmd.Flags().StringVar(&o.fillByte, "fill-byte", "", tr("Byte used to fill the gaps of a bin image, e.g.: 0x00"))
+	cmd.Flags().StringVar(&o.baseAddress, "base-address", "", tr("Address of the first byte of a bin image, e.g.: 0x2000"))
+	cmd.Flags().StringVar(&o.familyID, "family-id", "", tr("Family ID of the blocks of an uf2 image, e.g.: 0xADA52840"))
+	cmd.MarkFlagRequired("output")
+}
+
+// request returns the request to merge the inputs with the output options
+func (o *outputFlags) request(inputs []*rpc.ImageInput) *rpc.ImageMergeRequest {
+	req := &rpc.ImageMergeRequest{
+		Inputs:       inputs,
+		OutputPath:   o.path,
+		OutputFormat: o.format,
+	}
+	if o.fillByte != "" {
+		req.FillByte = wrapperspb.UInt32(parseNumber(o.fillByte))
+	}
+	if o.baseAddress != "" {
+		req.BaseAddress = wrapperspb.UInt32(parseNumber(o.baseAddress))
+	}
+	if o.familyID != "" {
+		req.FamilyId = wrapperspb.UInt32(parseNumber(o.familyID))
+	}
+	return req
+}
+
+func parseNumber(s string) uint32 {
+	value, err := firmware.ParseAddress(s)
+	if err != nil {
+		feedback.Errorf(tr("Invalid argument: %v"), err)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	return value
+}
+
+func runImageMerge(req *rpc.ImageMergeRequest) {
+	res, err := image.ImageMerge(context.Background(), req)
+	if err != nil {
+		feedback.Errorf(tr("Error writing image: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	feedback.PrintResult(imageResult{res: res})
+}
+
+// imageResult contains the image written by the image commands
+type imageResult struct {
+	res *rpc.ImageMergeResponse
+}
+
+func (r imageResult) Data() interface{} {
+	return r.res
+}
+
+func (r imageResult) String() string {
+	return tr("Written %[1]s image %[2]s with data from 0x%08[3]X to 0x%08[4]X",
+		r.res.GetOutputFormat(), r.res.GetOutputPath(), r.res.GetStartAddress(), r.res.GetEndAddress())
+}
@@ -0,0 +1,67 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package image
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/firmware"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var mergeOutput outputFlags
+
+// initMergeCommand creates a new `merge` command
+func initMergeCommand() *cobra.Command {
+	mergeCommand := &cobra.Command{
+		Use:   fmt.Sprintf("merge <%s>[@<%s>]... -o <%s>", tr("image"), tr("offset"), tr("output")),
+		Short: tr("Merges many firmware images in a single image."),
+		Long: tr("Merges many firmware images in a single image. The data of a bin image is placed at the given offset, " +
+			"the addresses of the hex and uf2 images are moved by the offset. The images must not overlap."),
+		Example: "" +
+			"  " + os.Args[0] + " image merge MySketch.ino.hex bootloader.hex -o MySketch.merged.hex\n" +
+			"  " + os.Args[0] + " image merge bootloader.bin MySketch.ino.bin@0x10000 partitions.bin@0x8000 -o MySketch.merged.bin",
+		Args: cobra.MinimumNArgs(1),
+		Run:  runMergeCommand,
+	}
+	mergeOutput.addToCommand(mergeCommand)
+	return mergeCommand
+}
+
+func runMergeCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino-cli image merge`")
+
+	inputs := []*rpc.ImageInput{}
+	for _, arg := range args {
+		inputs = append(inputs, parseInput(arg))
+	}
+	runImageMerge(mergeOutput.request(inputs))
+}
+
+// parseInput parses an image argument in the form PATH[@OFFSET], the path is
+// taken as is if the text after the last @ isn't a valid offset
+func parseInput(arg string) *rpc.ImageInput {
+	if i := strings.LastIndex(arg, "@"); i != -1 {
+		if offset, err := firmware.ParseAddress(arg[i+1:]); err == nil {
+			return &rpc.ImageInput{Path: arg[:i], Offset: offset}
+		}
+	}
+	return &rpc.ImageInput{Path: arg}
+}
@@ -44,5 +44,7 @@ func NewCommand() *cobra.Command {
 	libCommand.AddCommand(initUpgradeCommand())
 	libCommand.AddCommand(initUpdateIndexCommand())
 	libCommand.AddCommand(initDepsCommand())
+	libCommand.AddCommand(initResolveCommand())
+	libCommand.AddCommand(initPrecompileCommand())
 	return libCommand
 }
@@ -0,0 +1,119 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/arduino/arduino-cli/cli/arguments"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/cli/output"
+	"github.com/arduino/arduino-cli/commands/lib"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var (
+	precompileFqbn        arguments.Fqbn
+	precompileWithSources bool
+	precompileOutput      string
+	precompileVerbose     bool
+)
+
+func initPrecompileCommand() *cobra.Command {
+	precompileCommand := &cobra.Command{
+		Use:   fmt.Sprintf("precompile %s", tr("LIBRARY")),
+		Short: tr("Compiles a library for one or more boards and packages it as a precompiled library."),
+		Long: tr(`Compiles a library for one or more boards and packages it as a precompiled library.
+
+The library, installed in the user directory or given with the path of its
+folder, is compiled for each board and the static library is added to the
+src/{build.mcu} folder, or to src/{build.mcu}/{fpu}-{float-abi} if the board
+selects a floating point configuration. The library is declared
+precompiled=true and its sources are removed, unless --with-sources is given:
+then the library is declared precompiled=full and its sources are compiled for
+the boards without a binary. The library is written in a zip file, that can be
+installed with "lib install --zip-path". The installed library isn't changed.`),
+		Example: "" +
+			"  " + os.Args[0] + " lib precompile MyLibrary -b arduino:samd:mkr1000 -b arduino:mbed_nano:nano33ble\n" +
+			"  " + os.Args[0] + " lib precompile /home/user/MyLibrary -b arduino:avr:uno --with-sources --output MyLibrary.zip",
+		Args: cobra.ExactArgs(1),
+		Run:  runPrecompileCommand,
+	}
+	precompileFqbn.AddToCommandAllowMultiple(precompileCommand)
+	precompileCommand.Flags().BoolVar(&precompileWithSources, "with-sources", false, tr("Keep the sources of the library, to compile them for the boards without a binary."))
+	precompileCommand.Flags().StringVarP(&precompileOutput, "output", "o", "", tr("Path of the zip file to write, by default it's written in the current folder."))
+	precompileCommand.Flags().BoolVarP(&precompileVerbose, "verbose", "v", false, tr("Optional, turns on verbose mode."))
+	return precompileCommand
+}
+
+func runPrecompileCommand(cmd *cobra.Command, args []string) {
+	instance := instance.CreateAndInit()
+	logrus.Info("Executing `arduino-cli lib precompile`")
+
+	if len(precompileFqbn.All()) == 0 {
+		feedback.Errorf(tr("Missing FQBN (Fully Qualified Board Name)"))
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	req := &rpc.LibraryPrecompileRequest{
+		Instance:    instance,
+		Library:     args[0],
+		Fqbn:        precompileFqbn.All(),
+		WithSources: precompileWithSources,
+		OutputPath:  precompileOutput,
+		Verbose:     precompileVerbose,
+	}
+
+	ctx, cancel := arguments.InterruptibleContext()
+	defer cancel()
+	var res *rpc.LibraryPrecompileResponse
+	var err error
+	if output.OutputFormat == "json" {
+		res, err = lib.LibraryPrecompile(ctx, req, new(bytes.Buffer), new(bytes.Buffer))
+	} else {
+		res, err = lib.LibraryPrecompile(ctx, req, os.Stdout, os.Stderr)
+	}
+	if err != nil {
+		feedback.Errorf(tr("Error precompiling library: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	feedback.PrintResult(precompileResult{res})
+	logrus.Info("Done")
+}
+
+type precompileResult struct {
+	res *rpc.LibraryPrecompileResponse
+}
+
+func (pr precompileResult) Data() interface{} {
+	return pr.res
+}
+
+func (pr precompileResult) String() string {
+	t := table.New()
+	t.SetHeader(tr("Board"), tr("Binary"))
+	for _, binary := range pr.res.GetBinaries() {
+		t.AddRow(binary.GetFqbn(), binary.GetPath())
+	}
+	return t.Render() + "\n" + tr("Precompiled library written to %s", pr.res.GetOutputPath())
+}
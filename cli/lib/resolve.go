@@ -0,0 +1,185 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/commands/lib"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var (
+	overrideLibrary  string
+	removeOverride   bool
+	resolveProfile   string
+	resolveLibrary   []string
+	resolveLibraries []string
+)
+
+func initResolveCommand() *cobra.Command {
+	resolveCommand := &cobra.Command{
+		Use:   fmt.Sprintf("resolve %s [%s]", tr("HEADER"), tr("SKETCH_PATH")),
+		Short: tr("Shows the libraries providing a header and the one used by the build."),
+		Long: tr(`Shows the libraries providing a header and the one used by the build.
+
+All the libraries providing the header are listed with the priority computed
+for the board: the one with the highest priority is used by the build. The
+--override flag makes the build always use the given library for the header,
+the choice is saved in the library.header_overrides setting.
+
+The libraries are the ones the build would consider: the libraries given with
+--library and --libraries, the installed ones (or the ones pinned by the
+--profile of the sketch) and the ones bundled with the platforms. If the sketch
+path is given, the board attached to it or of its profile is used when --fqbn
+isn't set.`),
+		Example: "" +
+			"  " + os.Args[0] + " lib resolve Servo.h -b arduino:avr:uno\n" +
+			"  " + os.Args[0] + " lib resolve Servo.h -b esp32:esp32:esp32 --override ServoESP32\n" +
+			"  " + os.Args[0] + " lib resolve Servo.h -b esp32:esp32:esp32 --remove-override\n" +
+			"  " + os.Args[0] + " lib resolve Servo.h /home/user/Arduino/MySketch --profile nanorp",
+		Args: cobra.RangeArgs(1, 2),
+		Run:  runResolveCommand,
+	}
+	fqbn.AddToCommand(resolveCommand)
+	resolveCommand.Flags().StringVar(&overrideLibrary, "override", "", tr("Always use the given library for the header."))
+	resolveCommand.Flags().StringVarP(&resolveProfile, "profile", "m", "", tr("Build profile to use, as declared in the sketch.yaml file of the sketch."))
+	resolveCommand.Flags().StringSliceVar(&resolveLibrary, "library", []string{},
+		tr("List of paths to libraries root folders. Libraries set this way have top priority in case of conflicts. Can be used multiple times for different libraries."))
+	resolveCommand.Flags().StringSliceVar(&resolveLibraries, "libraries", []string{},
+		tr("List of custom libraries dir paths separated by commas. Or can be used multiple times for multiple libraries dir paths."))
+	resolveCommand.Flags().BoolVar(&removeOverride, "remove-override", false, tr("Remove the library chosen for the header with --override."))
+	return resolveCommand
+}
+
+func runResolveCommand(cmd *cobra.Command, args []string) {
+	instance := instance.CreateAndInit()
+	logrus.Info("Executing `arduino-cli lib resolve`")
+
+	header := args[0]
+	if overrideLibrary != "" && removeOverride {
+		feedback.Errorf(tr("Can't use both --override and --remove-override"))
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	sketchPath := ""
+	if len(args) > 1 {
+		sketchPath = args[1]
+	}
+	req := &rpc.LibraryResolveRequest{
+		Instance:   instance,
+		Header:     header,
+		Fqbn:       fqbn.String(),
+		SketchPath: sketchPath,
+		Profile:    resolveProfile,
+		Libraries:  resolveLibraries,
+		Library:    resolveLibrary,
+	}
+	res, err := lib.LibraryResolve(context.Background(), req)
+	if err != nil {
+		feedback.Errorf(tr("Error resolving header: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	if overrideLibrary != "" || removeOverride {
+		if overrideLibrary != "" && !providesHeader(res, overrideLibrary) {
+			feedback.Errorf(tr("The library %[1]s doesn't provide %[2]s"), overrideLibrary, header)
+			os.Exit(errorcodes.ErrBadArgument)
+		}
+		configuration.SetLibraryHeaderOverride(configuration.Settings, header, overrideLibrary)
+		if err := configuration.Settings.WriteConfig(); err != nil {
+			feedback.Errorf(tr("Writing config file: %v"), err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+		res, err = lib.LibraryResolve(context.Background(), req)
+		if err != nil {
+			feedback.Errorf(tr("Error resolving header: %v"), err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+	}
+
+	feedback.PrintResult(resolveResult{res})
+	logrus.Info("Done")
+}
+
+func providesHeader(res *rpc.LibraryResolveResponse, library string) bool {
+	if res.GetSelected().GetName() == library {
+		return true
+	}
+	for _, alternative := range res.GetAlternatives() {
+		if alternative.GetName() == library {
+			return true
+		}
+	}
+	return false
+}
+
+type resolveResult struct {
+	resolution *rpc.LibraryResolveResponse
+}
+
+func (rr resolveResult) Data() interface{} {
+	return rr.resolution
+}
+
+func (rr resolveResult) String() string {
+	t := table.New()
+	t.SetHeader(tr("Name"), tr("Version"), tr("Location"), tr("Architecture"), tr("Priority"), tr("Used"))
+	addRow := func(candidate *rpc.LibraryCandidate, used string) {
+		t.AddRow(candidate.GetName(), candidate.GetVersion(), candidate.GetLocation().String(),
+			candidate.GetArchitectureMatch(), fmt.Sprint(candidate.GetPriority()), used)
+	}
+	addRow(rr.resolution.GetSelected(), tr("yes"))
+	for _, alternative := range rr.resolution.GetAlternatives() {
+		addRow(alternative, tr("no"))
+	}
+
+	res := t.Render()
+	res += "\n" + tr("Used: %s", rr.resolution.GetSelected().GetInstallDir())
+	res += "\n" + tr("Reason: %s", resolveReason(rr.resolution.GetReason()))
+	if o := rr.resolution.GetOverride(); o != "" && rr.resolution.GetReason() != "override" {
+		res += "\n" + tr("The library %s chosen with --override doesn't provide the header", o)
+	}
+	return res
+}
+
+func resolveReason(reason string) string {
+	switch reason {
+	case "override":
+		return tr("the library has been chosen with --override")
+	case "only-candidate":
+		return tr("it's the only library providing the header")
+	case "architecture":
+		return tr("it matches the architecture of the board better")
+	case "name":
+		return tr("its name matches the header better")
+	case "location":
+		return tr("it's installed in a location with higher priority")
+	case "name-distance":
+		return tr("more libraries have the same priority, its name is the closest to the header")
+	case "alphabetical-order":
+		return tr("more libraries have the same priority, it's the first in alphabetical order")
+	}
+	return reason
+}
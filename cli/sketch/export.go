@@ -0,0 +1,156 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/arduino/arduino-cli/cli/arguments"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/cli/output"
+	"github.com/arduino/arduino-cli/commands/compile"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var (
+	exportFqbn             arguments.Fqbn
+	exportFormat           string
+	exportBuildProperties  []string
+	exportWarnings         string
+	exportWarningsPolicy   arguments.WarningsPolicy
+	exportOptimizeForDebug bool
+	exportLibrary          []string
+	exportLibraries        []string
+	exportProfile          string
+	exportVerbose          bool
+)
+
+// initExportCommand creates a new `export` command
+func initExportCommand() *cobra.Command {
+	exportCommand := &cobra.Command{
+		Use:   fmt.Sprintf("export <%s> <%s>", tr("sketchPath"), tr("outputDir")),
+		Short: tr("Exports the build of a sketch as a standalone project."),
+		Long: tr("Exports the build of a sketch as a standalone project, that can be built without Arduino CLI. " +
+			"The project contains the preprocessed sketch, the sources of the libraries and of the core used by the build and, " +
+			"depending on the format, a Makefile (make) or a CMakeLists.txt (cmake) running the same commands of the build. " +
+			"The cpp format exports only the sources. The toolchains are not copied: their paths are variables of the build files."),
+		Example: "" +
+			"  " + os.Args[0] + " sketch export -b arduino:avr:uno\n" +
+			"  " + os.Args[0] + " sketch export -b arduino:avr:uno --format cmake /home/user/Arduino/MySketch\n" +
+			"  " + os.Args[0] + " sketch export -b arduino:avr:uno /home/user/Arduino/MySketch /home/user/MySketchProject",
+		Args: cobra.MaximumNArgs(2),
+		Run:  runExportCommand,
+	}
+
+	exportFqbn.AddToCommand(exportCommand)
+	exportCommand.Flags().StringVar(&exportFormat, "format", "make", tr("Format of the project, can be: %s.", "make, cmake, cpp"))
+	exportCommand.Flags().StringArrayVar(&exportBuildProperties, "build-property", []string{},
+		tr("Override a build property with a custom value. Can be used multiple times for multiple properties."))
+	exportCommand.Flags().StringVar(&exportWarnings, "warnings", "none",
+		tr(`Optional, can be: %s. Used to tell gcc which warning level to use (-W flag).`, "none, default, more, all"))
+	exportWarningsPolicy.AddToCommand(exportCommand)
+	exportCommand.Flags().BoolVar(&exportOptimizeForDebug, "optimize-for-debug", false, tr("Optional, optimize compile output for debugging, rather than for release."))
+	exportCommand.Flags().StringSliceVar(&exportLibrary, "library", []string{},
+		tr("List of paths to libraries root folders. Libraries set this way have top priority in case of conflicts. Can be used multiple times for different libraries."))
+	exportCommand.Flags().StringSliceVar(&exportLibraries, "libraries", []string{},
+		tr("List of custom libraries dir paths separated by commas. Or can be used multiple times for multiple libraries dir paths."))
+	exportCommand.Flags().StringVarP(&exportProfile, "profile", "m", "", tr("Build profile to use, as declared in the sketch.yaml file of the sketch. The platforms and libraries pinned by the profile are installed if missing."))
+	exportCommand.Flags().BoolVarP(&exportVerbose, "verbose", "v", false, tr("Optional, turns on verbose mode."))
+
+	return exportCommand
+}
+
+func runExportCommand(cmd *cobra.Command, args []string) {
+	inst := instance.CreateAndInit()
+
+	logrus.Info("Executing `arduino-cli sketch export`")
+
+	path := ""
+	if len(args) > 0 {
+		path = args[0]
+	}
+	sketchPath := arguments.InitSketchPath(path)
+
+	outputDir := ""
+	if len(args) == 2 {
+		outputDir = args[1]
+	}
+
+	req := &rpc.ExportSketchRequest{
+		Compile: &rpc.CompileRequest{
+			Instance:         inst,
+			Fqbn:             exportFqbn.String(),
+			SketchPath:       sketchPath.String(),
+			BuildProperties:  exportBuildProperties,
+			Warnings:         exportWarnings,
+			WarningsPolicy:   exportWarningsPolicy.ToRPC(),
+			OptimizeForDebug: exportOptimizeForDebug,
+			Library:          exportLibrary,
+			Libraries:        exportLibraries,
+			Profile:          exportProfile,
+			Verbose:          exportVerbose,
+		},
+		Format:    exportFormat,
+		OutputDir: outputDir,
+	}
+
+	ctx, cancel := arguments.InterruptibleContext()
+	defer cancel()
+	debug := configuration.Settings.GetString("logging.level") == "debug"
+	var res *rpc.ExportSketchResponse
+	var err error
+	if output.OutputFormat == "json" {
+		res, err = compile.ExportSketch(ctx, req, new(bytes.Buffer), new(bytes.Buffer), debug)
+	} else {
+		res, err = compile.ExportSketch(ctx, req, os.Stdout, os.Stderr, debug)
+	}
+	if err != nil {
+		feedback.Errorf(tr("Error exporting the sketch: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	feedback.PrintResult(exportResult{res})
+}
+
+type exportResult struct {
+	res *rpc.ExportSketchResponse
+}
+
+func (er exportResult) Data() interface{} {
+	return er.res
+}
+
+func (er exportResult) String() string {
+	res := tr("Project exported to %s", er.res.GetOutputDir())
+	if len(er.res.GetVariables()) > 0 {
+		t := table.New()
+		t.SetHeader(tr("Variable"), tr("Value"))
+		for _, v := range er.res.GetVariables() {
+			t.AddRow(v.GetName(), v.GetValue())
+		}
+		res += "\n\n" + tr("The toolchains are not part of the project, their paths can be changed with these variables:")
+		res += "\n" + t.Render()
+	}
+	return res
+}
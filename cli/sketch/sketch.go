@@ -35,6 +35,7 @@ func NewCommand() *cobra.Command {
 
 	sketchCommand.AddCommand(initNewCommand())
 	sketchCommand.AddCommand(initArchiveCommand())
+	sketchCommand.AddCommand(initExportCommand())
 
 	return sketchCommand
 }
@@ -0,0 +1,142 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/arduino/arduino-cli/cli/arguments"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/cli/output"
+	"github.com/arduino/arduino-cli/commands/test"
+	"github.com/arduino/arduino-cli/i18n"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var (
+	fqbn        arguments.Fqbn
+	buildPath   string
+	warnings    string
+	verbose     bool
+	jobs        int32
+	junitReport string
+	library     []string
+	libraries   []string
+	tr          = i18n.Tr
+)
+
+// NewCommand created a new `test` command
+func NewCommand() *cobra.Command {
+	testCommand := &cobra.Command{
+		Use:   "test",
+		Short: tr("Runs the unit tests of a sketch."),
+		Long:  tr("Builds the unit tests in the \"test\" folder of the sketch, together with the .cpp and .h files of the sketch and a mock of the Arduino API, using the compiler of the host system. Then runs them, without the need of a board."),
+		Example: "" +
+			"  " + os.Args[0] + " test /home/user/Arduino/MySketch\n" +
+			"  " + os.Args[0] + " test -b arduino:avr:uno --junit report.xml /home/user/Arduino/MySketch\n",
+		Args: cobra.MaximumNArgs(1),
+		Run:  runTestCommand,
+	}
+
+	fqbn.AddToCommand(testCommand)
+	testCommand.Flags().StringVar(&buildPath, "build-path", "",
+		tr("Path where to save compiled files. If omitted, a directory will be created in the default temporary path of your OS."))
+	testCommand.Flags().StringVar(&warnings, "warnings", "none",
+		tr(`Optional, can be: %s. Used to tell gcc which warning level to use (-W flag).`, "none, default, more, all"))
+	testCommand.Flags().BoolVarP(&verbose, "verbose", "v", false, tr("Optional, turns on verbose mode."))
+	testCommand.Flags().Int32VarP(&jobs, "jobs", "j", 0, tr("Max number of parallel compiles. If set to 0 the number of available CPUs cores will be used."))
+	testCommand.Flags().StringVar(&junitReport, "junit", "", tr("Write the results of the tests in JUnit XML format to the given file."))
+	testCommand.Flags().StringSliceVar(&library, "library", []string{},
+		tr("List of paths to libraries root folders. Libraries set this way have top priority in case of conflicts. Can be used multiple times for different libraries."))
+	testCommand.Flags().StringSliceVar(&libraries, "libraries", []string{},
+		tr("List of custom libraries dir paths separated by commas. Or can be used multiple times for multiple libraries dir paths."))
+
+	return testCommand
+}
+
+func runTestCommand(cmd *cobra.Command, args []string) {
+	inst := instance.CreateAndInit()
+
+	logrus.Info("Executing `arduino-cli test`")
+
+	path := ""
+	if len(args) > 0 {
+		path = args[0]
+	}
+	sketchPath := arguments.InitSketchPath(path)
+
+	var stdOut, stdErr io.Writer = os.Stdout, os.Stderr
+	if output.OutputFormat == "json" {
+		stdOut, stdErr = new(bytes.Buffer), new(bytes.Buffer)
+	}
+	ctx, cancel := arguments.InterruptibleContext()
+	defer cancel()
+	res, err := test.Test(ctx, &rpc.TestRequest{
+		Instance:    inst,
+		SketchPath:  sketchPath.String(),
+		Fqbn:        fqbn.String(),
+		BuildPath:   buildPath,
+		Libraries:   libraries,
+		Library:     library,
+		Warnings:    warnings,
+		Verbose:     verbose,
+		Jobs:        jobs,
+		JunitReport: junitReport,
+	}, stdOut, stdErr)
+	if err != nil {
+		feedback.Errorf(tr("Error running the tests: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	feedback.PrintResult(&testResult{Results: res.GetResults(), Success: res.GetSuccess()})
+	if !res.GetSuccess() {
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
+
+type testResult struct {
+	Results []*rpc.TestCaseResult `json:"results"`
+	Success bool                  `json:"success"`
+}
+
+func (r *testResult) Data() interface{} {
+	return r
+}
+
+func (r *testResult) String() string {
+	// The output of the tests is already printed via os.Stdout/os.Stderr
+	t := table.New()
+	t.SetHeader(tr("Test"), tr("Result"), tr("Failure"))
+	t.SetColumnWidthMode(2, table.Average)
+	failed := 0
+	for _, result := range r.Results {
+		status := tr("PASS")
+		if !result.GetPassed() {
+			status = tr("FAIL")
+			failed++
+		}
+		t.AddRow(result.GetSuite()+"."+result.GetName(), status, result.GetFailure())
+	}
+	return t.Render() + "\n" + tr("%[1]s tests, %[2]s failed", fmt.Sprint(len(r.Results)), fmt.Sprint(failed))
+}
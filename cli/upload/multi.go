@@ -0,0 +1,158 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upload
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/discovery"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/output"
+	"github.com/arduino/arduino-cli/commands/upload"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+)
+
+// runMultiUpload uploads to many boards at the same time, the output of every
+// upload is printed with the address of its port as prefix
+func runMultiUpload(ctx context.Context, req *rpc.UploadRequest, ports []*discovery.Port) {
+	multiReq := &rpc.MultiUploadRequest{
+		Upload: req,
+	}
+	if allMatching != "" {
+		multiReq.AllMatchingBoards = true
+		multiReq.DiscoveryTimeout = port.GetSearchTimeout().Milliseconds()
+	} else {
+		for _, p := range ports {
+			multiReq.Ports = append(multiReq.Ports, p.ToRPC())
+		}
+	}
+
+	printOutput := output.OutputFormat != "json"
+	outWriters := map[string]*prefixWriter{}
+	errWriters := map[string]*prefixWriter{}
+	writers := func(address string) (*prefixWriter, *prefixWriter) {
+		if _, ok := outWriters[address]; !ok {
+			prefix := "[" + address + "] "
+			outWriters[address] = &prefixWriter{out: os.Stdout, prefix: prefix}
+			errWriters[address] = &prefixWriter{out: os.Stderr, prefix: prefix}
+		}
+		return outWriters[address], errWriters[address]
+	}
+
+	results := []*multiUploadPortResult{}
+	err := upload.MultiUpload(ctx, multiReq, func(res *rpc.MultiUploadResponse) {
+		address := res.GetPort().GetAddress()
+		outWriter, errWriter := writers(address)
+		if printOutput {
+			outWriter.Write(res.GetOutStream())
+			errWriter.Write(res.GetErrStream())
+		}
+		if res.GetResult() != nil {
+			if printOutput {
+				outWriter.Flush()
+				errWriter.Flush()
+			}
+			results = append(results, &multiUploadPortResult{
+				Port:  res.GetPort(),
+				Error: res.GetResult().GetError(),
+			})
+		}
+	})
+	if err != nil {
+		feedback.Errorf(tr("Error during Upload: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Port.GetAddress() < results[j].Port.GetAddress()
+	})
+	feedback.PrintResult(multiUploadResult{results})
+
+	failed := []string{}
+	for _, result := range results {
+		if result.Error != "" {
+			failed = append(failed, result.Port.GetAddress())
+		}
+	}
+	if len(failed) > 0 {
+		feedback.Errorf(tr("Upload failed on ports: %s"), strings.Join(failed, ", "))
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
+
+type multiUploadPortResult struct {
+	Port  *rpc.Port `json:"port"`
+	Error string    `json:"error,omitempty"`
+}
+
+type multiUploadResult struct {
+	results []*multiUploadPortResult
+}
+
+func (r multiUploadResult) Data() interface{} {
+	return r.results
+}
+
+func (r multiUploadResult) String() string {
+	t := table.New()
+	t.SetHeader(tr("Port"), tr("Protocol"), tr("Result"))
+	for _, result := range r.results {
+		status := tr("Done")
+		if result.Error != "" {
+			status = result.Error
+		}
+		t.AddRow(result.Port.GetAddress(), result.Port.GetProtocol(), status)
+	}
+	return t.Render()
+}
+
+// prefixWriter writes the lines with the given prefix, the incomplete lines are
+// kept until they're completed or flushed
+type prefixWriter struct {
+	out    io.Writer
+	prefix string
+	buffer bytes.Buffer
+}
+
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	w.buffer.Write(p)
+	for {
+		i := bytes.IndexByte(w.buffer.Bytes(), '\n')
+		if i < 0 {
+			break
+		}
+		line := w.buffer.Next(i + 1)
+		if _, err := io.WriteString(w.out, w.prefix+string(line)); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush writes the incomplete line left
+func (w *prefixWriter) Flush() {
+	if w.buffer.Len() > 0 {
+		io.WriteString(w.out, w.prefix+w.buffer.String()+"\n")
+		w.buffer.Reset()
+	}
+}
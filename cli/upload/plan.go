@@ -0,0 +1,68 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upload
+
+import (
+	"fmt"
+	"strings"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// planResult prints the steps of the upload, the properties used to expand
+// the recipes are printed only with the JSON output
+type planResult struct {
+	res *rpc.UploadPlanResponse
+}
+
+func (r planResult) Data() interface{} {
+	return r.res
+}
+
+func (r planResult) String() string {
+	var sb strings.Builder
+	tool := r.res.GetToolId()
+	if platform := r.res.GetToolPlatform(); platform != "" {
+		tool += " (" + platform + ")"
+	}
+	fmt.Fprintln(&sb, tr("Action:"), r.res.GetAction())
+	fmt.Fprintln(&sb, tr("Tool:"), tool)
+	if reset := r.res.GetPortReset(); reset != nil {
+		if reset.GetPortToTouch() != "" {
+			fmt.Fprintln(&sb, tr("Reset: 1200-bps touch of %s", reset.GetPortToTouch()))
+		} else {
+			fmt.Fprintln(&sb, tr("Reset: no port to touch"))
+		}
+		if reset.GetWaitForUploadPort() {
+			fmt.Fprintln(&sb, tr("Wait for the upload port: up to %d ms, the new port replaces the address of the port in the commands", reset.GetWaitTimeout()))
+		}
+	}
+	fmt.Fprintln(&sb, tr("Steps:"))
+	for _, step := range r.res.GetSteps() {
+		switch {
+		case step.GetBuiltin() != "":
+			fmt.Fprintf(&sb, "  %s\n", step.GetBuiltin())
+		case step.GetCommandLine() == "":
+			fmt.Fprintf(&sb, "  %s: %s\n", step.GetRecipe(), tr("nothing to run"))
+		default:
+			fmt.Fprintf(&sb, "  %s: %s\n", step.GetRecipe(), step.GetCommandLine())
+		}
+	}
+	if masked := r.res.GetMaskedFields(); len(masked) > 0 {
+		fmt.Fprintln(&sb, tr("The values of the secret fields (%s) are replaced by ********, the steps using them can't be run as they are: use --show-secrets to print them.", strings.Join(masked, ", ")))
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"os"
 
+	"github.com/arduino/arduino-cli/arduino/discovery"
 	"github.com/arduino/arduino-cli/arduino/sketch"
 	"github.com/arduino/arduino-cli/cli/arguments"
 	"github.com/arduino/arduino-cli/cli/errorcodes"
@@ -32,15 +33,18 @@ import (
 )
 
 var (
-	fqbn       arguments.Fqbn
-	port       arguments.Port
-	verbose    bool
-	verify     bool
-	importDir  string
-	importFile string
-	programmer arguments.Programmer
-	dryRun     bool
-	tr         = i18n.Tr
+	fqbn        arguments.Fqbn
+	port        arguments.Port
+	verbose     bool
+	verify      bool
+	importDir   string
+	importFile  string
+	programmer  arguments.Programmer
+	dryRun      bool
+	allMatching string
+	plan        bool
+	showSecrets bool
+	tr          = i18n.Tr
 )
 
 // NewCommand created a new `upload` command
@@ -53,17 +57,27 @@ func NewCommand() *cobra.Command {
 		Args:    cobra.MaximumNArgs(1),
 		PreRun: func(cmd *cobra.Command, args []string) {
 			arguments.CheckFlagsConflicts(cmd, "input-file", "input-dir")
+			arguments.CheckFlagsConflicts(cmd, "all-matching", "port")
+			arguments.CheckFlagsConflicts(cmd, "all-matching", "fqbn")
+			arguments.CheckFlagsConflicts(cmd, "all-matching", "plan")
+			if showSecrets && !plan {
+				feedback.Errorf(tr("The --show-secrets flag can be used only with --plan."))
+				os.Exit(errorcodes.ErrBadArgument)
+			}
 		},
 		Run: runUploadCommand,
 	}
 
 	fqbn.AddToCommand(uploadCommand)
-	port.AddToCommand(uploadCommand)
+	port.AddToCommandAllowMultiple(uploadCommand)
+	uploadCommand.Flags().StringVar(&allMatching, "all-matching", "", tr("Upload to all the connected boards matching the given FQBN."))
 	uploadCommand.Flags().StringVarP(&importDir, "input-dir", "", "", tr("Directory containing binaries to upload."))
 	uploadCommand.Flags().StringVarP(&importFile, "input-file", "i", "", tr("Binary file to upload."))
 	uploadCommand.Flags().BoolVarP(&verify, "verify", "t", false, tr("Verify uploaded binary after the upload."))
 	uploadCommand.Flags().BoolVarP(&verbose, "verbose", "v", false, tr("Optional, turns on verbose mode."))
 	programmer.AddToCommand(uploadCommand)
+	uploadCommand.Flags().BoolVar(&plan, "plan", false, tr("Print the commands of the upload, with the port reset, without running them."))
+	uploadCommand.Flags().BoolVar(&showSecrets, "show-secrets", false, tr("Ask for the user fields of the upload tool and print the values of the secret ones in the plan, so that its commands can be run."))
 	uploadCommand.Flags().BoolVar(&dryRun, "dry-run", false, tr("Do not perform the actual upload, just log out actions"))
 	uploadCommand.Flags().MarkHidden("dry-run")
 	return uploadCommand
@@ -89,10 +103,25 @@ func runUploadCommand(command *cobra.Command, args []string) {
 		os.Exit(errorcodes.ErrGeneric)
 	}
 
-	discoveryPort, err := port.GetPort(instance, sk)
-	if err != nil {
-		feedback.Errorf(tr("Error during Upload: %v"), err)
-		os.Exit(errorcodes.ErrGeneric)
+	// The ports of the boards to upload to, when uploading to all the matching
+	// boards they are searched by the MultiUpload
+	var ports []*discovery.Port
+	protocol := "serial"
+	if allMatching != "" {
+		fqbn.Set(allMatching)
+	} else {
+		if len(port.GetAddresses()) > 1 {
+			ports, err = port.GetPorts(instance)
+		} else {
+			var discoveryPort *discovery.Port
+			discoveryPort, err = port.GetPort(instance, sk)
+			ports = []*discovery.Port{discoveryPort}
+		}
+		if err != nil {
+			feedback.Errorf(tr("Error during Upload: %v"), err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+		protocol = ports[0].Protocol
 	}
 
 	if fqbn.String() == "" && sk != nil && sk.Metadata != nil {
@@ -104,7 +133,7 @@ func runUploadCommand(command *cobra.Command, args []string) {
 	userFieldRes, err := upload.SupportedUserFields(context.Background(), &rpc.SupportedUserFieldsRequest{
 		Instance: instance,
 		Fqbn:     fqbn.String(),
-		Protocol: discoveryPort.Protocol,
+		Protocol: protocol,
 	})
 	if err != nil {
 		feedback.Errorf(tr("Error during Upload: %v"), err)
@@ -112,8 +141,10 @@ func runUploadCommand(command *cobra.Command, args []string) {
 	}
 
 	fields := map[string]string{}
-	if len(userFieldRes.UserFields) > 0 {
-		feedback.Print(tr("Uploading to specified board using %s protocol requires the following info:", discoveryPort.Protocol))
+	// The plan doesn't need the user fields, the secret ones are masked unless
+	// they're requested
+	if len(userFieldRes.UserFields) > 0 && (!plan || showSecrets) {
+		feedback.Print(tr("Uploading to specified board using %s protocol requires the following info:", protocol))
 		fields = arguments.AskForUserFields(userFieldRes.UserFields)
 	}
 
@@ -121,11 +152,12 @@ func runUploadCommand(command *cobra.Command, args []string) {
 		path = sketchPath.String()
 	}
 
-	if _, err := upload.Upload(context.Background(), &rpc.UploadRequest{
+	ctx, cancel := arguments.InterruptibleContext()
+	defer cancel()
+	req := &rpc.UploadRequest{
 		Instance:   instance,
 		Fqbn:       fqbn.String(),
 		SketchPath: path,
-		Port:       discoveryPort.ToRPC(),
 		Verbose:    verbose,
 		Verify:     verify,
 		ImportFile: importFile,
@@ -133,7 +165,26 @@ func runUploadCommand(command *cobra.Command, args []string) {
 		Programmer: programmer.String(),
 		DryRun:     dryRun,
 		UserFields: fields,
-	}, os.Stdout, os.Stderr); err != nil {
+	}
+	if plan && len(ports) > 1 {
+		feedback.Errorf(tr("Can't plan the upload to more than one port."))
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	if allMatching != "" || len(ports) > 1 {
+		runMultiUpload(ctx, req, ports)
+		return
+	}
+	req.Port = ports[0].ToRPC()
+	if plan {
+		res, err := upload.UploadPlan(ctx, &rpc.UploadPlanRequest{Upload: req, ShowSecretFields: showSecrets})
+		if err != nil {
+			feedback.Errorf(tr("Error during Upload: %v"), err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+		feedback.PrintResult(planResult{res})
+		return
+	}
+	if _, err := upload.Upload(ctx, req, os.Stdout, os.Stderr); err != nil {
 		feedback.Errorf(tr("Error during Upload: %v"), err)
 		os.Exit(errorcodes.ErrGeneric)
 	}
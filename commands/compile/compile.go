@@ -26,8 +26,14 @@ import (
 
 	"github.com/arduino/arduino-cli/arduino"
 	bldr "github.com/arduino/arduino-cli/arduino/builder"
+	"github.com/arduino/arduino-cli/arduino/builder/buildcache"
+	"github.com/arduino/arduino-cli/arduino/builder/buildmanifest"
+	"github.com/arduino/arduino-cli/arduino/builder/buildtrace"
+	"github.com/arduino/arduino-cli/arduino/builder/diagnostics"
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/firmware"
+	"github.com/arduino/arduino-cli/arduino/security"
 	"github.com/arduino/arduino-cli/arduino/sketch"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/configuration"
@@ -41,12 +47,22 @@ import (
 	properties "github.com/arduino/go-properties-orderedmap"
 	"github.com/segmentio/stats/v4"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
 )
 
 var tr = i18n.Tr
 
 // Compile FIXMEDOC
-func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream io.Writer, debug bool) (r *rpc.CompileResponse, e error) {
+// If diagnosticCB is not nil it's called for every diagnostic produced by the compiler, as soon as it's available.
+func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream io.Writer, diagnosticCB func(*rpc.CompileDiagnostic), debug bool) (*rpc.CompileResponse, error) {
+	r, _, err := compile(ctx, req, outStream, errStream, diagnosticCB, debug, nil)
+	return r, err
+}
+
+// compile runs the build and returns also the builder context used, to allow
+// further inspection of the build results. If shared is not nil the build uses
+// the resources shared with the other builds of a MultiCompile.
+func compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream io.Writer, diagnosticCB func(*rpc.CompileDiagnostic), debug bool, shared *sharedBuildResources) (r *rpc.CompileResponse, builderCtx *types.Context, e error) {
 
 	// There is a binding between the export binaries setting and the CLI flag to explicitly set it,
 	// since we want this binding to work also for the gRPC interface we must read it here in this
@@ -76,6 +92,11 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 		"libraries":       strings.Join(req.Libraries, ","),
 		"clean":           strconv.FormatBool(req.GetClean()),
 		"exportBinaries":  strconv.FormatBool(exportBinaries),
+		"reproducible":    strconv.FormatBool(req.GetReproducible()),
+		"verifyManifest":  strconv.FormatBool(req.GetVerifyManifest() != ""),
+		"signManifest":    strconv.FormatBool(req.GetSignManifestKey() != ""),
+		"profile":         strconv.FormatBool(req.GetProfile() != ""),
+		"compareTo":       strconv.FormatBool(req.GetCompareTo() != ""),
 	}
 
 	// Use defer func() to evaluate tags map when function returns
@@ -90,29 +111,47 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 
 	pm := commands.GetPackageManager(req.GetInstance().GetId())
 	if pm == nil {
-		return nil, &arduino.InvalidInstanceError{}
+		return nil, nil, &arduino.InvalidInstanceError{}
 	}
 
 	logrus.Tracef("Compile %s for %s started", req.GetSketchPath(), req.GetFqbn())
-	if req.GetSketchPath() == "" {
-		return nil, &arduino.MissingSketchPathError{}
+	var sketchPath *paths.Path
+	var virtualSketchDir *paths.Path
+	if virtualSketch := req.GetVirtualSketch(); virtualSketch != nil {
+		if req.GetSketchPath() != "" {
+			return nil, nil, &arduino.InvalidArgumentError{Message: tr("The sketch path can't be set when compiling a virtual sketch")}
+		}
+		scratchDir, path, err := createVirtualSketch(virtualSketch)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer scratchDir.RemoveAll()
+		sketchPath = path
+		virtualSketchDir = path
+		if req.GetBuildPath() == "" {
+			req = proto.Clone(req).(*rpc.CompileRequest)
+			req.BuildPath = scratchDir.Join("build").String()
+		}
+		if diagnosticCB != nil {
+			cb := diagnosticCB
+			diagnosticCB = func(d *rpc.CompileDiagnostic) {
+				relativizeDiagnostic(d, virtualSketchDir)
+				cb(d)
+			}
+		}
+	} else if req.GetSketchPath() == "" {
+		return nil, nil, &arduino.MissingSketchPathError{}
+	} else {
+		sketchPath = paths.New(req.GetSketchPath())
 	}
-	sketchPath := paths.New(req.GetSketchPath())
 	sk, err := sketch.New(sketchPath)
 	if err != nil {
-		return nil, &arduino.CantOpenSketchError{Cause: err}
+		return nil, nil, &arduino.CantOpenSketchError{Cause: err}
 	}
 
-	fqbnIn := req.GetFqbn()
-	if fqbnIn == "" && sk != nil && sk.Metadata != nil {
-		fqbnIn = sk.Metadata.CPU.Fqbn
-	}
-	if fqbnIn == "" {
-		return nil, &arduino.MissingFQBNError{}
-	}
-	fqbn, err := cores.ParseFQBN(fqbnIn)
+	pm, fqbn, profile, profileLibraries, err := buildTarget(req, sk, pm, outStream, errStream)
 	if err != nil {
-		return nil, &arduino.InvalidFQBNError{Cause: err}
+		return nil, nil, err
 	}
 
 	targetPlatform := pm.FindPlatform(&packagemanager.PlatformReference{
@@ -125,10 +164,10 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 		// 	"\"%[1]s:%[2]s\" platform is not installed, please install it by running \""+
 		// 		version.GetAppName()+" core install %[1]s:%[2]s\".", fqbn.Package, fqbn.PlatformArch)
 		// feedback.Error(errorMessage)
-		return nil, &arduino.PlatformNotFoundError{Platform: targetPlatform.String(), Cause: fmt.Errorf(tr("platform not installed"))}
+		return nil, nil, &arduino.PlatformNotFoundError{Platform: targetPlatform.String(), Cause: fmt.Errorf(tr("platform not installed"))}
 	}
 
-	builderCtx := &types.Context{}
+	builderCtx = &types.Context{}
 	builderCtx.PackageManager = pm
 	builderCtx.FQBN = fqbn
 	builderCtx.SketchLocation = sk.FullPath
@@ -137,10 +176,22 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 	builderCtx.HardwareDirs = configuration.HardwareDirectories(configuration.Settings)
 	builderCtx.BuiltInToolsDirs = configuration.BundleToolsDirectories(configuration.Settings)
 
-	builderCtx.OtherLibrariesDirs = paths.NewPathList(req.GetLibraries()...)
-	builderCtx.OtherLibrariesDirs.Add(configuration.LibrariesDir(configuration.Settings))
+	setLibrariesDirs(builderCtx, req, profile, profileLibraries)
 
-	builderCtx.LibraryDirs = paths.NewPathList(req.Library...)
+	// A profile builds only with the platforms it pins, the ones installed
+	// globally are ignored
+	if profile != nil {
+		builderCtx.HardwareDirs = paths.PathList{configuration.ProfilesPackagesDir(configuration.Settings)}
+		builderCtx.BuiltInToolsDirs = nil
+	}
+
+	if shared != nil {
+		// The bundled tools have been already loaded in the PackageManager, that
+		// must not be modified while the other builds are using it
+		builderCtx.BuiltInToolsDirs = nil
+		builderCtx.Workers = shared.workers
+		builderCtx.PreloadedLibraries = shared.libraries
+	}
 
 	if req.GetBuildPath() == "" {
 		builderCtx.BuildPath = sk.BuildPath
@@ -148,7 +199,7 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 		builderCtx.BuildPath = paths.New(req.GetBuildPath()).Canonical()
 	}
 	if err = builderCtx.BuildPath.MkdirAll(); err != nil {
-		return nil, &arduino.PermissionDeniedError{Message: tr("Cannot create build directory"), Cause: err}
+		return nil, nil, &arduino.PermissionDeniedError{Message: tr("Cannot create build directory"), Cause: err}
 	}
 	builderCtx.CompilationDatabase = bldr.NewCompilationDatabase(
 		builderCtx.BuildPath.Join("compile_commands.json"),
@@ -159,12 +210,28 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 	// Optimize for debug
 	builderCtx.OptimizeForDebug = req.GetOptimizeForDebug()
 
-	builderCtx.CoreBuildCachePath = paths.TempDir().Join("arduino-core-cache")
+	buildCacheDir := configuration.BuildCacheDir(configuration.Settings)
+	builderCtx.CoreBuildCachePath = buildCacheDir.Join("arduino-core-cache")
+	builderCtx.ObjectsBuildCachePath = buildCacheDir.Join("arduino-objects-cache")
+	builderCtx.ObjectsCacheDisabled = !configuration.Settings.GetBool("build_cache.objects_enabled")
+	builderCtx.ObjectsCacheMaxSize = configuration.Settings.GetInt64("build_cache.objects_max_size") * 1024 * 1024
+	if builderCtx.RemoteBuildCache, err = remoteBuildCache(); err != nil {
+		return nil, nil, err
+	}
 
 	builderCtx.Jobs = int(req.GetJobs())
 
 	builderCtx.USBVidPid = req.GetVidPid()
 	builderCtx.WarningsLevel = req.GetWarnings()
+	if builderCtx.WarningsPolicy, err = warningsPolicy(sk, req.GetWarningsPolicy()); err != nil {
+		return nil, nil, err
+	}
+	for _, format := range req.GetExportFormats() {
+		if err := firmware.CheckFormat(format); err != nil {
+			return nil, nil, &arduino.InvalidArgumentError{Message: tr("Invalid export format"), Cause: err}
+		}
+	}
+	builderCtx.ExportFormats = req.GetExportFormats()
 
 	if debug {
 		builderCtx.DebugLevel = 100
@@ -172,83 +239,147 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 		builderCtx.DebugLevel = 5
 	}
 
-	builderCtx.CustomBuildProperties = append(req.GetBuildProperties(), "build.warn_data_percentage=75")
+	builderCtx.CustomBuildProperties = []string{}
+	if profile != nil {
+		builderCtx.CustomBuildProperties = append(builderCtx.CustomBuildProperties, profile.BuildProperties...)
+	}
+	builderCtx.CustomBuildProperties = append(builderCtx.CustomBuildProperties, req.GetBuildProperties()...)
+	builderCtx.CustomBuildProperties = append(builderCtx.CustomBuildProperties, "build.warn_data_percentage=75")
 
 	if req.GetBuildCachePath() != "" {
 		builderCtx.BuildCachePath = paths.New(req.GetBuildCachePath())
 		err = builderCtx.BuildCachePath.MkdirAll()
 		if err != nil {
-			return nil, &arduino.PermissionDeniedError{Message: tr("Cannot create build cache directory"), Cause: err}
+			return nil, nil, &arduino.PermissionDeniedError{Message: tr("Cannot create build cache directory"), Cause: err}
 		}
 	}
 
 	// Will be deprecated.
 	builderCtx.ArduinoAPIVersion = "10607"
 
-	// Check if Arduino IDE is installed and get it's libraries location.
-	dataDir := paths.New(configuration.Settings.GetString("directories.Data"))
-	preferencesTxt := dataDir.Join("preferences.txt")
-	ideProperties, err := properties.LoadFromPath(preferencesTxt)
-	if err == nil {
-		lastIdeSubProperties := ideProperties.SubTree("last").SubTree("ide")
-		// Preferences can contain records from previous IDE versions. Find the latest one.
-		var pathVariants []string
-		for k := range lastIdeSubProperties.AsMap() {
-			if strings.HasSuffix(k, ".hardwarepath") {
-				pathVariants = append(pathVariants, k)
-			}
-		}
-		sort.Strings(pathVariants)
-		ideHardwarePath := lastIdeSubProperties.Get(pathVariants[len(pathVariants)-1])
-		ideLibrariesPath := filepath.Join(filepath.Dir(ideHardwarePath), "libraries")
-		builderCtx.BuiltInLibrariesDirs = paths.NewPathList(ideLibrariesPath)
-	}
-
 	builderCtx.ExecStdout = outStream
 	builderCtx.ExecStderr = errStream
 	builderCtx.SetLogger(legacyi18n.LoggerToCustomStreams{Stdout: outStream, Stderr: errStream})
+	builderCtx.SetContext(ctx)
 	builderCtx.Clean = req.GetClean()
 	builderCtx.OnlyUpdateCompilationDatabase = req.GetCreateCompilationDatabaseOnly()
 
 	builderCtx.SourceOverride = req.GetSourceOverride()
+	builderCtx.SizeReport = req.GetSizeReport()
+	builderCtx.RecordIncludeGraph = req.GetIncludeGraph()
+	if req.GetTrace() {
+		builderCtx.Trace = buildtrace.New()
+	}
+
+	builderCtx.Reproducible = req.GetReproducible()
+	if req.GetVerifyManifestKey() != "" && req.GetVerifyManifest() == "" {
+		return nil, nil, &arduino.InvalidArgumentError{Message: tr("The key to verify the build manifest requires a build manifest to verify")}
+	}
+	var expectedManifest *buildmanifest.Manifest
+	if verifyManifest := req.GetVerifyManifest(); verifyManifest != "" {
+		if key := req.GetVerifyManifestKey(); key != "" {
+			if err := verifyBuildManifestSignature(paths.New(verifyManifest), paths.New(key)); err != nil {
+				return nil, nil, err
+			}
+		}
+		expectedManifest, err = buildmanifest.Load(paths.New(verifyManifest))
+		if err != nil {
+			return nil, nil, &arduino.InvalidArgumentError{Message: tr("Invalid build manifest"), Cause: err}
+		}
+		// Rebuild everything without using the cached files and without
+		// overwriting the binaries to compare with
+		builderCtx.Reproducible = true
+		builderCtx.Clean = true
+		exportBinaries = false
+	}
+	if req.GetSignManifestKey() != "" && !builderCtx.Reproducible {
+		return nil, nil, &arduino.InvalidArgumentError{Message: tr("Only the manifest of a reproducible build can be signed")}
+	}
+
+	var previousExecutable *paths.Path
+	if compareTo := req.GetCompareTo(); compareTo != "" {
+		previousExecutable, err = findPreviousExecutable(paths.New(compareTo), sk)
+		if err != nil {
+			return nil, nil, err
+		}
+		// The build may overwrite the previous executable if it's in the same
+		// build path, a copy is analyzed after the build
+		compareDir, err := paths.MkTempDir("", "arduino-compare-")
+		if err != nil {
+			return nil, nil, &arduino.TempDirCreationFailedError{Cause: err}
+		}
+		defer compareDir.RemoveAll()
+		builderCtx.CompareToExecutable = compareDir.Join(previousExecutable.Base())
+		if err := previousExecutable.CopyTo(builderCtx.CompareToExecutable); err != nil {
+			return nil, nil, &arduino.PermissionDeniedError{Message: tr("Error copying the previous executable"), Cause: err}
+		}
+		if previousBuildPath := previousExecutable.Parent().Canonical(); !previousBuildPath.EquivalentTo(builderCtx.BuildPath) {
+			builderCtx.CompareToBuildPath = previousBuildPath
+		}
+	} else if req.GetMaxFlashIncrease() != nil || req.GetMaxRamIncrease() != nil {
+		return nil, nil, &arduino.InvalidArgumentError{Message: tr("A previous build to compare with is required to limit the increase of the memory used")}
+	}
+
+	if diagnosticCB != nil {
+		builderCtx.OnCompilerDiagnostic = func(d *diagnostics.Diagnostic) {
+			diagnosticCB(d.ToRPC())
+		}
+	}
 
 	r = &rpc.CompileResponse{}
 	defer func() {
 		if p := builderCtx.BuildPath; p != nil {
 			r.BuildPath = p.String()
 		}
+		for _, d := range builderCtx.CompilerDiagnostics {
+			diagnostic := d.ToRPC()
+			if virtualSketchDir != nil {
+				relativizeDiagnostic(diagnostic, virtualSketchDir)
+			}
+			r.Diagnostics = append(r.Diagnostics, diagnostic)
+		}
+		// The include graph is returned also when the build fails, since it
+		// helps understanding errors caused by the wrong library being chosen
+		if builderCtx.RecordIncludeGraph {
+			r.IncludeGraph = includeGraphToRPC(builderCtx.IncludeGraph)
+		}
+		for _, span := range builderCtx.Trace.Spans() {
+			r.Trace = append(r.Trace, span.ToRPC())
+		}
+		if comparison := builderCtx.SizeComparison.ToRPC(); comparison != nil {
+			comparison.PreviousExecutable = previousExecutable.String()
+			r.SizeComparison = comparison
+		}
 	}()
 
 	// if --preprocess or --show-properties were passed, we can stop here
 	if req.GetShowProperties() {
-		compileErr := builder.RunParseHardwareAndDumpBuildProperties(builderCtx)
-		if compileErr != nil {
-			compileErr = &arduino.CompileFailedError{Message: err.Error()}
+		if err := builder.RunParseHardwareAndDumpBuildProperties(builderCtx); err != nil {
+			return r, builderCtx, compileError(ctx, err)
 		}
-		return r, compileErr
+		return r, builderCtx, nil
 	} else if req.GetPreprocess() {
-		compileErr := builder.RunPreprocess(builderCtx)
-		if compileErr != nil {
-			compileErr = &arduino.CompileFailedError{Message: err.Error()}
+		if err := builder.RunPreprocess(builderCtx); err != nil {
+			return r, builderCtx, compileError(ctx, err)
 		}
-		return r, compileErr
+		return r, builderCtx, nil
 	}
 
 	// if it's a regular build, go on...
 	if err := builder.RunBuilder(builderCtx); err != nil {
-		return r, &arduino.CompileFailedError{Message: err.Error()}
+		return r, builderCtx, compileError(ctx, err)
 	}
 
 	// If the export directory is set we assume you want to export the binaries
-	if req.GetExportDir() != "" {
+	if req.GetExportDir() != "" && expectedManifest == nil {
 		exportBinaries = true
 	}
 	// If CreateCompilationDatabaseOnly is set, we do not need to export anything
 	if req.GetCreateCompilationDatabaseOnly() {
 		exportBinaries = false
 	}
+	var exportPath *paths.Path
 	if exportBinaries {
-		var exportPath *paths.Path
 		if exportDir := req.GetExportDir(); exportDir != "" {
 			exportPath = paths.New(exportDir)
 		} else {
@@ -258,19 +389,17 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 		}
 		logrus.WithField("path", exportPath).Trace("Saving sketch to export path.")
 		if err := exportPath.MkdirAll(); err != nil {
-			return r, &arduino.PermissionDeniedError{Message: tr("Error creating output dir"), Cause: err}
+			return r, builderCtx, &arduino.PermissionDeniedError{Message: tr("Error creating output dir"), Cause: err}
 		}
 
 		// Copy all "sketch.ino.*" artifacts to the export directory
-		baseName, ok := builderCtx.BuildProperties.GetOk("build.project_name") // == "sketch.ino"
-		if !ok {
-			return r, &arduino.MissingPlatformPropertyError{Property: "build.project_name"}
+		if !builderCtx.BuildProperties.ContainsKey("build.project_name") {
+			return r, builderCtx, &arduino.MissingPlatformPropertyError{Property: "build.project_name"}
 		}
-		buildFiles, err := builderCtx.BuildPath.ReadDir()
+		buildFiles, err := buildArtifacts(builderCtx)
 		if err != nil {
-			return r, &arduino.PermissionDeniedError{Message: tr("Error reading build directory"), Cause: err}
+			return r, builderCtx, &arduino.PermissionDeniedError{Message: tr("Error reading build directory"), Cause: err}
 		}
-		buildFiles.FilterPrefix(baseName)
 		for _, buildFile := range buildFiles {
 			exportedFile := exportPath.Join(buildFile.Base())
 			logrus.
@@ -278,7 +407,49 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 				WithField("dest", exportedFile).
 				Trace("Copying artifact.")
 			if err = buildFile.CopyTo(exportedFile); err != nil {
-				return r, &arduino.PermissionDeniedError{Message: tr("Error copying output file %s", buildFile), Cause: err}
+				return r, builderCtx, &arduino.PermissionDeniedError{Message: tr("Error copying output file %s", buildFile), Cause: err}
+			}
+		}
+	}
+
+	// Write the manifest of reproducible builds in the build path and next to the
+	// exported binaries, and compare the build with the expected manifest
+	if builderCtx.Reproducible && !req.GetCreateCompilationDatabaseOnly() {
+		artifacts, err := buildArtifacts(builderCtx)
+		if err != nil {
+			return r, builderCtx, &arduino.PermissionDeniedError{Message: tr("Error reading build directory"), Cause: err}
+		}
+		manifest, err := newBuildManifest(builderCtx, artifacts)
+		if err != nil {
+			return r, builderCtx, &arduino.PermissionDeniedError{Message: tr("Error creating the build manifest"), Cause: err}
+		}
+		manifestPaths := paths.PathList{builderCtx.BuildPath.Join(buildmanifest.FileName)}
+		if exportPath != nil {
+			manifestPaths.Add(exportPath.Join(buildmanifest.FileName))
+		}
+		for _, manifestPath := range manifestPaths {
+			if err := manifest.Save(manifestPath); err != nil {
+				return r, builderCtx, &arduino.PermissionDeniedError{Message: tr("Error writing the build manifest"), Cause: err}
+			}
+			r.BuildManifest = manifestPath.String()
+
+			if key := req.GetSignManifestKey(); key != "" {
+				signaturePath := manifestPath.Parent().Join(buildmanifest.SignatureFileName)
+				if _, err := security.SignDetached(manifestPath, signaturePath, paths.New(key)); err != nil {
+					return r, builderCtx, &arduino.InvalidArgumentError{Message: tr("Error signing the build manifest"), Cause: err}
+				}
+				r.BuildManifestSignature = signaturePath.String()
+			}
+		}
+
+		if expectedManifest != nil {
+			expectedDir := paths.New(req.GetVerifyManifest()).Parent()
+			diffs, err := verifyBuildManifest(expectedManifest, expectedDir, manifest, builderCtx.BuildPath)
+			if err != nil {
+				return r, builderCtx, &arduino.PermissionDeniedError{Message: tr("Error comparing the build with the manifest"), Cause: err}
+			}
+			if len(diffs) > 0 {
+				return r, builderCtx, &arduino.BuildManifestMismatchError{Differences: diffs}
 			}
 		}
 	}
@@ -287,15 +458,181 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 	for _, lib := range builderCtx.ImportedLibraries {
 		rpcLib, err := lib.ToRPCLibrary()
 		if err != nil {
-			return r, &arduino.PermissionDeniedError{Message: tr("Error getting information for library %s", lib.Name), Cause: err}
+			return r, builderCtx, &arduino.PermissionDeniedError{Message: tr("Error getting information for library %s", lib.Name), Cause: err}
 		}
 		importedLibs = append(importedLibs, rpcLib)
 	}
 
-	logrus.Tracef("Compile %s for %s successful", sk.Name, fqbnIn)
+	logrus.Tracef("Compile %s for %s successful", sk.Name, fqbn)
 
-	return &rpc.CompileResponse{
+	r = &rpc.CompileResponse{
 		UsedLibraries:          importedLibs,
 		ExecutableSectionsSize: builderCtx.ExecutableSectionsSize.ToRPCExecutableSectionSizeArray(),
-	}, nil
+		BuildManifest:          r.BuildManifest,
+		BuildManifestSignature: r.BuildManifestSignature,
+	}
+	// The report is computed also to compare the size with a previous build
+	if req.GetSizeReport() {
+		r.SizeReport = builderCtx.MemoryUsageReport.ToRPC()
+	}
+	return r, builderCtx, checkSizeBudget(req, builderCtx.SizeComparison)
+}
+
+// compileError converts an error of the builder, the build has been stopped by
+// ctx if canceled
+func compileError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return &arduino.CanceledError{Message: tr("Build canceled"), Cause: ctx.Err()}
+	}
+	return &arduino.CompileFailedError{Message: err.Error()}
+}
+
+// remoteBuildCache returns the remote build cache configured with the
+// `build_cache.remote_url` and `build_cache.remote_mode` settings, or nil if
+// not configured.
+// buildTarget returns the board the sketch is built for, with the
+// PackageManager and the profile, with its libraries, used to build it. The
+// sketch is nil if the request has no sketch.
+func buildTarget(req *rpc.CompileRequest, sk *sketch.Sketch, pm *packagemanager.PackageManager, outStream, errStream io.Writer) (*packagemanager.PackageManager, *cores.FQBN, *sketch.Profile, paths.PathList, error) {
+	var err error
+	profileName := req.GetProfile()
+	if sk != nil {
+		if profileName, err = buildProfileName(sk, req, errStream); err != nil {
+			return nil, nil, nil, nil, err
+		}
+	} else if profileName != "" {
+		// The profiles are in the project file of the sketch
+		return nil, nil, nil, nil, &arduino.MissingSketchPathError{}
+	}
+	var profile *sketch.Profile
+	var profileLibraries paths.PathList
+	if profileName != "" {
+		if sk.Project != nil {
+			profile = sk.Project.GetProfile(profileName)
+		}
+		if profile == nil {
+			return nil, nil, nil, nil, &arduino.ProfileNotFoundError{Profile: profileName, Cause: fmt.Errorf(tr("missing in %s"), sk.FullPath.Join(sketch.ProjectFileName))}
+		}
+		if req.GetFqbn() != "" {
+			return nil, nil, nil, nil, &arduino.InvalidArgumentError{Message: tr("The FQBN can't be set when building with a profile")}
+		}
+		downloadCB := func(p *rpc.DownloadProgress) {
+			if p.File != "" && !req.GetQuiet() {
+				fmt.Fprintln(outStream, tr("Downloading %s", p.File))
+			}
+		}
+		taskCB := func(p *rpc.TaskProgress) {
+			if p.Name != "" && !req.GetQuiet() {
+				fmt.Fprintln(outStream, p.Name)
+			}
+		}
+		pm, profileLibraries, err = commands.LoadProfile(req.GetInstance().GetId(), profile, downloadCB, taskCB)
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+	}
+
+	fqbnIn := req.GetFqbn()
+	if profile != nil {
+		fqbnIn = profile.FQBN
+	}
+	if fqbnIn == "" && sk != nil && sk.Metadata != nil {
+		fqbnIn = sk.Metadata.CPU.Fqbn
+	}
+	if fqbnIn == "" {
+		return nil, nil, nil, nil, &arduino.MissingFQBNError{}
+	}
+	fqbn, err := cores.ParseFQBN(fqbnIn)
+	if err != nil {
+		return nil, nil, nil, nil, &arduino.InvalidFQBNError{Cause: err}
+	}
+	if profile != nil {
+		options := []string{}
+		for option := range profile.BoardOptions {
+			options = append(options, option)
+		}
+		sort.Strings(options)
+		for _, option := range options {
+			fqbn.Configs.Set(option, profile.BoardOptions[option])
+		}
+	}
+
+	return pm, fqbn, profile, profileLibraries, nil
+}
+
+// setLibrariesDirs sets where the build searches the libraries: the folders
+// and the libraries given with the request, the installed libraries or the
+// ones pinned by the profile, and the libraries bundled with the Arduino IDE.
+// The choices of the libraries forced by the user are set too.
+func setLibrariesDirs(builderCtx *types.Context, req *rpc.CompileRequest, profile *sketch.Profile, profileLibraries paths.PathList) {
+	builderCtx.OtherLibrariesDirs = paths.NewPathList(req.GetLibraries()...)
+	builderCtx.OtherLibrariesDirs.Add(configuration.LibrariesDir(configuration.Settings))
+
+	builderCtx.LibraryDirs = paths.NewPathList(req.Library...)
+
+	// A profile builds only with the libraries it pins, the ones installed
+	// globally are ignored
+	if profile != nil {
+		builderCtx.OtherLibrariesDirs = paths.NewPathList(req.GetLibraries()...)
+		builderCtx.LibraryDirs.AddAll(profileLibraries)
+	}
+
+	// Check if Arduino IDE is installed and get it's libraries location.
+	dataDir := paths.New(configuration.Settings.GetString("directories.Data"))
+	preferencesTxt := dataDir.Join("preferences.txt")
+	ideProperties, err := properties.LoadFromPath(preferencesTxt)
+	if err == nil && profile == nil {
+		lastIdeSubProperties := ideProperties.SubTree("last").SubTree("ide")
+		// Preferences can contain records from previous IDE versions. Find the latest one.
+		var pathVariants []string
+		for k := range lastIdeSubProperties.AsMap() {
+			if strings.HasSuffix(k, ".hardwarepath") {
+				pathVariants = append(pathVariants, k)
+			}
+		}
+		sort.Strings(pathVariants)
+		ideHardwarePath := lastIdeSubProperties.Get(pathVariants[len(pathVariants)-1])
+		ideLibrariesPath := filepath.Join(filepath.Dir(ideHardwarePath), "libraries")
+		builderCtx.BuiltInLibrariesDirs = paths.NewPathList(ideLibrariesPath)
+	}
+
+	builderCtx.LibraryHeaderOverrides = configuration.LibraryHeaderOverrides(configuration.Settings)
+}
+
+// buildProfileName returns the requested build profile, or the default one of
+// the sketch project if the board is not given with the request. An invalid
+// sketch project file is an error only if a profile is needed: if it's
+// requested, or if the board isn't given with the request nor attached to the
+// sketch, otherwise a warning is printed.
+func buildProfileName(sk *sketch.Sketch, req *rpc.CompileRequest, errStream io.Writer) (string, error) {
+	if sk.ProjectError != nil {
+		attachedFqbn := ""
+		if sk.Metadata != nil {
+			attachedFqbn = sk.Metadata.CPU.Fqbn
+		}
+		if req.GetProfile() != "" || (req.GetFqbn() == "" && attachedFqbn == "") {
+			return "", &arduino.InvalidArgumentError{Message: tr("Invalid sketch project file"), Cause: sk.ProjectError}
+		}
+		fmt.Fprintln(errStream, tr("Warning: %s", sk.ProjectError))
+		return "", nil
+	}
+	if req.GetProfile() == "" && req.GetFqbn() == "" && sk.Project != nil {
+		return sk.Project.DefaultProfile, nil
+	}
+	return req.GetProfile(), nil
+}
+
+func remoteBuildCache() (*buildcache.Remote, error) {
+	remoteURL := configuration.Settings.GetString("build_cache.remote_url")
+	if remoteURL == "" {
+		return nil, nil
+	}
+	switch mode := configuration.Settings.GetString("build_cache.remote_mode"); mode {
+	case "read-only":
+		return buildcache.NewRemote(remoteURL, true)
+	case "read-write":
+		return buildcache.NewRemote(remoteURL, false)
+	default:
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid remote build cache mode %[1]s, it must be %[2]s or %[3]s", mode, "read-only", "read-write")}
+	}
 }
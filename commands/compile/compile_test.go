@@ -0,0 +1,65 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildProfileNameWithInvalidProject(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "build_profile_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	sketchPath := tmp.Join("Sketch")
+	require.NoError(t, sketchPath.MkdirAll())
+	require.NoError(t, sketchPath.Join("Sketch.ino").WriteFile([]byte("void setup() {}\nvoid loop() {}\n")))
+	require.NoError(t, sketchPath.Join(sketch.ProjectFileName).WriteFile([]byte("profiles: [\n")))
+
+	profileName := func(req *rpc.CompileRequest) (string, string, error) {
+		sk, err := sketch.New(sketchPath)
+		require.NoError(t, err)
+		errStream := &bytes.Buffer{}
+		name, err := buildProfileName(sk, req, errStream)
+		return name, errStream.String(), err
+	}
+
+	// The profile is needed without a board
+	_, _, err = profileName(&rpc.CompileRequest{})
+	require.Error(t, err)
+	_, _, err = profileName(&rpc.CompileRequest{Profile: "uno"})
+	require.Error(t, err)
+
+	// The board given with the request is used
+	name, warning, err := profileName(&rpc.CompileRequest{Fqbn: "arduino:avr:uno"})
+	require.NoError(t, err)
+	require.Empty(t, name)
+	require.Contains(t, warning, sketch.ProjectFileName)
+
+	// The board attached to the sketch is used
+	require.NoError(t, sketchPath.Join("sketch.json").WriteFile([]byte(`{"cpu": {"fqbn": "arduino:avr:uno"}}`)))
+	name, warning, err = profileName(&rpc.CompileRequest{})
+	require.NoError(t, err)
+	require.Empty(t, name)
+	require.Contains(t, warning, sketch.ProjectFileName)
+	_, _, err = profileName(&rpc.CompileRequest{Profile: "uno"})
+	require.Error(t, err)
+}
@@ -0,0 +1,405 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"context"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	bldr "github.com/arduino/arduino-cli/arduino/builder"
+	"github.com/arduino/arduino-cli/arduino/builder/projectexport"
+	"github.com/arduino/arduino-cli/arduino/globals"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/legacy/builder/builder_utils"
+	"github.com/arduino/arduino-cli/legacy/builder/constants"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"google.golang.org/protobuf/proto"
+)
+
+// exportFormats are the formats of the exported projects
+var exportFormats = []string{"make", "cmake", "cpp"}
+
+// recipesAfterLink are the prefixes of the recipes run after the linker, in
+// the order used by the builder
+var recipesAfterLink = []string{
+	"recipe.hooks.linking.postlink",
+	"recipe.hooks.objcopy.preobjcopy",
+	"recipe.objcopy.",
+	"recipe.hooks.objcopy.postobjcopy",
+}
+
+// ExportSketch writes the build of the sketch as a standalone project. The
+// sketch is preprocessed and its build is run without compiling, to get the
+// commands of the build, then the sources used by the commands are copied in
+// the project and, unless the format is "cpp", the commands are written as a
+// Makefile or as a CMakeLists.txt. The paths of the tools used by the build are
+// variables of the build files, since the toolchains are not copied.
+func ExportSketch(ctx context.Context, req *rpc.ExportSketchRequest, outStream, errStream io.Writer, debug bool) (*rpc.ExportSketchResponse, error) {
+	format := req.GetFormat()
+	validFormat := false
+	for _, f := range exportFormats {
+		validFormat = validFormat || f == format
+	}
+	if !validFormat {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid export format %[1]s, it must be one of: %[2]s", format, strings.Join(exportFormats, ", "))}
+	}
+	if req.GetCompile() == nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Missing compile request")}
+	}
+	if req.GetCompile().GetSketchPath() == "" {
+		return nil, &arduino.MissingSketchPathError{}
+	}
+	sk, err := sketch.New(paths.New(req.GetCompile().GetSketchPath()))
+	if err != nil {
+		return nil, &arduino.CantOpenSketchError{Cause: err}
+	}
+
+	outputDir := sk.FullPath.Parent().Join(sk.Name + "-" + format)
+	if req.GetOutputDir() != "" {
+		outputDir = paths.New(req.GetOutputDir())
+	}
+	if err := outputDir.ToAbs(); err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid output directory"), Cause: err}
+	}
+	if outputDir.Exist() {
+		if files, err := outputDir.ReadDir(); err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error reading output directory"), Cause: err}
+		} else if len(files) > 0 {
+			return nil, &arduino.InvalidArgumentError{Message: tr("The output directory %s is not empty", outputDir)}
+		}
+	}
+
+	// The build runs in a new folder, to find all the commands of the build
+	// and to not mistake the leftovers of other builds for generated sources
+	buildPath, err := paths.MkTempDir("", "arduino-sketch-export")
+	if err != nil {
+		return nil, &arduino.TempDirCreationFailedError{Cause: err}
+	}
+	defer buildPath.RemoveAll()
+
+	compileReq := proto.Clone(req.GetCompile()).(*rpc.CompileRequest)
+	compileReq.BuildPath = buildPath.String()
+	compileReq.CreateCompilationDatabaseOnly = true
+	compileReq.ExportDir = ""
+	compileReq.SizeReport = false
+	compileReq.VerifyManifest = ""
+	compileReq.CompareTo = ""
+	compileReq.MaxFlashIncrease = nil
+	compileReq.MaxRamIncrease = nil
+	_, builderCtx, err := compile(ctx, compileReq, outStream, errStream, nil, debug, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	project, mapper, err := exportProject(builderCtx, sk)
+	if err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Error exporting the build of the sketch"), Cause: err}
+	}
+
+	if err := outputDir.MkdirAll(); err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Error creating output dir"), Cause: err}
+	}
+	if err := mapper.CopyTo(outputDir); err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Error copying the sources of the project"), Cause: err}
+	}
+	if err := removeLineDirectives(outputDir.Join("sketch")); err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Error copying the sources of the project"), Cause: err}
+	}
+
+	var buildFile *paths.Path
+	var writeBuildFile func(io.Writer, *projectexport.Project) error
+	switch format {
+	case "make":
+		buildFile = outputDir.Join(projectexport.MakefileName)
+		writeBuildFile = projectexport.WriteMakefile
+	case "cmake":
+		buildFile = outputDir.Join(projectexport.CMakeListsName)
+		writeBuildFile = projectexport.WriteCMakeLists
+	}
+	if buildFile != nil {
+		f, err := buildFile.Create()
+		if err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error writing %s", buildFile.Base()), Cause: err}
+		}
+		err = writeBuildFile(f, project)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error writing %s", buildFile.Base()), Cause: err}
+		}
+	}
+
+	res := &rpc.ExportSketchResponse{OutputDir: outputDir.String()}
+	for _, v := range project.Variables {
+		res.Variables = append(res.Variables, &rpc.ExportSketchVariable{
+			Name:        v.Name,
+			Value:       v.Value,
+			Description: v.Description,
+		})
+	}
+	return res, nil
+}
+
+// exportProject builds the project from the commands recorded by a build run
+// without compiling
+func exportProject(ctx *types.Context, sk *sketch.Sketch) (*projectexport.Project, *projectexport.Mapper, error) {
+	buildProperties := ctx.BuildProperties
+	mapper := projectexport.NewMapper()
+	project := &projectexport.Project{
+		Name: sk.Name,
+		FQBN: buildProperties.Get(constants.BUILD_PROPERTIES_FQBN),
+	}
+
+	mapper.AddBuildDir(ctx.BuildPath, "generated")
+	mapper.AddExistingSources(ctx.SketchBuildPath, "sketch")
+	platformDir := buildProperties.GetPath(constants.BUILD_PROPERTIES_RUNTIME_PLATFORM_PATH)
+	mapper.AddSources(platformDir, "platform")
+	// The core and the variant may come from a referenced platform
+	if core := buildProperties.GetPath("build.core.path"); core != nil {
+		if corePlatformDir := core.Parent().Parent(); !corePlatformDir.EqualsTo(platformDir) {
+			mapper.AddSources(corePlatformDir, "core-platform")
+		}
+	}
+	if variant := buildProperties.GetPath("build.variant.path"); variant != nil {
+		if variantPlatformDir := variant.Parent().Parent(); !variantPlatformDir.EqualsTo(platformDir) {
+			mapper.AddSources(variantPlatformDir, "variant-platform")
+		}
+	}
+	libraryDirs := map[string]bool{}
+	for _, lib := range ctx.ImportedLibraries {
+		dir := "libraries/" + lib.InstallDir.Base()
+		for i := 2; libraryDirs[dir]; i++ {
+			dir = "libraries/" + lib.InstallDir.Base() + "-" + strconv.Itoa(i)
+		}
+		libraryDirs[dir] = true
+		mapper.AddSources(lib.InstallDir, dir)
+	}
+	project.Variables = toolVariables(buildProperties)
+	for _, v := range project.Variables {
+		mapper.AddVariable(paths.New(v.Value), v.Name)
+	}
+
+	mapArgs := func(args []string) []projectexport.Word {
+		res := []projectexport.Word{}
+		for _, arg := range args {
+			res = append(res, mapper.Map(arg))
+		}
+		return res
+	}
+
+	// Compile the sources, in a stable order since the build runs in parallel
+	compilations := append([]bldr.CompilationCommand{}, ctx.CompilationDatabase.Contents...)
+	sort.SliceStable(compilations, func(i, j int) bool { return compilations[i].Output < compilations[j].Output })
+	objectFiles := paths.NewPathList()
+	for _, command := range compilations {
+		if command.Output == "" {
+			continue
+		}
+		objectFile := paths.New(command.Output)
+		objectFiles.Add(objectFile)
+		step := &projectexport.Step{
+			Inputs:   []projectexport.Word{mapper.Map(command.File)},
+			Outputs:  []projectexport.Word{mapper.MapPath(objectFile)},
+			Commands: [][]projectexport.Word{mapArgs(command.Arguments)},
+		}
+		if objectFile.Ext() == ".o" {
+			step.DepFile = mapper.Map(strings.TrimSuffix(command.Output, ".o") + ".d")
+		}
+		project.Steps = append(project.Steps, step)
+	}
+
+	// Archive the objects files of the core and of the libraries linked as
+	// archives: the objects are the ones not linked directly
+	linkedFiles := paths.NewPathList()
+	linkedFiles.AddAll(ctx.SketchObjectFiles)
+	linkedFiles.AddAll(ctx.LibrariesObjectFiles)
+	linkedFiles.AddAll(ctx.CoreObjectsFiles)
+	archives := paths.NewPathList()
+	for _, file := range append(linkedFiles.Clone(), ctx.CoreArchiveFilePath) {
+		if inside, _ := file.IsInsideDir(ctx.BuildPath); inside && file.Ext() == ".a" {
+			archives.Add(file)
+		}
+	}
+	for _, archive := range archives {
+		step := &projectexport.Step{
+			Outputs:       []projectexport.Word{mapper.MapPath(archive)},
+			RemoveOutputs: true,
+		}
+		for _, objectFile := range objectFiles {
+			if inside, _ := objectFile.IsInsideDir(archive.Parent()); !inside || linkedFiles.Contains(objectFile) {
+				continue
+			}
+			properties := buildProperties.Clone()
+			properties.Set(constants.BUILD_PROPERTIES_ARCHIVE_FILE, archive.Base())
+			properties.SetPath(constants.BUILD_PROPERTIES_ARCHIVE_FILE_PATH, archive)
+			properties.SetPath(constants.BUILD_PROPERTIES_OBJECT_FILE, objectFile)
+			command, err := builder_utils.PrepareCommandForRecipe(properties, constants.RECIPE_AR_PATTERN, false)
+			if err != nil {
+				return nil, nil, err
+			}
+			step.Inputs = append(step.Inputs, mapper.MapPath(objectFile))
+			step.Commands = append(step.Commands, mapArgs(command.Args))
+		}
+		project.Steps = append(project.Steps, step)
+	}
+
+	// Link the executable, as done by the builder
+	link := &projectexport.Step{}
+	inputs := map[string]bool{}
+	for _, file := range append(linkedFiles.Clone(), ctx.CoreArchiveFilePath) {
+		input := mapper.MapPath(file)
+		link.Inputs = append(link.Inputs, input)
+		inputs[input.String()] = true
+	}
+	for _, recipe := range findRecipesWithPrefix(buildProperties, "recipe.hooks.linking.prelink") {
+		command, err := builder_utils.PrepareCommandForRecipe(buildProperties, recipe, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		link.Commands = append(link.Commands, mapArgs(command.Args))
+	}
+	coreArchiveRelPath, err := ctx.BuildPath.RelTo(ctx.CoreArchiveFilePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	properties := buildProperties.Clone()
+	properties.Set(constants.BUILD_PROPERTIES_COMPILER_WARNING_FLAGS, properties.Get(constants.BUILD_PROPERTIES_COMPILER_WARNING_FLAGS+"."+ctx.WarningsLevel))
+	properties.Set(constants.BUILD_PROPERTIES_ARCHIVE_FILE, coreArchiveRelPath.String())
+	properties.SetPath(constants.BUILD_PROPERTIES_ARCHIVE_FILE_PATH, ctx.CoreArchiveFilePath)
+	objectFileList := []string{}
+	for _, file := range linkedFiles {
+		objectFileList = append(objectFileList, "\""+file.String()+"\"")
+	}
+	properties.Set("object_files", strings.Join(objectFileList, " "))
+	command, err := builder_utils.PrepareCommandForRecipe(properties, constants.RECIPE_C_COMBINE_PATTERN, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	linkCommand := mapArgs(command.Args)
+	link.Commands = append(link.Commands, linkCommand)
+	// The outputs of the linker are the files of the build folder, not used as
+	// inputs, given as arguments. The one given with -o comes first.
+	for i, arg := range linkCommand {
+		if !arg.IsPathIn(projectexport.BuildDir) || inputs[arg.String()] {
+			continue
+		}
+		if i > 0 && linkCommand[i-1].String() == "-o" {
+			link.Outputs = append([]projectexport.Word{arg}, link.Outputs...)
+		} else {
+			link.Outputs = append(link.Outputs, arg)
+		}
+	}
+	project.Steps = append(project.Steps, link)
+
+	project.Final = &projectexport.Step{Inputs: link.Outputs}
+	for _, prefix := range recipesAfterLink {
+		for _, recipe := range findRecipesWithPrefix(buildProperties, prefix) {
+			command, err := builder_utils.PrepareCommandForRecipe(buildProperties, recipe, false)
+			if err != nil {
+				return nil, nil, err
+			}
+			project.Final.Commands = append(project.Final.Commands, mapArgs(command.Args))
+		}
+	}
+	return project, mapper, nil
+}
+
+// toolVariables returns a variable for the folder of every tool used by the
+// build. The name of the variable is the one of the tool, without version,
+// when available.
+func toolVariables(buildProperties *properties.Map) []*projectexport.Variable {
+	toolNames := map[string]string{}
+	for _, key := range buildProperties.Keys() {
+		if !strings.HasPrefix(key, "runtime.tools.") || !strings.HasSuffix(key, ".path") {
+			continue
+		}
+		name := strings.TrimSuffix(strings.TrimPrefix(key, "runtime.tools."), ".path")
+		path := buildProperties.Get(key)
+		if path == "" {
+			continue
+		}
+		if current, ok := toolNames[path]; !ok || len(name) < len(current) {
+			toolNames[path] = name
+		}
+	}
+	res := []*projectexport.Variable{}
+	for path, name := range toolNames {
+		res = append(res, &projectexport.Variable{
+			Name:        "TOOLS_" + strings.ToUpper(nonIdentifierChars.ReplaceAllString(name, "_")),
+			Value:       path,
+			Description: "Path of the " + name + " tool",
+		})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res
+}
+
+var nonIdentifierChars = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// findRecipesWithPrefix returns the non empty recipes with the given prefix,
+// sorted as done by the builder
+func findRecipesWithPrefix(buildProperties *properties.Map, prefix string) []string {
+	recipes := []string{}
+	for _, key := range buildProperties.Keys() {
+		if strings.HasPrefix(key, prefix) && strings.HasSuffix(key, ".pattern") && buildProperties.Get(key) != "" {
+			recipes = append(recipes, key)
+		}
+	}
+	sort.Strings(recipes)
+	return recipes
+}
+
+var lineDirective = regexp.MustCompile(`^#line\s\d+\s"`)
+
+// removeLineDirectives blanks the #line directives, added by the builder to
+// the sources of the sketch, since they refer to the original files
+func removeLineDirectives(dir *paths.Path) error {
+	if !dir.IsDir() {
+		return nil
+	}
+	files, err := dir.ReadDirRecursive()
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if _, ok := globals.AdditionalFileValidExtensions[file.Ext()]; !ok || file.IsDir() {
+			continue
+		}
+		data, err := file.ReadFile()
+		if err != nil {
+			return err
+		}
+		lines := strings.Split(string(data), "\n")
+		for i, line := range lines {
+			if lineDirective.MatchString(line) {
+				lines[i] = ""
+			}
+		}
+		if err := file.WriteFile([]byte(strings.Join(lines, "\n"))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
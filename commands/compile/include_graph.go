@@ -0,0 +1,44 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// includeGraphToRPC converts the #include directives resolved to a library
+// during the build
+func includeGraphToRPC(edges []*types.IncludeGraphEdge) []*rpc.IncludeGraphEdge {
+	res := []*rpc.IncludeGraphEdge{}
+	for _, edge := range edges {
+		rpcEdge := &rpc.IncludeGraphEdge{
+			SourceFile:   edge.SourceFile.String(),
+			Header:       edge.Resolution.Header,
+			Selected:     edge.Resolution.Selected.ToRPC(),
+			Alternatives: []*rpc.LibraryCandidate{},
+			Reason:       edge.Resolution.Reason,
+		}
+		if edge.SourceLibrary != nil {
+			rpcEdge.SourceLibrary = edge.SourceLibrary.Name
+		}
+		for _, alternative := range edge.Resolution.Alternatives {
+			rpcEdge.Alternatives = append(rpcEdge.Alternatives, alternative.ToRPC())
+		}
+		res = append(res, rpcEdge)
+	}
+	return res
+}
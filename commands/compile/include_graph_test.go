@@ -0,0 +1,78 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesresolver"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncludeGraphToRPC(t *testing.T) {
+	userServo := &libraries.Library{Name: "Servo", Location: libraries.User, InstallDir: paths.New("user", "Servo")}
+	bundleServo := &libraries.Library{Name: "Servo", Location: libraries.IDEBuiltIn, InstallDir: paths.New("ide", "Servo")}
+	wire := &libraries.Library{Name: "Wire", Location: libraries.PlatformBuiltIn, InstallDir: paths.New("platform", "Wire")}
+
+	edges := []*types.IncludeGraphEdge{
+		{
+			SourceFile: paths.New("sketch", "sketch.ino"),
+			Resolution: &librariesresolver.Resolution{
+				Header:   "Servo.h",
+				Selected: &librariesresolver.Candidate{Library: userServo, Priority: 1503, ArchitectureMatch: librariesresolver.ArchitectureCompatible},
+				Alternatives: []*librariesresolver.Candidate{
+					{Library: bundleServo, Priority: 1500, ArchitectureMatch: librariesresolver.ArchitectureCompatible},
+				},
+				Reason: librariesresolver.ReasonLocation,
+			},
+		},
+		{
+			SourceFile:    paths.New("user", "Servo", "src", "Servo.cpp"),
+			SourceLibrary: userServo,
+			Resolution: &librariesresolver.Resolution{
+				Header:       "Wire.h",
+				Selected:     &librariesresolver.Candidate{Library: wire, Priority: 1502, ArchitectureMatch: librariesresolver.ArchitectureOptimized},
+				Alternatives: []*librariesresolver.Candidate{},
+				Reason:       librariesresolver.ReasonOnlyCandidate,
+			},
+		},
+	}
+
+	res := includeGraphToRPC(edges)
+	require.Len(t, res, 2)
+
+	require.Equal(t, paths.New("sketch", "sketch.ino").String(), res[0].GetSourceFile())
+	require.Equal(t, "", res[0].GetSourceLibrary())
+	require.Equal(t, "Servo.h", res[0].GetHeader())
+	require.Equal(t, "Servo", res[0].GetSelected().GetName())
+	require.Equal(t, paths.New("user", "Servo").String(), res[0].GetSelected().GetInstallDir())
+	require.Equal(t, rpc.LibraryLocation_LIBRARY_LOCATION_USER, res[0].GetSelected().GetLocation())
+	require.Equal(t, int32(1503), res[0].GetSelected().GetPriority())
+	require.Len(t, res[0].GetAlternatives(), 1)
+	require.Equal(t, paths.New("ide", "Servo").String(), res[0].GetAlternatives()[0].GetInstallDir())
+	require.Equal(t, rpc.LibraryLocation_LIBRARY_LOCATION_IDE_BUILTIN, res[0].GetAlternatives()[0].GetLocation())
+	require.Equal(t, "location", res[0].GetReason())
+
+	require.Equal(t, "Servo", res[1].GetSourceLibrary())
+	require.Equal(t, "Wire", res[1].GetSelected().GetName())
+	require.Equal(t, "optimized", res[1].GetSelected().GetArchitectureMatch())
+	require.Empty(t, res[1].GetAlternatives())
+	require.Equal(t, "only-candidate", res[1].GetReason())
+}
@@ -0,0 +1,68 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"io/ioutil"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesresolver"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/legacy/builder"
+	legacyi18n "github.com/arduino/arduino-cli/legacy/builder/i18n"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// LibrariesResolver returns the resolver the requested build uses to choose
+// the libraries providing the included headers, with the platform of the
+// board. The libraries are loaded as in the build: the ones given with the
+// request, the ones pinned by the profile or installed, and the ones bundled
+// with the platforms and with the Arduino IDE. The sketch path of the request
+// is needed only to use its profiles or the board attached to it.
+func LibrariesResolver(req *rpc.CompileRequest) (*librariesresolver.Cpp, *cores.PlatformRelease, error) {
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, nil, &arduino.InvalidInstanceError{}
+	}
+	var sk *sketch.Sketch
+	if req.GetSketchPath() != "" {
+		var err error
+		if sk, err = sketch.New(paths.New(req.GetSketchPath())); err != nil {
+			return nil, nil, &arduino.CantOpenSketchError{Cause: err}
+		}
+	}
+	pm, fqbn, profile, profileLibraries, err := buildTarget(req, sk, pm, ioutil.Discard, ioutil.Discard)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, _, _, _, _, err := pm.ResolveFQBN(fqbn); err != nil {
+		return nil, nil, &arduino.UnknownFQBNError{Cause: err}
+	}
+
+	builderCtx := &types.Context{}
+	builderCtx.PackageManager = pm
+	builderCtx.FQBN = fqbn
+	setLibrariesDirs(builderCtx, req, profile, profileLibraries)
+	builderCtx.SetLogger(legacyi18n.LoggerToCustomStreams{Stdout: ioutil.Discard, Stderr: ioutil.Discard})
+	if err := builder.RunLoadLibraries(builderCtx); err != nil {
+		return nil, nil, &arduino.PermissionDeniedError{Message: tr("Error loading the libraries"), Cause: err}
+	}
+	return builderCtx.LibrariesResolver, builderCtx.TargetPlatform, nil
+}
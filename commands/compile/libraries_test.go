@@ -0,0 +1,78 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/legacy/builder"
+	legacyi18n "github.com/arduino/arduino-cli/legacy/builder/i18n"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadLibrariesOfTheBuild(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "load_libraries_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	configuration.Settings = configuration.Init(tmp.Join("arduino-cli.yaml").String())
+	configuration.Settings.Set("directories.Data", tmp.Join("data").String())
+	configuration.Settings.Set("directories.User", tmp.Join("user").String())
+
+	addLibrary := func(dir *paths.Path, architectures string) {
+		require.NoError(t, dir.MkdirAll())
+		require.NoError(t, dir.Join("library.properties").WriteFile([]byte("name="+dir.Base()+"\nversion=1.0.0\narchitectures="+architectures+"\n")))
+		require.NoError(t, dir.Join("src").MkdirAll())
+		require.NoError(t, dir.Join("src", "Servo.h").WriteFile([]byte{}))
+	}
+	platformDir := tmp.Join("hardware", "alice", "avr")
+	require.NoError(t, platformDir.MkdirAll())
+	require.NoError(t, platformDir.Join("boards.txt").WriteFile([]byte("board1.name=Board 1\n")))
+	require.NoError(t, platformDir.Join("platform.txt").WriteFile([]byte("name=Alice\n")))
+	addLibrary(platformDir.Join("libraries", "Servo"), "avr")
+	addLibrary(tmp.Join("user", "libraries", "ServoInstalled"), "*")
+	addLibrary(tmp.Join("dev", "Servo"), "avr")
+	addLibrary(tmp.Join("other", "ServoOther"), "avr")
+
+	pm := packagemanager.NewPackageManager(nil, nil, nil, nil)
+	require.Empty(t, pm.LoadHardwareFromDirectory(tmp.Join("hardware")))
+	fqbn, err := cores.ParseFQBN("alice:avr:board1")
+	require.NoError(t, err)
+	resolve := func(req *rpc.CompileRequest) *paths.Path {
+		builderCtx := &types.Context{}
+		builderCtx.PackageManager = pm
+		builderCtx.FQBN = fqbn
+		setLibrariesDirs(builderCtx, req, nil, nil)
+		builderCtx.SetLogger(legacyi18n.LoggerToCustomStreams{})
+		require.NoError(t, builder.RunLoadLibraries(builderCtx))
+		resolution := builderCtx.LibrariesResolver.Resolve("Servo.h", "avr")
+		require.NotNil(t, resolution)
+		return resolution.Selected.Library.InstallDir
+	}
+
+	// The libraries of the platform are considered with the installed ones
+	require.Equal(t, platformDir.Join("libraries", "Servo"), resolve(&rpc.CompileRequest{}))
+	// The libraries given with the request have the priority of the build
+	require.Equal(t, tmp.Join("dev", "Servo"), resolve(&rpc.CompileRequest{Library: []string{tmp.Join("dev", "Servo").String()}}))
+	// The overrides of the user are applied
+	configuration.Settings.Set("library.header_overrides", []string{"Servo.h=ServoOther"})
+	require.Equal(t, tmp.Join("other", "ServoOther"), resolve(&rpc.CompileRequest{Libraries: []string{tmp.Join("other").String()}}))
+}
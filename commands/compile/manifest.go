@@ -0,0 +1,204 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/builder/buildmanifest"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	"github.com/arduino/arduino-cli/arduino/security"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	paths "github.com/arduino/go-paths-helper"
+	"golang.org/x/crypto/openpgp/armor"
+)
+
+// buildArtifacts returns the files produced by the build that are exported,
+// like "sketch.ino.hex"
+func buildArtifacts(builderCtx *types.Context) (paths.PathList, error) {
+	baseName := builderCtx.BuildProperties.Get("build.project_name") // == "sketch.ino"
+	buildFiles, err := builderCtx.BuildPath.ReadDir()
+	if err != nil {
+		return nil, err
+	}
+	buildFiles.FilterPrefix(baseName)
+	buildFiles.Sort()
+	return buildFiles, nil
+}
+
+// newBuildManifest returns the manifest of the build, listing the inputs used
+// by the builder and the given artifacts
+func newBuildManifest(builderCtx *types.Context, artifacts paths.PathList) (*buildmanifest.Manifest, error) {
+	m := buildmanifest.New(builderCtx.FQBN.String())
+
+	sk := builderCtx.Sketch
+	sketchFiles := paths.PathList{sk.MainFile}
+	sketchFiles.AddAll(sk.OtherSketchFiles)
+	sketchFiles.AddAll(sk.AdditionalFiles)
+	sketchFiles.Sort()
+	for _, file := range sketchFiles {
+		rel, err := sk.FullPath.RelTo(file)
+		if err != nil {
+			return nil, err
+		}
+		// the overridden files are built with the content given by the client
+		if content, ok := builderCtx.SourceOverride[rel.String()]; ok {
+			m.AddFile(rel.String(), []byte(content))
+			continue
+		}
+		content, err := file.ReadFile()
+		if err != nil {
+			return nil, err
+		}
+		m.AddFile(rel.String(), content)
+	}
+
+	for _, lib := range builderCtx.ImportedLibraries {
+		hash, err := buildmanifest.DirSHA256(lib.InstallDir)
+		if err != nil {
+			return nil, err
+		}
+		version := ""
+		if lib.Version != nil {
+			version = lib.Version.String()
+		}
+		m.Libraries = append(m.Libraries, &buildmanifest.Library{
+			Name:     lib.Name,
+			Version:  version,
+			Location: lib.Location.String(),
+			SHA256:   hash,
+		})
+	}
+
+	platforms := []*cores.PlatformRelease{builderCtx.TargetPlatform}
+	if builderCtx.ActualPlatform != builderCtx.TargetPlatform {
+		platforms = append(platforms, builderCtx.ActualPlatform)
+	}
+	for _, platform := range platforms {
+		hash, err := releaseSHA256(platform.Resource, platform.InstallDir)
+		if err != nil {
+			return nil, err
+		}
+		m.Platforms = append(m.Platforms, &buildmanifest.Release{
+			Name:    platform.Platform.String(),
+			Version: platform.Version.String(),
+			SHA256:  hash,
+		})
+	}
+
+	for _, tool := range builderCtx.RequiredTools {
+		hash, err := releaseSHA256(tool.GetCompatibleFlavour(), tool.InstallDir)
+		if err != nil {
+			return nil, err
+		}
+		m.Tools = append(m.Tools, &buildmanifest.Release{
+			Name:    tool.Tool.Package.Name + ":" + tool.Tool.Name,
+			Version: tool.Version.String(),
+			SHA256:  hash,
+		})
+	}
+
+	m.SetBuildProperties(builderCtx.BuildProperties)
+
+	for _, artifact := range artifacts {
+		if err := m.AddArtifact(artifact); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// releaseSHA256 returns the SHA-256 checksum of the archive of a release, or
+// the SHA-256 of the installed files if the checksum is not available
+func releaseSHA256(resource *resources.DownloadResource, installDir *paths.Path) (string, error) {
+	if resource != nil && strings.HasPrefix(resource.Checksum, "SHA-256:") {
+		return strings.ToLower(strings.TrimPrefix(resource.Checksum, "SHA-256:")), nil
+	}
+	return buildmanifest.DirSHA256(installDir)
+}
+
+// verifyBuildManifest compares the rebuilt artifacts with the ones of the
+// expected manifest. The artifacts found next to the expected manifest are
+// compared byte by byte, the others through their SHA-256. The differences
+// are returned together with the differences in the inputs, that may explain
+// them.
+func verifyBuildManifest(expected *buildmanifest.Manifest, expectedDir *paths.Path, rebuilt *buildmanifest.Manifest, buildPath *paths.Path) ([]string, error) {
+	rebuiltHashes := map[string]string{}
+	for _, artifact := range rebuilt.Artifacts {
+		rebuiltHashes[artifact.Path] = artifact.SHA256
+	}
+
+	diffs := []string{}
+	for _, artifact := range expected.Artifacts {
+		hash, ok := rebuiltHashes[artifact.Path]
+		if !ok {
+			diffs = append(diffs, tr("artifact %s has not been produced", artifact.Path))
+			continue
+		}
+		delete(rebuiltHashes, artifact.Path)
+
+		if exported := expectedDir.Join(artifact.Path); exported.Exist() {
+			expectedContent, err := exported.ReadFile()
+			if err != nil {
+				return nil, err
+			}
+			rebuiltContent, err := buildPath.Join(artifact.Path).ReadFile()
+			if err != nil {
+				return nil, err
+			}
+			if !bytes.Equal(expectedContent, rebuiltContent) {
+				diffs = append(diffs, tr("artifact %[1]s differs from %[2]s", artifact.Path, exported))
+			}
+		} else if hash != artifact.SHA256 {
+			diffs = append(diffs, tr("artifact %s differs from the one in the manifest", artifact.Path))
+		}
+	}
+	for _, artifact := range rebuilt.Artifacts {
+		if _, ok := rebuiltHashes[artifact.Path]; ok {
+			diffs = append(diffs, tr("artifact %s is not in the manifest", artifact.Path))
+		}
+	}
+
+	if len(diffs) > 0 {
+		diffs = append(diffs, expected.DiffInputs(rebuilt)...)
+	}
+	return diffs, nil
+}
+
+// verifyBuildManifestSignature checks that the signature next to the manifest
+// has been made with the given public key, binary or ASCII armored
+func verifyBuildManifestSignature(manifestPath, keyPath *paths.Path) error {
+	keyData, err := keyPath.ReadFile()
+	if err != nil {
+		return &arduino.InvalidArgumentError{Message: tr("Error reading the key of the build manifest"), Cause: err}
+	}
+	var key io.Reader = bytes.NewReader(keyData)
+	if block, err := armor.Decode(bytes.NewReader(keyData)); err == nil {
+		key = block.Body
+	}
+	signaturePath := manifestPath.Parent().Join(buildmanifest.SignatureFileName)
+	if !signaturePath.Exist() {
+		return &arduino.InvalidArgumentError{Message: tr("Signature of the build manifest not found: %s", signaturePath)}
+	}
+	if valid, _, err := security.VerifySignature(manifestPath, signaturePath, key); !valid {
+		return &arduino.InvalidArgumentError{Message: tr("Invalid signature of the build manifest"), Cause: err}
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/builder/buildmanifest"
+	"github.com/arduino/arduino-cli/arduino/security"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+)
+
+func TestVerifyBuildManifest(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "verify_manifest_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	exportDir := tmp.Join("export")
+	buildDir := tmp.Join("build")
+	require.NoError(t, exportDir.MkdirAll())
+	require.NoError(t, buildDir.MkdirAll())
+
+	manifestOf := func(dir *paths.Path, files ...string) *buildmanifest.Manifest {
+		m := buildmanifest.New("arduino:avr:uno")
+		for _, file := range files {
+			require.NoError(t, m.AddArtifact(dir.Join(file)))
+		}
+		return m
+	}
+
+	require.NoError(t, exportDir.Join("sketch.ino.hex").WriteFile([]byte(":00000001FF")))
+	require.NoError(t, exportDir.Join("sketch.ino.elf").WriteFile([]byte("ELF")))
+	expected := manifestOf(exportDir, "sketch.ino.hex", "sketch.ino.elf")
+
+	// identical rebuild
+	require.NoError(t, buildDir.Join("sketch.ino.hex").WriteFile([]byte(":00000001FF")))
+	require.NoError(t, buildDir.Join("sketch.ino.elf").WriteFile([]byte("ELF")))
+	diffs, err := verifyBuildManifest(expected, exportDir, manifestOf(buildDir, "sketch.ino.hex", "sketch.ino.elf"), buildDir)
+	require.NoError(t, err)
+	require.Empty(t, diffs)
+
+	// the exported binary is compared byte by byte, the missing elf by hash
+	require.NoError(t, buildDir.Join("sketch.ino.hex").WriteFile([]byte(":00000001FE")))
+	require.NoError(t, exportDir.Join("sketch.ino.elf").Remove())
+	require.NoError(t, buildDir.Join("sketch.ino.bin").WriteFile([]byte("BIN")))
+	diffs, err = verifyBuildManifest(expected, exportDir, manifestOf(buildDir, "sketch.ino.bin", "sketch.ino.hex", "sketch.ino.elf"), buildDir)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"artifact sketch.ino.hex differs from " + exportDir.Join("sketch.ino.hex").String(),
+		"artifact sketch.ino.bin is not in the manifest",
+	}, diffs)
+
+	// the elf differs from the hash in the manifest
+	require.NoError(t, buildDir.Join("sketch.ino.elf").WriteFile([]byte("ELF2")))
+	diffs, err = verifyBuildManifest(expected, exportDir, manifestOf(buildDir, "sketch.ino.elf"), buildDir)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"artifact sketch.ino.hex has not been produced",
+		"artifact sketch.ino.elf differs from the one in the manifest",
+	}, diffs)
+}
+
+func TestVerifyBuildManifestSignature(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "manifest_signature_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	entity, err := openpgp.NewEntity("Test", "", "test@example.com", nil)
+	require.NoError(t, err)
+	privateKey := tmp.Join("private.gpg.key")
+	var buffer bytes.Buffer
+	require.NoError(t, entity.SerializePrivate(&buffer, nil))
+	require.NoError(t, privateKey.WriteFile(buffer.Bytes()))
+	// The public key is ASCII armored, as exported by "gpg --armor --export"
+	publicKey := tmp.Join("public.asc")
+	buffer.Reset()
+	w, err := armor.Encode(&buffer, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.Serialize(w))
+	require.NoError(t, w.Close())
+	require.NoError(t, publicKey.WriteFile(buffer.Bytes()))
+
+	manifest := tmp.Join(buildmanifest.FileName)
+	require.NoError(t, buildmanifest.New("arduino:avr:uno").Save(manifest))
+	require.Error(t, verifyBuildManifestSignature(manifest, publicKey))
+
+	_, err = security.SignDetached(manifest, tmp.Join(buildmanifest.SignatureFileName), privateKey)
+	require.NoError(t, err)
+	require.NoError(t, verifyBuildManifestSignature(manifest, publicKey))
+
+	require.NoError(t, buildmanifest.New("arduino:avr:mega").Save(manifest))
+	require.Error(t, verifyBuildManifestSignature(manifest, publicKey))
+}
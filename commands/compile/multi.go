@@ -0,0 +1,151 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/builder/scheduler"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+	"google.golang.org/protobuf/proto"
+)
+
+// sharedBuildResources are the resources shared by the builds of a MultiCompile
+type sharedBuildResources struct {
+	// workers running the compilation jobs of all the builds
+	workers scheduler.Workers
+	// libraries already loaded, by the absolute path of their directory
+	libraries map[string]libraries.List
+}
+
+// MultiCompile compiles the sketch for every requested board at the same time.
+// The builds share the PackageManager and the user libraries already loaded by the
+// instance, and run their compilation jobs on the same pool of workers. Every
+// build uses a subfolder, named after the FQBN, of the build path and of the
+// export directory. The error of a build doesn't stop the others: it's reported
+// in the result of the board.
+func MultiCompile(ctx context.Context, req *rpc.MultiCompileRequest, debug bool) (*rpc.MultiCompileResponse, error) {
+	compileReq := req.GetCompile()
+	if compileReq == nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Missing compile request")}
+	}
+	if len(req.GetFqbns()) == 0 {
+		return nil, &arduino.MissingFQBNError{}
+	}
+	targetDirs := map[string]bool{}
+	for _, fqbn := range req.GetFqbns() {
+		targetDir := multiCompileTargetDir(fqbn)
+		if targetDirs[targetDir] {
+			return nil, &arduino.InvalidArgumentError{Message: tr("The FQBN %s is given more than once", fqbn)}
+		}
+		targetDirs[targetDir] = true
+	}
+
+	pm := commands.GetPackageManager(compileReq.GetInstance().GetId())
+	if pm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	lm := commands.GetLibraryManager(compileReq.GetInstance().GetId())
+	if lm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	if compileReq.GetSketchPath() == "" {
+		return nil, &arduino.MissingSketchPathError{}
+	}
+	sk, err := sketch.New(paths.New(compileReq.GetSketchPath()))
+	if err != nil {
+		return nil, &arduino.CantOpenSketchError{Cause: err}
+	}
+	buildPath := sk.BuildPath
+	if compileReq.GetBuildPath() != "" {
+		buildPath = paths.New(compileReq.GetBuildPath())
+	}
+
+	// The builds don't load the bundled tools by themselves, since that modifies
+	// the PackageManager they are sharing
+	pm.LoadToolsFromBundleDirectories(configuration.BundleToolsDirectories(configuration.Settings))
+	shared := &sharedBuildResources{
+		workers:   scheduler.NewWorkers(int(compileReq.GetJobs())),
+		libraries: userLibraries(lm),
+	}
+
+	results := make([]*rpc.MultiCompileTargetResult, len(req.GetFqbns()))
+	var wg sync.WaitGroup
+	for i, fqbn := range req.GetFqbns() {
+		targetReq := proto.Clone(compileReq).(*rpc.CompileRequest)
+		targetReq.Fqbn = fqbn
+		targetReq.BuildPath = buildPath.Join(multiCompileTargetDir(fqbn)).String()
+		if exportDir := compileReq.GetExportDir(); exportDir != "" {
+			targetReq.ExportDir = paths.New(exportDir).Join(multiCompileTargetDir(fqbn)).String()
+		}
+
+		wg.Add(1)
+		go func(i int, targetReq *rpc.CompileRequest) {
+			defer wg.Done()
+			outStream := &bytes.Buffer{}
+			errStream := &bytes.Buffer{}
+			res, _, err := compile(ctx, targetReq, outStream, errStream, nil, debug, shared)
+			result := &rpc.MultiCompileTargetResult{
+				Fqbn:      targetReq.GetFqbn(),
+				Result:    res,
+				OutStream: outStream.Bytes(),
+				ErrStream: errStream.Bytes(),
+			}
+			if err != nil {
+				result.Error = err.Error()
+			}
+			results[i] = result
+		}(i, targetReq)
+	}
+	wg.Wait()
+
+	return &rpc.MultiCompileResponse{Results: results}, nil
+}
+
+// multiCompileTargetDir returns the name of the subfolder used by the build for
+// the given FQBN, e.g. "arduino.avr.nano.cpu-atmega328old" for
+// "arduino:avr:nano:cpu=atmega328old"
+func multiCompileTargetDir(fqbn string) string {
+	return strings.NewReplacer(":", ".", ",", "_", "=", "-", "/", "_", "\\", "_").Replace(fqbn)
+}
+
+// userLibraries returns the libraries installed in the user directory, as loaded
+// by the LibrariesManager of the instance
+func userLibraries(lm *librariesmanager.LibrariesManager) map[string]libraries.List {
+	librariesDir := configuration.LibrariesDir(configuration.Settings)
+	if err := librariesDir.ToAbs(); err != nil {
+		return nil
+	}
+	libs := libraries.List{}
+	for _, alternatives := range lm.Libraries {
+		for _, lib := range alternatives.Alternatives {
+			if lib.Location == libraries.User && lib.InstallDir.Parent().EquivalentTo(librariesDir) {
+				libs = append(libs, lib)
+			}
+		}
+	}
+	return map[string]libraries.List{librariesDir.String(): libs}
+}
@@ -0,0 +1,44 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"context"
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiCompileTargetDir(t *testing.T) {
+	require.Equal(t, "arduino.avr.uno", multiCompileTargetDir("arduino:avr:uno"))
+	require.Equal(t, "arduino.avr.nano.cpu-atmega328old", multiCompileTargetDir("arduino:avr:nano:cpu=atmega328old"))
+	require.Equal(t, "esp32.esp32.esp32.PSRAM-enabled_FlashMode-qio", multiCompileTargetDir("esp32:esp32:esp32:PSRAM=enabled,FlashMode=qio"))
+}
+
+func TestMultiCompileInvalidRequest(t *testing.T) {
+	_, err := MultiCompile(context.Background(), &rpc.MultiCompileRequest{Fqbns: []string{"arduino:avr:uno"}}, false)
+	require.Error(t, err)
+
+	_, err = MultiCompile(context.Background(), &rpc.MultiCompileRequest{Compile: &rpc.CompileRequest{}}, false)
+	require.Error(t, err)
+
+	_, err = MultiCompile(context.Background(), &rpc.MultiCompileRequest{
+		Compile: &rpc.CompileRequest{},
+		Fqbns:   []string{"arduino:avr:uno", "arduino:avr:mega", "arduino:avr:uno"},
+	}, false)
+	require.EqualError(t, err, "The FQBN arduino:avr:uno is given more than once")
+}
@@ -0,0 +1,94 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"fmt"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/builder/buildmanifest"
+	"github.com/arduino/arduino-cli/arduino/builder/sizereport"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// findPreviousExecutable returns the executable of a previous build of the
+// sketch: compareTo can be the executable itself, a build manifest listing it
+// or a folder containing it (a build path or the exported binaries).
+func findPreviousExecutable(compareTo *paths.Path, sk *sketch.Sketch) (*paths.Path, error) {
+	if compareTo.IsDir() {
+		executables, err := compareTo.ReadDir()
+		if err != nil {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Error reading the previous build %s", compareTo), Cause: err}
+		}
+		executables.FilterOutDirs()
+		executables.FilterSuffix(".elf")
+		if len(executables) == 1 {
+			return executables[0], nil
+		}
+		// Many executables in the folder: use the one of the sketch
+		for _, executable := range executables {
+			if executable.Base() == sk.MainFile.Base()+".elf" {
+				return executable, nil
+			}
+		}
+		return nil, &arduino.InvalidArgumentError{Message: tr("Executable of the sketch not found in the previous build %s", compareTo)}
+	}
+	if !compareTo.Exist() {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Previous build %s not found", compareTo)}
+	}
+	if compareTo.Ext() != ".json" {
+		return compareTo, nil
+	}
+
+	manifest, err := buildmanifest.Load(compareTo)
+	if err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid build manifest"), Cause: err}
+	}
+	for _, artifact := range manifest.Artifacts {
+		if executable := compareTo.Parent().Join(artifact.Path); executable.Ext() == ".elf" {
+			if !executable.Exist() {
+				return nil, &arduino.InvalidArgumentError{Message: tr("Executable %s listed in the build manifest not found", executable)}
+			}
+			return executable, nil
+		}
+	}
+	return nil, &arduino.InvalidArgumentError{Message: tr("The build manifest doesn't list an executable")}
+}
+
+// checkSizeBudget returns an error if the memory used by the build grew, from
+// the previous build, more than allowed by the request
+func checkSizeBudget(req *rpc.CompileRequest, comparison *sizereport.Comparison) error {
+	if comparison == nil {
+		return nil
+	}
+	exceeded := []string{}
+	if max := req.GetMaxFlashIncrease(); max != nil {
+		if increase := int64(comparison.Flash - comparison.PreviousFlash); increase > max.GetValue() {
+			exceeded = append(exceeded, tr("flash increased by %[1]s bytes, the maximum is %[2]s", fmt.Sprint(increase), fmt.Sprint(max.GetValue())))
+		}
+	}
+	if max := req.GetMaxRamIncrease(); max != nil {
+		if increase := int64(comparison.RAM - comparison.PreviousRAM); increase > max.GetValue() {
+			exceeded = append(exceeded, tr("RAM increased by %[1]s bytes, the maximum is %[2]s", fmt.Sprint(increase), fmt.Sprint(max.GetValue())))
+		}
+	}
+	if len(exceeded) > 0 {
+		return &arduino.SizeBudgetExceededError{Exceeded: exceeded}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/builder/buildmanifest"
+	"github.com/arduino/arduino-cli/arduino/builder/sizereport"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestFindPreviousExecutable(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "compare_to_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	sk := &sketch.Sketch{MainFile: paths.New("Blink", "Blink.ino")}
+
+	buildDir := tmp.Join("build")
+	require.NoError(t, buildDir.Join("sketch").MkdirAll())
+	require.NoError(t, buildDir.Join("Blink.ino.elf").WriteFile([]byte("ELF")))
+	require.NoError(t, buildDir.Join("Blink.ino.hex").WriteFile([]byte(":00000001FF")))
+
+	executable, err := findPreviousExecutable(buildDir, sk)
+	require.NoError(t, err)
+	require.Equal(t, buildDir.Join("Blink.ino.elf").String(), executable.String())
+	executable, err = findPreviousExecutable(buildDir.Join("Blink.ino.elf"), sk)
+	require.NoError(t, err)
+	require.Equal(t, buildDir.Join("Blink.ino.elf").String(), executable.String())
+
+	// The executable of the sketch is chosen between many
+	require.NoError(t, buildDir.Join("other.elf").WriteFile([]byte("ELF")))
+	executable, err = findPreviousExecutable(buildDir, sk)
+	require.NoError(t, err)
+	require.Equal(t, buildDir.Join("Blink.ino.elf").String(), executable.String())
+	_, err = findPreviousExecutable(buildDir, &sketch.Sketch{MainFile: paths.New("Other", "Other.ino")})
+	require.Error(t, err)
+
+	m := buildmanifest.New("arduino:avr:uno")
+	require.NoError(t, m.AddArtifact(buildDir.Join("Blink.ino.hex")))
+	require.NoError(t, m.AddArtifact(buildDir.Join("Blink.ino.elf")))
+	require.NoError(t, m.Save(buildDir.Join(buildmanifest.FileName)))
+	executable, err = findPreviousExecutable(buildDir.Join(buildmanifest.FileName), sk)
+	require.NoError(t, err)
+	require.Equal(t, buildDir.Join("Blink.ino.elf").String(), executable.String())
+
+	_, err = findPreviousExecutable(tmp.Join("missing"), sk)
+	require.Error(t, err)
+}
+
+func TestCheckSizeBudget(t *testing.T) {
+	comparison := &sizereport.Comparison{PreviousFlash: 1000, Flash: 2200, PreviousRAM: 100, RAM: 120}
+
+	require.NoError(t, checkSizeBudget(&rpc.CompileRequest{}, comparison))
+	require.NoError(t, checkSizeBudget(&rpc.CompileRequest{
+		MaxFlashIncrease: wrapperspb.Int64(1200),
+		MaxRamIncrease:   wrapperspb.Int64(20),
+	}, comparison))
+
+	err := checkSizeBudget(&rpc.CompileRequest{
+		MaxFlashIncrease: wrapperspb.Int64(1024),
+		MaxRamIncrease:   wrapperspb.Int64(0),
+	}, comparison)
+	require.Error(t, err)
+	require.IsType(t, &arduino.SizeBudgetExceededError{}, err)
+	require.Len(t, err.(*arduino.SizeBudgetExceededError).Exceeded, 2)
+}
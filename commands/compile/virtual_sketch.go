@@ -0,0 +1,113 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/globals"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// createVirtualSketch writes the files of the virtual sketch in a new private
+// folder. It returns the folder, that must be removed after the build, and the
+// path of the sketch inside it.
+func createVirtualSketch(vs *rpc.VirtualSketch) (*paths.Path, *paths.Path, error) {
+	files := vs.GetFiles()
+	names := []string{}
+	for name := range files {
+		clean := path.Clean(name)
+		if name == "" || path.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, "../") || strings.Contains(name, "\\") {
+			return nil, nil, &arduino.InvalidArgumentError{Message: tr("Invalid file name in the virtual sketch: %s", name)}
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	sketchName := vs.GetName()
+	if sketchName == "" {
+		for _, name := range names {
+			if _, ok := globals.MainFileValidExtensions[path.Ext(name)]; ok && !strings.Contains(name, "/") {
+				if sketchName != "" {
+					return nil, nil, &arduino.InvalidArgumentError{Message: tr("The name of the virtual sketch is required when the sketch has more .ino files")}
+				}
+				sketchName = strings.TrimSuffix(name, path.Ext(name))
+			}
+		}
+	}
+	if sketchName == "" || strings.ContainsAny(sketchName, "/\\") || sketchName == "." || sketchName == ".." {
+		return nil, nil, &arduino.InvalidArgumentError{Message: tr("Missing or invalid name of the virtual sketch")}
+	}
+	hasMainFile := false
+	for ext := range globals.MainFileValidExtensions {
+		_, ok := files[sketchName+ext]
+		hasMainFile = hasMainFile || ok
+	}
+	if !hasMainFile {
+		return nil, nil, &arduino.InvalidArgumentError{Message: tr("The virtual sketch doesn't contain the main file %s", sketchName+globals.MainFileValidExtension)}
+	}
+
+	scratchRoot := paths.TempDir().Join("arduino-virtual-sketches")
+	if err := scratchRoot.MkdirAll(); err != nil {
+		return nil, nil, &arduino.TempDirCreationFailedError{Cause: err}
+	}
+	scratchDir, err := scratchRoot.MkTempDir("sketch-")
+	if err != nil {
+		return nil, nil, &arduino.TempDirCreationFailedError{Cause: err}
+	}
+	sketchDir := scratchDir.Join(sketchName)
+	for _, name := range names {
+		file := sketchDir.Join(filepath.FromSlash(path.Clean(name)))
+		if err := file.Parent().MkdirAll(); err != nil {
+			scratchDir.RemoveAll()
+			return nil, nil, &arduino.CantCreateSketchError{Cause: err}
+		}
+		if err := file.WriteFile(files[name]); err != nil {
+			scratchDir.RemoveAll()
+			return nil, nil, &arduino.CantCreateSketchError{Cause: err}
+		}
+	}
+	return scratchDir, sketchDir, nil
+}
+
+// relativizeDiagnostic changes the paths of the files of the virtual sketch,
+// used by the diagnostic, to paths relative to the sketch folder
+func relativizeDiagnostic(d *rpc.CompileDiagnostic, sketchDir *paths.Path) {
+	relativize := func(file string) string {
+		if file == "" {
+			return file
+		}
+		if rel, err := sketchDir.RelTo(paths.New(file)); err == nil && !strings.HasPrefix(rel.String(), "..") {
+			return filepath.ToSlash(rel.String())
+		}
+		return file
+	}
+	d.File = relativize(d.GetFile())
+	for _, c := range d.GetContext() {
+		c.File = relativize(c.GetFile())
+	}
+	for _, f := range d.GetFixIts() {
+		f.File = relativize(f.GetFile())
+	}
+	for _, n := range d.GetNotes() {
+		relativizeDiagnostic(n, sketchDir)
+	}
+}
@@ -0,0 +1,76 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateVirtualSketch(t *testing.T) {
+	scratchDir, sketchDir, err := createVirtualSketch(&rpc.VirtualSketch{
+		Files: map[string][]byte{
+			"Blink.ino":       []byte("void setup() {}\nvoid loop() {}\n"),
+			"other.h":         []byte("#define OTHER 1\n"),
+			"src/lib/lib.cpp": []byte("int lib() { return 1; }\n"),
+			"data/image.bin":  {0xff, 0x00, 0xfe},
+		},
+	})
+	require.NoError(t, err)
+	defer scratchDir.RemoveAll()
+	require.Equal(t, "Blink", sketchDir.Base())
+	sk, err := sketch.New(sketchDir)
+	require.NoError(t, err)
+	require.Equal(t, "Blink", sk.Name)
+	data, err := sketchDir.Join("src", "lib", "lib.cpp").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "int lib() { return 1; }\n", string(data))
+	// The files are not required to be valid UTF-8
+	data, err = sketchDir.Join("data", "image.bin").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, []byte{0xff, 0x00, 0xfe}, data)
+
+	d := &rpc.CompileDiagnostic{
+		File:    sketchDir.Join("Blink.ino").String(),
+		Context: []*rpc.CompileDiagnosticContext{{File: sketchDir.Join("src", "lib", "lib.cpp").String()}},
+		Notes:   []*rpc.CompileDiagnostic{{File: "/usr/include/stdio.h"}},
+	}
+	relativizeDiagnostic(d, sketchDir)
+	require.Equal(t, "Blink.ino", d.File)
+	require.Equal(t, "src/lib/lib.cpp", d.Context[0].File)
+	require.Equal(t, "/usr/include/stdio.h", d.Notes[0].File)
+
+	// The name is required when there are more .ino files
+	_, _, err = createVirtualSketch(&rpc.VirtualSketch{Files: map[string][]byte{"A.ino": nil, "B.ino": nil}})
+	require.Error(t, err)
+	scratchDir2, sketchDir2, err := createVirtualSketch(&rpc.VirtualSketch{Name: "B", Files: map[string][]byte{"A.ino": nil, "B.ino": nil}})
+	require.NoError(t, err)
+	defer scratchDir2.RemoveAll()
+	require.Equal(t, "B", sketchDir2.Base())
+
+	// The main file must be present
+	_, _, err = createVirtualSketch(&rpc.VirtualSketch{Name: "C", Files: map[string][]byte{"A.ino": nil}})
+	require.Error(t, err)
+
+	// The files must be inside the sketch
+	for _, name := range []string{"../A.ino", "/A.ino", "src/../../A.h", ""} {
+		_, _, err = createVirtualSketch(&rpc.VirtualSketch{Name: "A", Files: map[string][]byte{"A.ino": nil, name: nil}})
+		require.Error(t, err, name)
+	}
+}
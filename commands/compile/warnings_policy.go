@@ -0,0 +1,60 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// warningsLevels are the warning levels defined by the platforms with the
+// compiler.warning_flags.* properties
+var warningsLevels = map[string]bool{"none": true, "default": true, "more": true, "all": true}
+
+// warningsPolicy returns the warning levels of the sketch, the libraries and
+// the platform: the levels set by the request override the ones of the sketch
+// project file.
+func warningsPolicy(sk *sketch.Sketch, req *rpc.WarningsPolicy) (types.WarningsPolicy, error) {
+	res := types.WarningsPolicy{}
+	if sk.Project != nil && sk.Project.WarningsPolicy != nil {
+		p := sk.Project.WarningsPolicy
+		res = types.WarningsPolicy{
+			Sketch:                 p.Sketch,
+			Libraries:              p.Libraries,
+			Platform:               p.Platform,
+			SketchWarningsAsErrors: p.SketchWarningsAsErrors,
+		}
+	}
+	if req.GetSketch() != "" {
+		res.Sketch = req.GetSketch()
+	}
+	if req.GetLibraries() != "" {
+		res.Libraries = req.GetLibraries()
+	}
+	if req.GetPlatform() != "" {
+		res.Platform = req.GetPlatform()
+	}
+	res.SketchWarningsAsErrors = res.SketchWarningsAsErrors || req.GetSketchWarningsAsErrors()
+
+	for _, level := range []string{res.Sketch, res.Libraries, res.Platform} {
+		if level != "" && !warningsLevels[level] {
+			return res, &arduino.InvalidArgumentError{Message: tr("Invalid warning level %s in the warnings policy", level)}
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,45 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarningsPolicy(t *testing.T) {
+	sk := &sketch.Sketch{}
+	policy, err := warningsPolicy(sk, nil)
+	require.NoError(t, err)
+	require.True(t, policy.IsEmpty())
+
+	sk.Project = &sketch.Project{WarningsPolicy: &sketch.WarningsPolicy{Sketch: "all", SketchWarningsAsErrors: true, Platform: "none"}}
+	policy, err = warningsPolicy(sk, nil)
+	require.NoError(t, err)
+	require.Equal(t, types.WarningsPolicy{Sketch: "all", SketchWarningsAsErrors: true, Platform: "none"}, policy)
+
+	// The request overrides the project file
+	policy, err = warningsPolicy(sk, &rpc.WarningsPolicy{Sketch: "more", Libraries: "default"})
+	require.NoError(t, err)
+	require.Equal(t, types.WarningsPolicy{Sketch: "more", Libraries: "default", SketchWarningsAsErrors: true, Platform: "none"}, policy)
+
+	_, err = warningsPolicy(sk, &rpc.WarningsPolicy{Platform: "loud"})
+	require.Error(t, err)
+}
@@ -0,0 +1,310 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/globals"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/commands/upload"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultWatchDebounce is the time waited after the last change of a watched
+// file before starting a new build.
+const DefaultWatchDebounce = 300 * time.Millisecond
+
+// Watch compiles the sketch and compiles it again every time one of the watched
+// files changes, until ctx is canceled. The watched locations are the sketch, the
+// libraries passed explicitly to the build and the used libraries installed in
+// the user's sketchbook. If an upload request is given, the sketch is uploaded
+// after every successful build.
+// buildStartedCB and buildCompletedCB are called respectively before and after
+// every build, if diagnosticCB is not nil it's called for every diagnostic
+// produced by the compiler.
+func Watch(ctx context.Context, req *rpc.CompileWatchRequest, outStream, errStream io.Writer,
+	diagnosticCB func(*rpc.CompileDiagnostic),
+	buildStartedCB func(*rpc.CompileWatchBuildStarted),
+	buildCompletedCB func(*rpc.CompileWatchBuildCompleted),
+	debug bool) error {
+
+	compileReq := req.GetCompile()
+	if compileReq == nil {
+		return &arduino.InvalidArgumentError{Message: tr("Missing compile request")}
+	}
+	if compileReq.GetSketchPath() == "" {
+		return &arduino.MissingSketchPathError{}
+	}
+	sk, err := sketch.New(paths.New(compileReq.GetSketchPath()))
+	if err != nil {
+		return &arduino.CantOpenSketchError{Cause: err}
+	}
+	debounce := time.Duration(req.GetDebounceMs()) * time.Millisecond
+	if debounce <= 0 {
+		debounce = DefaultWatchDebounce
+	}
+
+	w, err := newSketchWatcher()
+	if err != nil {
+		return &arduino.UnavailableError{Message: tr("Cannot watch sketch files"), Cause: err}
+	}
+	defer w.Close()
+
+	// The build outputs must not trigger a new build
+	ignored := paths.PathList{sk.FullPath.Join("build"), sk.BuildPath}
+	if buildPath := compileReq.GetBuildPath(); buildPath != "" {
+		ignored.Add(paths.New(buildPath))
+	}
+	if exportDir := compileReq.GetExportDir(); exportDir != "" {
+		ignored.Add(paths.New(exportDir))
+	}
+
+	// Until the libraries used by the sketch are known, watch the sketch and
+	// the libraries explicitly passed to the build
+	watched := paths.PathList{sk.FullPath}
+	watched.AddAll(paths.NewPathList(compileReq.GetLibrary()...))
+
+	changedFiles := []string{}
+	for {
+		if buildStartedCB != nil {
+			buildStartedCB(&rpc.CompileWatchBuildStarted{ChangedFiles: changedFiles})
+		}
+		completed := &rpc.CompileWatchBuildCompleted{}
+		res, builderCtx, err := compile(ctx, compileReq, outStream, errStream, diagnosticCB, debug, nil)
+		if err != nil {
+			completed.Error = err.Error()
+		} else {
+			completed.Result = res
+			if uploadReq := req.GetUpload(); uploadReq != nil {
+				uploadReq = proto.Clone(uploadReq).(*rpc.UploadRequest)
+				if uploadReq.GetImportDir() == "" && uploadReq.GetImportFile() == "" {
+					uploadReq.ImportDir = res.GetBuildPath()
+				}
+				if _, err := upload.Upload(ctx, uploadReq, outStream, errStream); err != nil {
+					completed.UploadError = err.Error()
+				} else {
+					completed.Uploaded = true
+				}
+			}
+		}
+
+		if ctx.Err() != nil {
+			// Canceled during the build or the upload
+			return nil
+		}
+
+		// If the build failed before detecting the libraries keep the previous locations
+		if builderCtx != nil && len(builderCtx.WatchedLocations) > 0 {
+			watched = builderCtx.WatchedLocations
+		}
+		w.SetLocations(watched, ignored)
+		completed.WatchedLocations = watched.AsStrings()
+		if buildCompletedCB != nil {
+			buildCompletedCB(completed)
+		}
+
+		changedFiles, err = w.WaitForChanges(ctx, debounce, func(err error) {
+			fmt.Fprintln(errStream, tr("Error watching files: %v", err))
+		})
+		if err != nil {
+			// Canceled
+			return nil
+		}
+	}
+}
+
+// sketchWatcher watches recursively a set of folders for changes to the
+// files that are part of a build.
+type sketchWatcher struct {
+	watcher *fsnotify.Watcher
+	// The folders being watched
+	watched map[string]bool
+	ignored paths.PathList
+}
+
+func newSketchWatcher() (*sketchWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	return &sketchWatcher{
+		watcher: watcher,
+		watched: map[string]bool{},
+	}, nil
+}
+
+// Close stops watching all the locations
+func (w *sketchWatcher) Close() error {
+	return w.watcher.Close()
+}
+
+// SetLocations changes the watched locations to the given folders and all their
+// subfolders, except the hidden ones and the ones in ignored.
+func (w *sketchWatcher) SetLocations(locations paths.PathList, ignored paths.PathList) {
+	w.ignored = ignored
+	wanted := map[string]bool{}
+	for _, location := range locations {
+		filepath.Walk(location.String(), func(path string, info os.FileInfo, err error) error {
+			if err != nil || !info.IsDir() {
+				return nil
+			}
+			if path != location.String() && w.isIgnored(path) {
+				return filepath.SkipDir
+			}
+			wanted[path] = true
+			return nil
+		})
+	}
+	for dir := range w.watched {
+		if !wanted[dir] {
+			w.watcher.Remove(dir)
+			delete(w.watched, dir)
+		}
+	}
+	for dir := range wanted {
+		if !w.watched[dir] {
+			w.add(dir)
+		}
+	}
+}
+
+func (w *sketchWatcher) add(dir string) {
+	if err := w.watcher.Add(dir); err != nil {
+		logrus.WithError(err).WithField("dir", dir).Warn("Cannot watch folder")
+		return
+	}
+	w.watched[dir] = true
+}
+
+// addRecursive watches a newly created folder with all its subfolders. The
+// relevant files already present in the folder are returned, since they may
+// have been created before the folder was watched.
+func (w *sketchWatcher) addRecursive(dir string) []string {
+	files := []string{}
+	filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		if !info.IsDir() {
+			if w.isRelevant(path) {
+				files = append(files, path)
+			}
+			return nil
+		}
+		if w.isIgnored(path) {
+			return filepath.SkipDir
+		}
+		if !w.watched[path] {
+			w.add(path)
+		}
+		return nil
+	})
+	return files
+}
+
+// isIgnored returns true if the path is hidden or inside an ignored folder
+func (w *sketchWatcher) isIgnored(path string) bool {
+	if strings.HasPrefix(filepath.Base(path), ".") {
+		return true
+	}
+	p := paths.New(path)
+	for _, ignored := range w.ignored {
+		if p.EquivalentTo(ignored) {
+			return true
+		}
+		if inside, err := p.IsInsideDir(ignored); err == nil && inside {
+			return true
+		}
+	}
+	return false
+}
+
+// isRelevant returns true if a change to the given file may affect the build
+func (w *sketchWatcher) isRelevant(path string) bool {
+	if w.isIgnored(path) {
+		return false
+	}
+	ext := filepath.Ext(path)
+	if _, ok := globals.MainFileValidExtensions[ext]; ok {
+		return true
+	}
+	if _, ok := globals.AdditionalFileValidExtensions[ext]; ok {
+		return true
+	}
+	// library.properties and precompiled libraries
+	return ext == ".properties" || ext == ".a"
+}
+
+// WaitForChanges blocks until some of the watched files are changed and returns
+// the list of the changed files. A burst of changes is returned as a single
+// list, waiting for debounce time after the last change. If ctx is canceled an
+// error is returned.
+func (w *sketchWatcher) WaitForChanges(ctx context.Context, debounce time.Duration, errorCB func(error)) ([]string, error) {
+	changed := []string{}
+	seen := map[string]bool{}
+	addChanged := func(file string) {
+		if !seen[file] {
+			seen[file] = true
+			changed = append(changed, file)
+		}
+	}
+	var timeout <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return nil, errors.New(tr("file watcher closed"))
+			}
+			if event.Op&fsnotify.Create != 0 {
+				if info, err := os.Stat(event.Name); err == nil && info.IsDir() && !w.isIgnored(event.Name) {
+					for _, file := range w.addRecursive(event.Name) {
+						addChanged(file)
+						timeout = time.After(debounce)
+					}
+				}
+			}
+			if event.Op&(fsnotify.Remove|fsnotify.Rename) != 0 && w.watched[event.Name] {
+				// A watched folder has been removed or renamed
+				delete(w.watched, event.Name)
+			} else if event.Op == fsnotify.Chmod || !w.isRelevant(event.Name) {
+				continue
+			}
+			addChanged(event.Name)
+			timeout = time.After(debounce)
+		case err, ok := <-w.watcher.Errors:
+			if ok && errorCB != nil {
+				errorCB(err)
+			}
+		case <-timeout:
+			return changed, nil
+		}
+	}
+}
@@ -0,0 +1,80 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSketchWatcher(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "sketch_watcher_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	tmp = tmp.Canonical()
+
+	sketchDir := tmp.Join("Sketch")
+	buildDir := sketchDir.Join("build")
+	require.NoError(t, buildDir.MkdirAll())
+	require.NoError(t, sketchDir.Join(".git").MkdirAll())
+	mainFile := sketchDir.Join("Sketch.ino")
+	require.NoError(t, mainFile.WriteFile([]byte("void setup() {}\nvoid loop() {}\n")))
+
+	w, err := newSketchWatcher()
+	require.NoError(t, err)
+	defer w.Close()
+	w.SetLocations(paths.PathList{sketchDir}, paths.PathList{buildDir})
+	require.True(t, w.watched[sketchDir.String()])
+	require.False(t, w.watched[buildDir.String()])
+	require.False(t, w.watched[sketchDir.Join(".git").String()])
+
+	waitForChanges := func(timeout time.Duration) []string {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		changed, _ := w.WaitForChanges(ctx, 50*time.Millisecond, nil)
+		return changed
+	}
+
+	// A burst of changes is reported once
+	require.NoError(t, mainFile.WriteFile([]byte("void setup() {}\n")))
+	require.NoError(t, mainFile.WriteFile([]byte("void setup() {}\nvoid loop() {}\n")))
+	require.NoError(t, sketchDir.Join("other.h").WriteFile([]byte("")))
+	require.Equal(t, []string{mainFile.String(), sketchDir.Join("other.h").String()}, waitForChanges(5*time.Second))
+
+	// Changes to the build outputs, hidden and non-source files are ignored
+	require.NoError(t, buildDir.Join("Sketch.ino.cpp").WriteFile([]byte("")))
+	require.NoError(t, sketchDir.Join(".git", "index.h").WriteFile([]byte("")))
+	require.NoError(t, sketchDir.Join("notes.txt").WriteFile([]byte("")))
+	require.Nil(t, waitForChanges(500*time.Millisecond))
+
+	// New folders are watched too, including the files created before watching them
+	srcDir := sketchDir.Join("src")
+	require.NoError(t, srcDir.MkdirAll())
+	require.NoError(t, srcDir.Join("helper.cpp").WriteFile([]byte("")))
+	require.Equal(t, []string{srcDir.Join("helper.cpp").String()}, waitForChanges(5*time.Second))
+	require.True(t, w.watched[srcDir.String()])
+	require.NoError(t, srcDir.Join("helper.h").WriteFile([]byte("")))
+	require.Equal(t, []string{srcDir.Join("helper.h").String()}, waitForChanges(5*time.Second))
+
+	// Folders not in the locations anymore are not watched
+	w.SetLocations(paths.PathList{srcDir}, paths.PathList{buildDir})
+	require.False(t, w.watched[sketchDir.String()])
+	require.True(t, w.watched[srcDir.String()])
+}
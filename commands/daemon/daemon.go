@@ -27,9 +27,11 @@ import (
 	"github.com/arduino/arduino-cli/commands/board"
 	"github.com/arduino/arduino-cli/commands/compile"
 	"github.com/arduino/arduino-cli/commands/core"
+	"github.com/arduino/arduino-cli/commands/image"
 	"github.com/arduino/arduino-cli/commands/lib"
 	"github.com/arduino/arduino-cli/commands/monitor"
 	"github.com/arduino/arduino-cli/commands/sketch"
+	"github.com/arduino/arduino-cli/commands/test"
 	"github.com/arduino/arduino-cli/commands/upload"
 	"github.com/arduino/arduino-cli/i18n"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
@@ -262,6 +264,55 @@ func (s *ArduinoCoreServerImpl) Compile(req *rpc.CompileRequest, stream rpc.Ardu
 		stream.Context(), req,
 		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.CompileResponse{OutStream: data}) }),
 		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.CompileResponse{ErrStream: data}) }),
+		func(d *rpc.CompileDiagnostic) { stream.Send(&rpc.CompileResponse{Diagnostics: []*rpc.CompileDiagnostic{d}}) },
+		false) // Set debug to false
+	// When the size budget is exceeded the build is complete, the response
+	// is sent anyway since it tells how much the memory used grew
+	if _, budgetExceeded := err.(*arduino.SizeBudgetExceededError); err != nil && !budgetExceeded {
+		return convertErrorToRPCStatus(err)
+	}
+	// Diagnostics have been already streamed
+	resp.Diagnostics = nil
+	if sendErr := stream.Send(resp); sendErr != nil {
+		return sendErr
+	}
+	return convertErrorToRPCStatus(err)
+}
+
+// CompileWatch FIXMEDOC
+func (s *ArduinoCoreServerImpl) CompileWatch(req *rpc.CompileWatchRequest, stream rpc.ArduinoCoreService_CompileWatchServer) error {
+	err := compile.Watch(
+		stream.Context(), req,
+		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.CompileWatchResponse{OutStream: data}) }),
+		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.CompileWatchResponse{ErrStream: data}) }),
+		func(d *rpc.CompileDiagnostic) { stream.Send(&rpc.CompileWatchResponse{Diagnostic: d}) },
+		func(b *rpc.CompileWatchBuildStarted) { stream.Send(&rpc.CompileWatchResponse{BuildStarted: b}) },
+		func(b *rpc.CompileWatchBuildCompleted) {
+			// Diagnostics have been already streamed
+			if b.Result != nil {
+				b.Result.Diagnostics = nil
+			}
+			stream.Send(&rpc.CompileWatchResponse{BuildCompleted: b})
+		},
+		false) // Set debug to false
+	if err != nil {
+		return convertErrorToRPCStatus(err)
+	}
+	return nil
+}
+
+// MultiCompile FIXMEDOC
+func (s *ArduinoCoreServerImpl) MultiCompile(ctx context.Context, req *rpc.MultiCompileRequest) (*rpc.MultiCompileResponse, error) {
+	resp, err := compile.MultiCompile(ctx, req, false) // Set debug to false
+	return resp, convertErrorToRPCStatus(err)
+}
+
+// ExportSketch FIXMEDOC
+func (s *ArduinoCoreServerImpl) ExportSketch(req *rpc.ExportSketchRequest, stream rpc.ArduinoCoreService_ExportSketchServer) error {
+	resp, err := compile.ExportSketch(
+		stream.Context(), req,
+		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.ExportSketchResponse{OutStream: data}) }),
+		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.ExportSketchResponse{ErrStream: data}) }),
 		false) // Set debug to false
 	if err != nil {
 		return convertErrorToRPCStatus(err)
@@ -269,6 +320,19 @@ func (s *ArduinoCoreServerImpl) Compile(req *rpc.CompileRequest, stream rpc.Ardu
 	return stream.Send(resp)
 }
 
+// Test FIXMEDOC
+func (s *ArduinoCoreServerImpl) Test(req *rpc.TestRequest, stream rpc.ArduinoCoreService_TestServer) error {
+	resp, err := test.Test(
+		stream.Context(), req,
+		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.TestResponse{OutStream: data}) }),
+		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.TestResponse{ErrStream: data}) }),
+	)
+	if err != nil {
+		return convertErrorToRPCStatus(err)
+	}
+	return stream.Send(resp)
+}
+
 // PlatformInstall FIXMEDOC
 func (s *ArduinoCoreServerImpl) PlatformInstall(req *rpc.PlatformInstallRequest, stream rpc.ArduinoCoreService_PlatformInstallServer) error {
 	resp, err := core.PlatformInstall(
@@ -347,6 +411,12 @@ func (s *ArduinoCoreServerImpl) Upload(req *rpc.UploadRequest, stream rpc.Arduin
 	return stream.Send(resp)
 }
 
+// MultiUpload FIXMEDOC
+func (s *ArduinoCoreServerImpl) MultiUpload(req *rpc.MultiUploadRequest, stream rpc.ArduinoCoreService_MultiUploadServer) error {
+	err := upload.MultiUpload(stream.Context(), req, func(resp *rpc.MultiUploadResponse) { stream.Send(resp) })
+	return convertErrorToRPCStatus(err)
+}
+
 // UploadUsingProgrammer FIXMEDOC
 func (s *ArduinoCoreServerImpl) UploadUsingProgrammer(req *rpc.UploadUsingProgrammerRequest, stream rpc.ArduinoCoreService_UploadUsingProgrammerServer) error {
 	resp, err := upload.UsingProgrammer(
@@ -360,6 +430,12 @@ func (s *ArduinoCoreServerImpl) UploadUsingProgrammer(req *rpc.UploadUsingProgra
 	return stream.Send(resp)
 }
 
+// UploadPlan FIXMEDOC
+func (s *ArduinoCoreServerImpl) UploadPlan(ctx context.Context, req *rpc.UploadPlanRequest) (*rpc.UploadPlanResponse, error) {
+	res, err := upload.UploadPlan(ctx, req)
+	return res, convertErrorToRPCStatus(err)
+}
+
 // SupportedUserFields FIXMEDOC
 func (s *ArduinoCoreServerImpl) SupportedUserFields(ctx context.Context, req *rpc.SupportedUserFieldsRequest) (*rpc.SupportedUserFieldsResponse, error) {
 	res, err := upload.SupportedUserFields(ctx, req)
@@ -451,6 +527,31 @@ func (s *ArduinoCoreServerImpl) LibraryList(ctx context.Context, req *rpc.Librar
 	return resp, convertErrorToRPCStatus(err)
 }
 
+// LibraryResolve FIXMEDOC
+func (s *ArduinoCoreServerImpl) LibraryResolve(ctx context.Context, req *rpc.LibraryResolveRequest) (*rpc.LibraryResolveResponse, error) {
+	resp, err := lib.LibraryResolve(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
+// LibraryPrecompile FIXMEDOC
+func (s *ArduinoCoreServerImpl) LibraryPrecompile(req *rpc.LibraryPrecompileRequest, stream rpc.ArduinoCoreService_LibraryPrecompileServer) error {
+	resp, err := lib.LibraryPrecompile(
+		stream.Context(), req,
+		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.LibraryPrecompileResponse{OutStream: data}) }),
+		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.LibraryPrecompileResponse{ErrStream: data}) }),
+	)
+	if err != nil {
+		return convertErrorToRPCStatus(err)
+	}
+	return stream.Send(resp)
+}
+
+// ImageMerge FIXMEDOC
+func (s *ArduinoCoreServerImpl) ImageMerge(ctx context.Context, req *rpc.ImageMergeRequest) (*rpc.ImageMergeResponse, error) {
+	resp, err := image.ImageMerge(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
 // ArchiveSketch FIXMEDOC
 func (s *ArduinoCoreServerImpl) ArchiveSketch(ctx context.Context, req *rpc.ArchiveSketchRequest) (*rpc.ArchiveSketchResponse, error) {
 	resp, err := sketch.ArchiveSketch(ctx, req)
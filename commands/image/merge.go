@@ -0,0 +1,115 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package image
+
+import (
+	"context"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/firmware"
+	"github.com/arduino/arduino-cli/i18n"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+var tr = i18n.Tr
+
+// ImageMerge merges the firmware images in a single image, a single image is
+// converted to the output format
+func ImageMerge(ctx context.Context, req *rpc.ImageMergeRequest) (*rpc.ImageMergeResponse, error) {
+	if len(req.GetInputs()) == 0 {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Missing images to merge")}
+	}
+	if req.GetOutputPath() == "" {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Missing output path")}
+	}
+	outputPath := paths.New(req.GetOutputPath())
+	outputFormat := req.GetOutputFormat()
+	if outputFormat == "" {
+		format, err := firmware.FormatFromPath(outputPath)
+		if err != nil {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Invalid output format"), Cause: err}
+		}
+		outputFormat = format
+	} else if err := firmware.CheckFormat(outputFormat); err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid output format"), Cause: err}
+	}
+
+	img := firmware.New()
+	for _, input := range req.GetInputs() {
+		inputPath := paths.New(input.GetPath())
+		if inputPath == nil || !inputPath.Exist() {
+			return nil, &arduino.NotFoundError{Message: tr("Image %s not found", input.GetPath())}
+		}
+		format := input.GetFormat()
+		if format == "" {
+			var err error
+			if format, err = firmware.FormatFromPath(inputPath); err != nil {
+				return nil, &arduino.InvalidArgumentError{Message: tr("Invalid image format"), Cause: err}
+			}
+		} else if err := firmware.CheckFormat(format); err != nil {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Invalid image format"), Cause: err}
+		}
+
+		// The data of a binary is placed at the offset, the addresses of the
+		// other formats are moved by the offset
+		offset := input.GetOffset()
+		baseAddress := uint32(0)
+		if format == firmware.FormatBin {
+			baseAddress, offset = offset, 0
+		}
+		inputImg, err := firmware.Load(inputPath, format, baseAddress)
+		if err != nil {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Error reading image %s", inputPath), Cause: err}
+		}
+		if err := img.Merge(inputImg, offset); err != nil {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Error merging image %s", inputPath), Cause: err}
+		}
+	}
+
+	opts := &firmware.EncodeOptions{FillByte: firmware.DefaultFillByte}
+	if fillByte := req.GetFillByte(); fillByte != nil {
+		if fillByte.GetValue() > 0xFF {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Invalid fill byte: 0x%X", fillByte.GetValue())}
+		}
+		opts.FillByte = byte(fillByte.GetValue())
+	}
+	if baseAddress := req.GetBaseAddress(); baseAddress != nil {
+		value := baseAddress.GetValue()
+		opts.BaseAddress = &value
+	}
+	if familyID := req.GetFamilyId(); familyID != nil {
+		value := familyID.GetValue()
+		opts.FamilyID = &value
+	}
+	data, err := img.Encode(outputFormat, opts)
+	if err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Error encoding image"), Cause: err}
+	}
+	if err := outputPath.WriteFile(data); err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Error writing image %s", outputPath), Cause: err}
+	}
+
+	res := &rpc.ImageMergeResponse{
+		OutputPath:   outputPath.String(),
+		OutputFormat: outputFormat,
+	}
+	if start, end, ok := img.Bounds(); ok {
+		res.StartAddress = start
+		res.EndAddress = end
+	}
+	return res, nil
+}
@@ -0,0 +1,88 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package image
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestImageMerge(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "image")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	hex := tmp.Join("app.hex")
+	require.NoError(t, hex.WriteFile([]byte(":0400100001020304E2\n:00000001FF\n")))
+	bin := tmp.Join("data.bin")
+	require.NoError(t, bin.WriteFile([]byte{5, 6}))
+	output := tmp.Join("merged.bin")
+
+	res, err := ImageMerge(context.Background(), &rpc.ImageMergeRequest{
+		Inputs: []*rpc.ImageInput{
+			{Path: hex.String(), Offset: 0x1000},
+			{Path: bin.String(), Offset: 0x1016},
+		},
+		OutputPath:  output.String(),
+		FillByte:    wrapperspb.UInt32(0),
+		BaseAddress: wrapperspb.UInt32(0x1000),
+	})
+	require.NoError(t, err)
+	require.Equal(t, output.String(), res.GetOutputPath())
+	require.Equal(t, "bin", res.GetOutputFormat())
+	require.Equal(t, uint32(0x1010), res.GetStartAddress())
+	require.Equal(t, uint32(0x1018), res.GetEndAddress())
+	data, err := output.ReadFile()
+	require.NoError(t, err)
+	expected := make([]byte, 0x18)
+	copy(expected[0x10:], []byte{1, 2, 3, 4, 0, 0, 5, 6})
+	require.Equal(t, expected, data)
+
+	// Overlapping images
+	_, err = ImageMerge(context.Background(), &rpc.ImageMergeRequest{
+		Inputs:     []*rpc.ImageInput{{Path: hex.String()}, {Path: bin.String(), Offset: 0x12}},
+		OutputPath: output.String(),
+	})
+	require.IsType(t, &arduino.InvalidArgumentError{}, err)
+
+	// Missing image
+	_, err = ImageMerge(context.Background(), &rpc.ImageMergeRequest{
+		Inputs:     []*rpc.ImageInput{{Path: tmp.Join("missing.hex").String()}},
+		OutputPath: output.String(),
+	})
+	require.IsType(t, &arduino.NotFoundError{}, err)
+
+	// Unknown output format
+	_, err = ImageMerge(context.Background(), &rpc.ImageMergeRequest{
+		Inputs:     []*rpc.ImageInput{{Path: hex.String()}},
+		OutputPath: tmp.Join("merged.elf").String(),
+	})
+	require.IsType(t, &arduino.InvalidArgumentError{}, err)
+
+	// Invalid fill byte
+	_, err = ImageMerge(context.Background(), &rpc.ImageMergeRequest{
+		Inputs:     []*rpc.ImageInput{{Path: hex.String()}},
+		OutputPath: output.String(),
+		FillByte:   wrapperspb.UInt32(0x100),
+	})
+	require.IsType(t, &arduino.InvalidArgumentError{}, err)
+}
@@ -442,103 +442,113 @@ func UpdateIndex(ctx context.Context, req *rpc.UpdateIndexRequest, downloadCB Do
 			continue
 		}
 
-		logrus.WithField("url", URL).Print("Updating index")
-
-		if URL.Scheme == "file" {
-			path := paths.New(URL.Path)
-			if _, err := packageindex.LoadIndexNoSign(path); err != nil {
-				return nil, &arduino.InvalidArgumentError{Message: tr("Invalid package index in %s", path), Cause: err}
-			}
-
-			fi, _ := os.Stat(path.String())
-			downloadCB(&rpc.DownloadProgress{
-				File:      tr("Updating index: %s", path.Base()),
-				TotalSize: fi.Size(),
-			})
-			downloadCB(&rpc.DownloadProgress{Completed: true})
-			continue
+		if err := updatePackageIndex(URL, indexpath, downloadCB); err != nil {
+			return nil, err
 		}
+	}
 
-		var tmp *paths.Path
-		if tmpFile, err := ioutil.TempFile("", ""); err != nil {
-			return nil, &arduino.TempFileCreationFailedError{Cause: err}
-		} else if err := tmpFile.Close(); err != nil {
-			return nil, &arduino.TempFileCreationFailedError{Cause: err}
-		} else {
-			tmp = paths.New(tmpFile.Name())
-		}
-		defer tmp.Remove()
+	return &rpc.UpdateIndexResponse{}, nil
+}
 
-		config, err := GetDownloaderConfig()
-		if err != nil {
-			return nil, &arduino.FailedDownloadError{Message: tr("Error downloading index '%s'", URL), Cause: err}
-		}
-		d, err := downloader.DownloadWithConfig(tmp.String(), URL.String(), *config)
-		if err != nil {
-			return nil, &arduino.FailedDownloadError{Message: tr("Error downloading index '%s'", URL), Cause: err}
-		}
-		coreIndexPath := indexpath.Join(path.Base(URL.Path))
-		err = Download(d, tr("Updating index: %s", coreIndexPath.Base()), downloadCB)
-		if err != nil {
-			return nil, &arduino.FailedDownloadError{Message: tr("Error downloading index '%s'", URL), Cause: err}
+// updatePackageIndex downloads the package index at the given URL, and its signature
+// if available, in the indexpath directory. Indexes with a "file" scheme are only
+// checked for validity.
+func updatePackageIndex(URL *url.URL, indexpath *paths.Path, downloadCB DownloadProgressCB) error {
+	logrus.WithField("url", URL).Print("Updating index")
+
+	if URL.Scheme == "file" {
+		path := paths.New(URL.Path)
+		if _, err := packageindex.LoadIndexNoSign(path); err != nil {
+			return &arduino.InvalidArgumentError{Message: tr("Invalid package index in %s", path), Cause: err}
 		}
 
-		// Check for signature
-		var tmpSig *paths.Path
-		var coreIndexSigPath *paths.Path
-		if URL.Hostname() == "downloads.arduino.cc" {
-			URLSig, err := url.Parse(URL.String())
-			if err != nil {
-				return nil, &arduino.InvalidURLError{Cause: err}
-			}
-			URLSig.Path += ".sig"
-
-			if t, err := ioutil.TempFile("", ""); err != nil {
-				return nil, &arduino.TempFileCreationFailedError{Cause: err}
-			} else if err := t.Close(); err != nil {
-				return nil, &arduino.TempFileCreationFailedError{Cause: err}
-			} else {
-				tmpSig = paths.New(t.Name())
-			}
-			defer tmpSig.Remove()
+		fi, _ := os.Stat(path.String())
+		downloadCB(&rpc.DownloadProgress{
+			File:      tr("Updating index: %s", path.Base()),
+			TotalSize: fi.Size(),
+		})
+		downloadCB(&rpc.DownloadProgress{Completed: true})
+		return nil
+	}
 
-			d, err := downloader.DownloadWithConfig(tmpSig.String(), URLSig.String(), *config)
-			if err != nil {
-				return nil, &arduino.FailedDownloadError{Message: tr("Error downloading index signature '%s'", URLSig), Cause: err}
-			}
+	var tmp *paths.Path
+	if tmpFile, err := ioutil.TempFile("", ""); err != nil {
+		return &arduino.TempFileCreationFailedError{Cause: err}
+	} else if err := tmpFile.Close(); err != nil {
+		return &arduino.TempFileCreationFailedError{Cause: err}
+	} else {
+		tmp = paths.New(tmpFile.Name())
+	}
+	defer tmp.Remove()
 
-			coreIndexSigPath = indexpath.Join(path.Base(URLSig.Path))
-			Download(d, tr("Updating index: %s", coreIndexSigPath.Base()), downloadCB)
-			if d.Error() != nil {
-				return nil, &arduino.FailedDownloadError{Message: tr("Error downloading index signature '%s'", URLSig), Cause: err}
-			}
+	config, err := GetDownloaderConfig()
+	if err != nil {
+		return &arduino.FailedDownloadError{Message: tr("Error downloading index '%s'", URL), Cause: err}
+	}
+	d, err := downloader.DownloadWithConfig(tmp.String(), URL.String(), *config)
+	if err != nil {
+		return &arduino.FailedDownloadError{Message: tr("Error downloading index '%s'", URL), Cause: err}
+	}
+	coreIndexPath := indexpath.Join(path.Base(URL.Path))
+	err = Download(d, tr("Updating index: %s", coreIndexPath.Base()), downloadCB)
+	if err != nil {
+		return &arduino.FailedDownloadError{Message: tr("Error downloading index '%s'", URL), Cause: err}
+	}
 
-			if valid, _, err := security.VerifyArduinoDetachedSignature(tmp, tmpSig); err != nil {
-				return nil, &arduino.PermissionDeniedError{Message: tr("Error verifying signature"), Cause: err}
-			} else if !valid {
-				return nil, &arduino.SignatureVerificationFailedError{File: URL.String()}
-			}
+	// Check for signature
+	var tmpSig *paths.Path
+	var coreIndexSigPath *paths.Path
+	if URL.Hostname() == "downloads.arduino.cc" {
+		URLSig, err := url.Parse(URL.String())
+		if err != nil {
+			return &arduino.InvalidURLError{Cause: err}
 		}
+		URLSig.Path += ".sig"
 
-		if _, err := packageindex.LoadIndex(tmp); err != nil {
-			return nil, &arduino.InvalidArgumentError{Message: tr("Invalid package index in %s", URL), Cause: err}
+		if t, err := ioutil.TempFile("", ""); err != nil {
+			return &arduino.TempFileCreationFailedError{Cause: err}
+		} else if err := t.Close(); err != nil {
+			return &arduino.TempFileCreationFailedError{Cause: err}
+		} else {
+			tmpSig = paths.New(t.Name())
 		}
+		defer tmpSig.Remove()
 
-		if err := indexpath.MkdirAll(); err != nil {
-			return nil, &arduino.PermissionDeniedError{Message: tr("Can't create data directory %s", indexpath), Cause: err}
+		d, err := downloader.DownloadWithConfig(tmpSig.String(), URLSig.String(), *config)
+		if err != nil {
+			return &arduino.FailedDownloadError{Message: tr("Error downloading index signature '%s'", URLSig), Cause: err}
 		}
 
-		if err := tmp.CopyTo(coreIndexPath); err != nil {
-			return nil, &arduino.PermissionDeniedError{Message: tr("Error saving downloaded index %s", URL), Cause: err}
+		coreIndexSigPath = indexpath.Join(path.Base(URLSig.Path))
+		Download(d, tr("Updating index: %s", coreIndexSigPath.Base()), downloadCB)
+		if d.Error() != nil {
+			return &arduino.FailedDownloadError{Message: tr("Error downloading index signature '%s'", URLSig), Cause: err}
 		}
-		if tmpSig != nil {
-			if err := tmpSig.CopyTo(coreIndexSigPath); err != nil {
-				return nil, &arduino.PermissionDeniedError{Message: tr("Error saving downloaded index signature"), Cause: err}
-			}
+
+		if valid, _, err := security.VerifyArduinoDetachedSignature(tmp, tmpSig); err != nil {
+			return &arduino.PermissionDeniedError{Message: tr("Error verifying signature"), Cause: err}
+		} else if !valid {
+			return &arduino.SignatureVerificationFailedError{File: URL.String()}
 		}
 	}
 
-	return &rpc.UpdateIndexResponse{}, nil
+	if _, err := packageindex.LoadIndex(tmp); err != nil {
+		return &arduino.InvalidArgumentError{Message: tr("Invalid package index in %s", URL), Cause: err}
+	}
+
+	if err := indexpath.MkdirAll(); err != nil {
+		return &arduino.PermissionDeniedError{Message: tr("Can't create data directory %s", indexpath), Cause: err}
+	}
+
+	if err := tmp.CopyTo(coreIndexPath); err != nil {
+		return &arduino.PermissionDeniedError{Message: tr("Error saving downloaded index %s", URL), Cause: err}
+	}
+	if tmpSig != nil {
+		if err := tmpSig.CopyTo(coreIndexSigPath); err != nil {
+			return &arduino.PermissionDeniedError{Message: tr("Error saving downloaded index signature"), Cause: err}
+		}
+	}
+	return nil
 }
 
 // UpdateCoreLibrariesIndex updates both Cores and Libraries indexes
@@ -0,0 +1,353 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/globals"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/utils"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/commands/compile"
+	"github.com/arduino/arduino-cli/legacy/builder/phases"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// LibraryPrecompile compiles a library for the given boards and packages it
+// as a precompiled library: the static library built for each board is put
+// in the folder of the library where the builder looks for it, named after
+// the mcu and the floating point configuration of the board, and
+// library.properties declares the library precompiled. The library is
+// compiled with the builder, as part of an empty sketch including its
+// headers, from a copy that is then written in a zip file.
+func LibraryPrecompile(ctx context.Context, req *rpc.LibraryPrecompileRequest, outStream, errStream io.Writer) (*rpc.LibraryPrecompileResponse, error) {
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	lm := commands.GetLibraryManager(req.GetInstance().GetId())
+	if lm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+
+	if len(req.GetFqbn()) == 0 {
+		return nil, &arduino.MissingFQBNError{}
+	}
+	var lib *libraries.Library
+	if libDir := paths.New(req.GetLibrary()); libDir != nil && libDir.IsDir() {
+		l, err := libraries.Load(libDir, libraries.Unmanaged)
+		if err != nil {
+			return nil, &arduino.InvalidLibraryError{Cause: err}
+		}
+		lib = l
+	} else if lib = lm.FindByReference(&librariesindex.Reference{Name: req.GetLibrary()}); lib == nil {
+		return nil, &arduino.LibraryNotFoundError{Library: req.GetLibrary()}
+	}
+	if lib.Layout != libraries.RecursiveLayout {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Library %s must have its sources in the src folder to be precompiled", lib.Name)}
+	}
+
+	outputPath, err := precompiledLibraryOutputPath(req.GetOutputPath(), lib)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := paths.MkTempDir("", "arduino-lib-precompile-")
+	if err != nil {
+		return nil, &arduino.TempDirCreationFailedError{Cause: err}
+	}
+	defer tmp.RemoveAll()
+
+	// The library is built from a copy of its files, where dot_a_linkage
+	// makes the builder archive its object files and precompiled is removed
+	// to compile the sources even if the library has been already precompiled
+	bundleDir := tmp.Join("bundle")
+	libDir := bundleDir.Join(lib.InstallDir.Base())
+	if err := copyLibraryFiles(lib.InstallDir, libDir); err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Error copying library files"), Cause: err}
+	}
+	libPropertiesPath := libDir.Join("library.properties")
+	libProperties, err := libPropertiesPath.ReadFile()
+	if err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Error reading library.properties"), Cause: err}
+	}
+	buildProperties := setLibraryProperty(setLibraryProperty(libProperties, "precompiled", ""), "dot_a_linkage", "true")
+	if err := libPropertiesPath.WriteFile(buildProperties); err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Error writing library.properties"), Cause: err}
+	}
+
+	sketchPath, err := createPrecompileSketch(tmp.Join("sketch", "Precompile"), lib)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &rpc.LibraryPrecompileResponse{}
+	archiveName := "lib" + utils.SanitizeName(lib.Name) + ".a"
+	done := map[string]string{}
+	for i, fqbnIn := range req.GetFqbn() {
+		fqbn, err := cores.ParseFQBN(fqbnIn)
+		if err != nil {
+			return nil, &arduino.InvalidFQBNError{Cause: err}
+		}
+		_, _, _, boardBuildProperties, _, err := pm.ResolveFQBN(fqbn)
+		if err != nil {
+			return nil, &arduino.UnknownFQBNError{Cause: err}
+		}
+		if !boardBuildProperties.ContainsKey("build.mcu") {
+			return nil, &arduino.MissingPlatformPropertyError{Property: "build.mcu"}
+		}
+		folder := phases.PrecompiledLibraryFolders(boardBuildProperties)[0]
+		if other, ok := done[folder]; ok {
+			fmt.Fprintln(outStream, tr("Skipping %[1]s: the library has been already compiled for %[2]s, in %[3]s", fqbnIn, other, folder))
+			continue
+		}
+
+		fmt.Fprintln(outStream, tr("Compiling library %[1]s for %[2]s", lib.Name, fqbnIn))
+		buildPath := tmp.Join("build", fmt.Sprint(i))
+		compileReq := &rpc.CompileRequest{
+			Instance:   req.GetInstance(),
+			Fqbn:       fqbnIn,
+			SketchPath: sketchPath.String(),
+			BuildPath:  buildPath.String(),
+			Library:    []string{libDir.String()},
+			Verbose:    req.GetVerbose(),
+		}
+		if _, err := compile.Compile(ctx, compileReq, outStream, errStream, nil, false); err != nil {
+			return nil, err
+		}
+
+		archive := buildPath.Join("libraries", lib.Name, lib.Name+".a")
+		if !archive.Exist() {
+			return nil, &arduino.NotFoundError{Message: tr("The library %[1]s has not been compiled for %[2]s", lib.Name, fqbnIn)}
+		}
+		binary := paths.New("src", folder, archiveName)
+		if err := libDir.Join(binary.String()).Parent().MkdirAll(); err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error copying the library binary"), Cause: err}
+		}
+		if err := archive.CopyTo(libDir.Join(binary.String())); err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error copying the library binary"), Cause: err}
+		}
+		done[folder] = fqbnIn
+		res.Binaries = append(res.Binaries, &rpc.LibraryPrecompiledBinary{Fqbn: fqbnIn, Path: filepath.ToSlash(binary.String())})
+	}
+
+	precompiled := "true"
+	if req.GetWithSources() {
+		precompiled = "full"
+	} else if err := removeLibrarySources(libDir.Join("src")); err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Error removing library sources"), Cause: err}
+	}
+	if err := libPropertiesPath.WriteFile(setLibraryProperty(libProperties, "precompiled", precompiled)); err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Error writing library.properties"), Cause: err}
+	}
+
+	if err := zipFolder(outputPath, bundleDir); err != nil {
+		outputPath.Remove()
+		return nil, &arduino.PermissionDeniedError{Message: tr("Error creating the library archive"), Cause: err}
+	}
+	res.OutputPath = outputPath.String()
+	return res, nil
+}
+
+// precompiledLibraryOutputPath returns the path of the zip file of the
+// precompiled library: outputPath may be a folder, where the file is named
+// after the library and its version, or the path of the file itself.
+func precompiledLibraryOutputPath(outputPath string, lib *libraries.Library) (*paths.Path, error) {
+	res := paths.New(outputPath)
+	if res == nil {
+		res = paths.New(".")
+	}
+	res, err := res.Clean().Abs()
+	if err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Error getting absolute path of library archive"), Cause: err}
+	}
+	if res.IsDir() {
+		name := lib.InstallDir.Base()
+		if lib.Version != nil && lib.Version.String() != "" {
+			name += "-" + lib.Version.String()
+		}
+		res = res.Join(name + "-precompiled.zip")
+	} else if res.Ext() == "" {
+		res = paths.New(res.String() + ".zip")
+	}
+	if res.Exist() {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Archive already exists")}
+	}
+	return res, nil
+}
+
+// copyLibraryFiles copies the files of a library, except the hidden ones
+// like the .git folder, that may contain the history of the sources.
+func copyLibraryFiles(src, dst *paths.Path) error {
+	files, err := src.ReadDir()
+	if err != nil {
+		return err
+	}
+	if err := dst.MkdirAll(); err != nil {
+		return err
+	}
+	for _, file := range files {
+		if strings.HasPrefix(file.Base(), ".") {
+			continue
+		}
+		if file.IsDir() {
+			err = file.CopyDirTo(dst.Join(file.Base()))
+		} else {
+			err = file.CopyTo(dst.Join(file.Base()))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createPrecompileSketch creates a sketch that includes the headers of the
+// library, so that the library is compiled by the build of the sketch.
+func createPrecompileSketch(sketchDir *paths.Path, lib *libraries.Library) (*paths.Path, error) {
+	headers := lib.DeclaredHeaders()
+	if len(headers) == 0 {
+		sourceHeaders, err := lib.SourceHeaders()
+		if err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error reading library headers"), Cause: err}
+		}
+		headers = sourceHeaders
+	}
+	if len(headers) == 0 {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Library %s doesn't have headers to include", lib.Name)}
+	}
+
+	source := ""
+	for _, header := range headers {
+		source += "#include <" + header + ">\n"
+	}
+	source += "\nvoid setup() {}\n\nvoid loop() {}\n"
+	if err := sketchDir.MkdirAll(); err != nil {
+		return nil, &arduino.TempDirCreationFailedError{Cause: err}
+	}
+	if err := sketchDir.Join(sketchDir.Base() + ".ino").WriteFile([]byte(source)); err != nil {
+		return nil, &arduino.TempFileCreationFailedError{Cause: err}
+	}
+	return sketchDir, nil
+}
+
+// removeLibrarySources removes the source files from the src folder of a
+// library, leaving the headers and the binaries.
+func removeLibrarySources(srcDir *paths.Path) error {
+	files, err := srcDir.ReadDirRecursive()
+	if err != nil {
+		return err
+	}
+	files.FilterOutDirs()
+	for _, file := range files {
+		if _, isSource := globals.SourceFilesValidExtensions[file.Ext()]; isSource {
+			if err := file.Remove(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// setLibraryProperty sets the value of a property in the content of a
+// library.properties file, keeping the other lines as they are. If value is
+// empty the property is removed.
+func setLibraryProperty(content []byte, key, value string) []byte {
+	lines := strings.SplitAfter(string(content), "\n")
+	res := ""
+	found := false
+	for _, line := range lines {
+		if k := strings.SplitN(line, "=", 2); len(k) == 2 && strings.TrimSpace(k[0]) == key {
+			if value != "" && !found {
+				res += key + "=" + value + "\n"
+			}
+			found = true
+			continue
+		}
+		res += line
+	}
+	if !found && value != "" {
+		if res != "" && !strings.HasSuffix(res, "\n") {
+			res += "\n"
+		}
+		res += key + "=" + value + "\n"
+	}
+	return []byte(res)
+}
+
+// zipFolder writes the files of a folder in a zip file.
+func zipFolder(zipPath, dir *paths.Path) (e error) {
+	files, err := dir.ReadDirRecursive()
+	if err != nil {
+		return err
+	}
+	files.FilterOutDirs()
+	files.Sort()
+
+	archive, err := zipPath.Create()
+	if err != nil {
+		return err
+	}
+	// An incomplete archive is removed, the data may be lost also when closing
+	// the file
+	defer func() {
+		if err := archive.Close(); err != nil && e == nil {
+			e = err
+		}
+		if e != nil {
+			zipPath.Remove()
+		}
+	}()
+	zipWriter := zip.NewWriter(archive)
+	for _, file := range files {
+		rel, err := dir.RelTo(file)
+		if err != nil {
+			return err
+		}
+		info, err := file.Stat()
+		if err != nil {
+			return err
+		}
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel.String())
+		header.Method = zip.Deflate
+		data, err := file.ReadFile()
+		if err != nil {
+			return err
+		}
+		w, err := zipWriter.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+	}
+	return zipWriter.Close()
+}
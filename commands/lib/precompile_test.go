@@ -0,0 +1,52 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
+)
+
+func TestSetLibraryProperty(t *testing.T) {
+	content := []byte("name=Foo\n# comment\nprecompiled=full\nversion=1.0.0")
+	require.Equal(t, "name=Foo\n# comment\nprecompiled=true\nversion=1.0.0", string(setLibraryProperty(content, "precompiled", "true")))
+	require.Equal(t, "name=Foo\n# comment\nversion=1.0.0", string(setLibraryProperty(content, "precompiled", "")))
+	require.Equal(t, "name=Foo\n# comment\nprecompiled=full\nversion=1.0.0\ndot_a_linkage=true\n", string(setLibraryProperty(content, "dot_a_linkage", "true")))
+	require.Equal(t, "name=Foo\n", string(setLibraryProperty([]byte("name=Foo\n"), "ldflags", "")))
+}
+
+func TestPrecompiledLibraryOutputPath(t *testing.T) {
+	dir, err := paths.MkTempDir("", "precompile-test")
+	require.NoError(t, err)
+	defer dir.RemoveAll()
+
+	lib := &libraries.Library{Name: "Foo Bar", InstallDir: paths.New("libs", "Foo_Bar"), Version: semver.MustParse("1.2.0")}
+	outputPath, err := precompiledLibraryOutputPath(dir.String(), lib)
+	require.NoError(t, err)
+	require.Equal(t, dir.Join("Foo_Bar-1.2.0-precompiled.zip").String(), outputPath.String())
+
+	outputPath, err = precompiledLibraryOutputPath(dir.Join("foo").String(), lib)
+	require.NoError(t, err)
+	require.Equal(t, dir.Join("foo.zip").String(), outputPath.String())
+
+	require.NoError(t, dir.Join("foo.zip").WriteFile([]byte{}))
+	_, err = precompiledLibraryOutputPath(dir.Join("foo.zip").String(), lib)
+	require.Error(t, err)
+}
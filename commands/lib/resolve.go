@@ -0,0 +1,62 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"context"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/commands/compile"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// LibraryResolve lists the libraries providing a header, with the priority
+// computed for each of them, and returns the one chosen by the build for the
+// given board. The libraries are loaded by the same steps of the build, with
+// the libraries and the profile given with the request.
+func LibraryResolve(ctx context.Context, req *rpc.LibraryResolveRequest) (*rpc.LibraryResolveResponse, error) {
+	header := req.GetHeader()
+	if header == "" {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Missing header")}
+	}
+	resolver, platform, err := compile.LibrariesResolver(&rpc.CompileRequest{
+		Instance:   req.GetInstance(),
+		Fqbn:       req.GetFqbn(),
+		SketchPath: req.GetSketchPath(),
+		Profile:    req.GetProfile(),
+		Libraries:  req.GetLibraries(),
+		Library:    req.GetLibrary(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resolution := resolver.Resolve(header, platform.Platform.Architecture)
+	if resolution == nil {
+		return nil, &arduino.NotFoundError{Message: tr("No library provides the header %s", header)}
+	}
+	res := &rpc.LibraryResolveResponse{
+		Selected:     resolution.Selected.ToRPC(),
+		Alternatives: []*rpc.LibraryCandidate{},
+		Reason:       resolution.Reason,
+		Override:     configuration.LibraryHeaderOverrides(configuration.Settings)[header],
+	}
+	for _, alternative := range resolution.Alternatives {
+		res.Alternatives = append(res.Alternatives, alternative.ToRPC())
+	}
+	return res, nil
+}
@@ -0,0 +1,208 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"net/url"
+	"path"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	sk "github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/arduino/utils"
+	"github.com/arduino/arduino-cli/cli/globals"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// LoadProfile returns a PackageManager that contains only the platforms pinned by
+// the profile, and the directories of the libraries pinned by the profile.
+// The platforms, tools and libraries that are missing are downloaded and installed
+// in a location separated from the one used by "core install" and "lib install",
+// so the exact versions required by the profile are always used.
+func LoadProfile(instanceID int32, profile *sk.Profile, downloadCB DownloadProgressCB, taskCB TaskProgressCB) (*packagemanager.PackageManager, paths.PathList, error) {
+	lm := GetLibraryManager(instanceID)
+	if lm == nil {
+		return nil, nil, &arduino.InvalidInstanceError{}
+	}
+
+	dataDir := paths.New(configuration.Settings.GetString("directories.Data"))
+	downloadsDir := paths.New(configuration.Settings.GetString("directories.Downloads"))
+	packagesDir := configuration.ProfilesPackagesDir(configuration.Settings)
+	if err := packagesDir.MkdirAll(); err != nil {
+		return nil, nil, &arduino.PermissionDeniedError{Message: tr("Failed to create data directory"), Cause: err}
+	}
+	pm := packagemanager.NewPackageManager(dataDir, packagesDir, downloadsDir, dataDir.Join("tmp"))
+
+	refs := []*packagemanager.PlatformReference{}
+	missing := []*packagemanager.PlatformReference{}
+	for _, platform := range profile.Platforms {
+		ref := &packagemanager.PlatformReference{
+			Package:              platform.Packager,
+			PlatformArchitecture: platform.Architecture,
+			PlatformVersion:      platform.Version,
+		}
+		refs = append(refs, ref)
+		if packagesDir.Join(ref.Package, "hardware", ref.PlatformArchitecture, ref.PlatformVersion.String()).NotExist() {
+			missing = append(missing, ref)
+		}
+	}
+
+	// The package indexes are needed only to install the missing platforms, the
+	// installed ones carry the list of their tools in installed.json
+	if len(missing) > 0 {
+		indexURLs := []string{globals.DefaultIndexURL}
+		for _, platform := range profile.Platforms {
+			if platform.PlatformIndexURL != nil {
+				indexURLs = append(indexURLs, platform.PlatformIndexURL.String())
+			}
+		}
+		if err := installProfilePlatforms(pm, indexURLs, missing, downloadCB, taskCB); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if errs := pm.LoadPinnedPlatformReleases(refs); len(errs) > 0 {
+		return nil, nil, &arduino.FailedInstallError{Message: tr("Error loading the platforms of profile %s", profile.Name), Cause: errs[0].Err()}
+	}
+
+	librariesDir := configuration.ProfilesLibrariesDir(configuration.Settings)
+	librariesDirs := paths.PathList{}
+	for _, library := range profile.Libraries {
+		saneName := utils.SanitizeName(library.Library)
+		libraryDir := librariesDir.Join(saneName+"_"+library.Version.String(), saneName)
+		librariesDirs.Add(libraryDir)
+		if libraryDir.IsDir() {
+			continue
+		}
+
+		release := lm.Index.FindRelease(&librariesindex.Reference{Name: library.Library, Version: library.Version})
+		if release == nil {
+			return nil, nil, &arduino.LibraryNotFoundError{Library: library.String()}
+		}
+		taskCB(&rpc.TaskProgress{Name: tr("Downloading %s", release)})
+		config, err := GetDownloaderConfig()
+		if err != nil {
+			return nil, nil, &arduino.FailedDownloadError{Message: tr("Can't download library"), Cause: err}
+		}
+		if d, err := release.Resource.Download(lm.DownloadsDir, config); err != nil {
+			return nil, nil, &arduino.FailedDownloadError{Message: tr("Can't download library"), Cause: err}
+		} else if err := Download(d, release.String(), downloadCB); err != nil {
+			return nil, nil, &arduino.FailedDownloadError{Message: tr("Can't download library"), Cause: err}
+		}
+		taskCB(&rpc.TaskProgress{Completed: true})
+
+		taskCB(&rpc.TaskProgress{Name: tr("Installing %s", release)})
+		if err := release.Resource.Install(lm.DownloadsDir, librariesDir, libraryDir); err != nil {
+			return nil, nil, &arduino.FailedLibraryInstallError{Cause: err}
+		}
+		taskCB(&rpc.TaskProgress{Message: tr("Installed %s", release), Completed: true})
+	}
+
+	return pm, librariesDirs, nil
+}
+
+// installProfilePlatforms downloads and installs the given platform releases, and the
+// tools they depend on, in the packages directory of the PackageManager. The package
+// indexes that are not available locally are downloaded, and they are updated if one
+// of the releases is not found, since the pinned release may be newer than the index.
+func installProfilePlatforms(pm *packagemanager.PackageManager, indexURLs []string, refs []*packagemanager.PlatformReference,
+	downloadCB DownloadProgressCB, taskCB TaskProgressCB) error {
+
+	if errs := pm.LoadPinnedPlatformReleases(nil); len(errs) > 0 {
+		return &arduino.FailedInstallError{Message: tr("Error loading installed tools"), Cause: errs[0].Err()}
+	}
+	if err := loadProfileIndexes(pm, indexURLs, false, downloadCB); err != nil {
+		return err
+	}
+
+	updated := false
+	for _, ref := range refs {
+		platform, tools, err := pm.FindPlatformReleaseDependencies(ref)
+		if err != nil && !updated {
+			if err := loadProfileIndexes(pm, indexURLs, true, downloadCB); err != nil {
+				return err
+			}
+			updated = true
+			platform, tools, err = pm.FindPlatformReleaseDependencies(ref)
+		}
+		if err != nil {
+			return &arduino.PlatformNotFoundError{Platform: ref.String(), Cause: err}
+		}
+
+		for _, tool := range tools {
+			if tool.IsInstalled() {
+				continue
+			}
+			if err := DownloadToolRelease(pm, tool, downloadCB); err != nil {
+				return &arduino.FailedDownloadError{Message: tr("Error downloading tool %s", tool), Cause: err}
+			}
+			if err := InstallToolRelease(pm, tool, taskCB); err != nil {
+				return err
+			}
+		}
+
+		config, err := GetDownloaderConfig()
+		if err != nil {
+			return &arduino.FailedDownloadError{Message: tr("Error downloading platform %s", platform), Cause: err}
+		}
+		d, err := pm.DownloadPlatformRelease(platform, config)
+		if err != nil {
+			return &arduino.FailedDownloadError{Message: tr("Error downloading platform %s", platform), Cause: err}
+		}
+		if err := Download(d, platform.String(), downloadCB); err != nil {
+			return err
+		}
+
+		taskCB(&rpc.TaskProgress{Name: tr("Installing platform %s", platform)})
+		if err := pm.InstallPlatform(platform); err != nil {
+			return &arduino.FailedInstallError{Message: tr("Cannot install platform"), Cause: err}
+		}
+		if err := pm.RunPostInstallScript(platform); err != nil {
+			taskCB(&rpc.TaskProgress{Message: tr("WARNING cannot configure platform: %s", err)})
+		}
+		taskCB(&rpc.TaskProgress{Message: tr("Platform %s installed", platform), Completed: true})
+	}
+	return nil
+}
+
+// loadProfileIndexes loads the given package indexes in the PackageManager. The indexes
+// missing from the data directory are downloaded, all of them if update is true.
+func loadProfileIndexes(pm *packagemanager.PackageManager, indexURLs []string, update bool, downloadCB DownloadProgressCB) error {
+	for _, u := range indexURLs {
+		URL, err := url.Parse(u)
+		if err != nil {
+			return &arduino.InvalidURLError{Cause: err}
+		}
+		if URL.Scheme == "file" {
+			if _, err := pm.LoadPackageIndexFromFile(paths.New(URL.Path)); err != nil {
+				return &arduino.InvalidArgumentError{Message: tr("Invalid package index in %s", URL), Cause: err}
+			}
+			continue
+		}
+		if indexFile := pm.IndexDir.Join(path.Base(URL.Path)); update || indexFile.NotExist() {
+			if err := updatePackageIndex(URL, pm.IndexDir, downloadCB); err != nil {
+				return err
+			}
+		}
+		if err := pm.LoadPackageIndex(URL); err != nil {
+			return &arduino.InvalidArgumentError{Message: tr("Invalid package index in %s", URL), Cause: err}
+		}
+	}
+	return nil
+}
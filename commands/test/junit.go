@@ -0,0 +1,107 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package test
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name          `xml:"testsuites"`
+	Name     string            `xml:"name,attr"`
+	Tests    int               `xml:"tests,attr"`
+	Failures int               `xml:"failures,attr"`
+	Time     string            `xml:"time,attr"`
+	Suites   []*junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string           `xml:"name,attr"`
+	Tests      int              `xml:"tests,attr"`
+	Failures   int              `xml:"failures,attr"`
+	Time       string           `xml:"time,attr"`
+	TestCases  []*junitTestCase `xml:"testcase"`
+	durationUs int64
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	File      string        `xml:"file,attr,omitempty"`
+	Line      int64         `xml:"line,attr,omitempty"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// junitTime formats a duration in seconds, as expected by the JUnit format
+func junitTime(durationUs int64) string {
+	return fmt.Sprintf("%.6f", float64(durationUs)/1e6)
+}
+
+// newJUnitReport groups the results of the tests by suite, in order of execution
+func newJUnitReport(name string, results []*rpc.TestCaseResult) *junitTestSuites {
+	report := &junitTestSuites{Name: name, Suites: []*junitTestSuite{}}
+	suites := map[string]*junitTestSuite{}
+	totalUs := int64(0)
+	for _, result := range results {
+		suite, ok := suites[result.GetSuite()]
+		if !ok {
+			suite = &junitTestSuite{Name: result.GetSuite()}
+			suites[result.GetSuite()] = suite
+			report.Suites = append(report.Suites, suite)
+		}
+		testCase := &junitTestCase{
+			Name:      result.GetName(),
+			Classname: result.GetSuite(),
+			File:      result.GetFile(),
+			Line:      result.GetLine(),
+			Time:      junitTime(result.GetDurationUs()),
+		}
+		suite.Tests++
+		report.Tests++
+		if !result.GetPassed() {
+			testCase.Failure = &junitFailure{Message: result.GetFailure(), Text: result.GetFailure()}
+			suite.Failures++
+			report.Failures++
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+		suite.durationUs += result.GetDurationUs()
+		totalUs += result.GetDurationUs()
+	}
+	for _, suite := range report.Suites {
+		suite.Time = junitTime(suite.durationUs)
+	}
+	report.Time = junitTime(totalUs)
+	return report
+}
+
+// writeJUnitReport writes the results of the tests in JUnit XML format
+func writeJUnitReport(path *paths.Path, name string, results []*rpc.TestCaseResult) error {
+	data, err := xml.MarshalIndent(newJUnitReport(name, results), "", "  ")
+	if err != nil {
+		return err
+	}
+	return path.WriteFile(append([]byte(xml.Header), append(data, '\n')...))
+}
@@ -0,0 +1,58 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package test
+
+import (
+	"bytes"
+	"embed"
+	"io/fs"
+
+	paths "github.com/arduino/go-paths-helper"
+)
+
+//go:embed mockcore
+var mockCore embed.FS
+
+// mockCorePackage is the name of the package of the mock core
+const mockCorePackage = "mock"
+
+// mockCoreBoard is the id of the board of the mock core
+const mockCoreBoard = "native"
+
+// installMockCore writes the mock core in the given hardware folder, as a platform
+// of the given architecture, so the libraries are resolved as for the boards of
+// that architecture. The files already up to date are not written again, to not
+// trigger the rebuild of the core.
+func installMockCore(hardwareDir *paths.Path, architecture string) error {
+	platformDir := hardwareDir.Join(mockCorePackage, architecture)
+	return fs.WalkDir(mockCore, "mockcore", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		target := platformDir.Join(path[len("mockcore"):])
+		if d.IsDir() {
+			return target.MkdirAll()
+		}
+		data, err := mockCore.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if current, err := target.ReadFile(); err == nil && bytes.Equal(current, data) {
+			return nil
+		}
+		return target.WriteFile(data)
+	})
+}
@@ -0,0 +1,126 @@
+/*
+  Arduino.cpp - Mock of the Arduino API: the state of the simulated board.
+*/
+
+#include "Arduino.h"
+
+#define NUM_PINS 256
+
+static uint8_t pinModes[NUM_PINS];
+static int digitalValues[NUM_PINS];
+static int digitalInputs[NUM_PINS];
+static int analogValues[NUM_PINS];
+static int analogInputs[NUM_PINS];
+static unsigned long long clockMicros;
+
+HardwareSerial Serial;
+
+void pinMode(uint8_t pin, uint8_t mode) {
+  pinModes[pin] = mode;
+  if (mode == INPUT_PULLUP) {
+    digitalInputs[pin] = HIGH;
+  }
+}
+
+void digitalWrite(uint8_t pin, uint8_t value) { digitalValues[pin] = value ? HIGH : LOW; }
+
+int digitalRead(uint8_t pin) {
+  if (pinModes[pin] == OUTPUT) {
+    return digitalValues[pin];
+  }
+  return digitalInputs[pin];
+}
+
+int analogRead(uint8_t pin) { return analogInputs[pin]; }
+void analogReference(uint8_t mode) { (void)mode; }
+void analogWrite(uint8_t pin, int value) { analogValues[pin] = value; }
+
+unsigned long millis(void) { return (unsigned long)(clockMicros / 1000); }
+unsigned long micros(void) { return (unsigned long)clockMicros; }
+void delay(unsigned long ms) { clockMicros += (unsigned long long)ms * 1000; }
+void delayMicroseconds(unsigned int us) { clockMicros += us; }
+void yield(void) {}
+
+void tone(uint8_t pin, unsigned int frequency, unsigned long duration) { (void)pin, (void)frequency, (void)duration; }
+void noTone(uint8_t pin) { (void)pin; }
+
+void attachInterrupt(uint8_t interruptNum, void (*userFunc)(void), int mode) { (void)interruptNum, (void)userFunc, (void)mode; }
+void detachInterrupt(uint8_t interruptNum) { (void)interruptNum; }
+void interrupts(void) {}
+void noInterrupts(void) {}
+
+long random(long max) {
+  if (max <= 0) {
+    return 0;
+  }
+  return rand() % max;
+}
+
+long random(long min, long max) {
+  if (min >= max) {
+    return min;
+  }
+  return random(max - min) + min;
+}
+
+void randomSeed(unsigned long seed) {
+  if (seed != 0) {
+    srand(seed);
+  }
+}
+
+long map(long value, long fromLow, long fromHigh, long toLow, long toHigh) {
+  return (value - fromLow) * (toHigh - toLow) / (fromHigh - fromLow) + toLow;
+}
+
+int HardwareSerial::available() { return (int)input.size(); }
+
+int HardwareSerial::read() {
+  if (input.empty()) {
+    return -1;
+  }
+  int c = (unsigned char)input[0];
+  input.erase(0, 1);
+  return c;
+}
+
+int HardwareSerial::peek() {
+  if (input.empty()) {
+    return -1;
+  }
+  return (unsigned char)input[0];
+}
+
+size_t HardwareSerial::write(uint8_t c) {
+  output += (char)c;
+  return 1;
+}
+
+namespace mock {
+
+void reset() {
+  memset(pinModes, INPUT, sizeof(pinModes));
+  memset(digitalValues, 0, sizeof(digitalValues));
+  memset(digitalInputs, 0, sizeof(digitalInputs));
+  memset(analogValues, 0, sizeof(analogValues));
+  memset(analogInputs, 0, sizeof(analogInputs));
+  clockMicros = 0;
+  Serial.input.clear();
+  Serial.output.clear();
+}
+
+uint8_t pinModeOf(uint8_t pin) { return pinModes[pin]; }
+int digitalWritten(uint8_t pin) { return digitalValues[pin]; }
+void setDigitalRead(uint8_t pin, int value) { digitalInputs[pin] = value ? HIGH : LOW; }
+int analogWritten(uint8_t pin) { return analogValues[pin]; }
+void setAnalogRead(uint8_t pin, int value) { analogInputs[pin] = value; }
+
+void setMillis(unsigned long ms) { clockMicros = (unsigned long long)ms * 1000; }
+void advanceMillis(unsigned long ms) { clockMicros += (unsigned long long)ms * 1000; }
+void advanceMicros(unsigned long us) { clockMicros += us; }
+
+void serialInput(const char *data) { Serial.input += data; }
+String serialOutput() { return String(Serial.output); }
+void clearSerialOutput() { Serial.output.clear(); }
+
+} // namespace mock
@@ -0,0 +1,159 @@
+/*
+  Arduino.h - Mock of the Arduino API to run the unit tests of a sketch on the
+  host system.
+
+  The functions that interact with the hardware work on a simulated board,
+  whose state can be inspected and changed by the tests with the functions
+  of the "mock" namespace.
+*/
+
+#ifndef Arduino_h
+#define Arduino_h
+
+#include <math.h>
+#include <stdbool.h>
+#include <stddef.h>
+#include <stdint.h>
+#include <stdlib.h>
+#include <string.h>
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+#define HIGH 0x1
+#define LOW 0x0
+
+#define INPUT 0x0
+#define OUTPUT 0x1
+#define INPUT_PULLUP 0x2
+
+#define LSBFIRST 0
+#define MSBFIRST 1
+
+#define CHANGE 1
+#define FALLING 2
+#define RISING 3
+
+#define LED_BUILTIN 13
+#define A0 14
+#define A1 15
+#define A2 16
+#define A3 17
+#define A4 18
+#define A5 19
+#define A6 20
+#define A7 21
+
+#define PI 3.1415926535897932384626433832795
+#define HALF_PI 1.5707963267948966192313216916398
+#define TWO_PI 6.283185307179586476925286766559
+#define DEG_TO_RAD 0.017453292519943295769236907684886
+#define RAD_TO_DEG 57.295779513082320876798154814105
+
+#define constrain(amt, low, high) ((amt) < (low) ? (low) : ((amt) > (high) ? (high) : (amt)))
+#define radians(deg) ((deg)*DEG_TO_RAD)
+#define degrees(rad) ((rad)*RAD_TO_DEG)
+#define sq(x) ((x) * (x))
+
+#define lowByte(w) ((uint8_t)((w)&0xff))
+#define highByte(w) ((uint8_t)((w) >> 8))
+#define bitRead(value, bit) (((value) >> (bit)) & 0x01)
+#define bitSet(value, bit) ((value) |= (1UL << (bit)))
+#define bitClear(value, bit) ((value) &= ~(1UL << (bit)))
+#define bitWrite(value, bit, bitvalue) ((bitvalue) ? bitSet(value, bit) : bitClear(value, bit))
+#define bit(b) (1UL << (b))
+
+#define PROGMEM
+#define pgm_read_byte(addr) (*(const uint8_t *)(addr))
+#define pgm_read_word(addr) (*(const uint16_t *)(addr))
+#define pgm_read_dword(addr) (*(const uint32_t *)(addr))
+
+typedef bool boolean;
+typedef uint8_t byte;
+typedef uint16_t word;
+
+void pinMode(uint8_t pin, uint8_t mode);
+void digitalWrite(uint8_t pin, uint8_t value);
+int digitalRead(uint8_t pin);
+int analogRead(uint8_t pin);
+void analogReference(uint8_t mode);
+void analogWrite(uint8_t pin, int value);
+
+unsigned long millis(void);
+unsigned long micros(void);
+void delay(unsigned long ms);
+void delayMicroseconds(unsigned int us);
+void yield(void);
+
+void tone(uint8_t pin, unsigned int frequency, unsigned long duration);
+void noTone(uint8_t pin);
+
+void attachInterrupt(uint8_t interruptNum, void (*userFunc)(void), int mode);
+void detachInterrupt(uint8_t interruptNum);
+void interrupts(void);
+void noInterrupts(void);
+
+#define digitalPinToInterrupt(p) (p)
+
+void setup(void);
+void loop(void);
+
+#ifdef __cplusplus
+} // extern "C"
+
+#include "WString.h"
+#include "HardwareSerial.h"
+
+template <class T, class L>
+auto min(const T &a, const L &b) -> decltype((b < a) ? b : a) {
+  return (b < a) ? b : a;
+}
+
+template <class T, class L>
+auto max(const T &a, const L &b) -> decltype((b < a) ? b : a) {
+  return (a < b) ? b : a;
+}
+
+long random(long max);
+long random(long min, long max);
+void randomSeed(unsigned long seed);
+long map(long value, long fromLow, long fromHigh, long toLow, long toHigh);
+
+// Functions to inspect and change the state of the simulated board
+namespace mock {
+
+// Restores the initial state of the board: all the pins are inputs at LOW,
+// the clock is at 0 and the serial buffers are empty. It's called before
+// every test.
+void reset();
+
+// Returns the mode set with pinMode
+uint8_t pinModeOf(uint8_t pin);
+// Returns the value set with digitalWrite
+int digitalWritten(uint8_t pin);
+// Sets the value returned by digitalRead
+void setDigitalRead(uint8_t pin, int value);
+// Returns the value set with analogWrite
+int analogWritten(uint8_t pin);
+// Sets the value returned by analogRead
+void setAnalogRead(uint8_t pin, int value);
+
+// Sets the time returned by millis and micros
+void setMillis(unsigned long ms);
+// Moves the clock forward: delay does the same without waiting
+void advanceMillis(unsigned long ms);
+void advanceMicros(unsigned long us);
+
+// Adds data to the input buffer of Serial
+void serialInput(const char *data);
+// Returns the data written to Serial since the last reset
+String serialOutput();
+// Empties the output buffer of Serial
+void clearSerialOutput();
+
+} // namespace mock
+
+#endif // __cplusplus
+
+#endif // Arduino_h
@@ -0,0 +1,119 @@
+/*
+  ArduinoTest.h - Minimal unit test framework for the tests of a sketch.
+
+  TEST(Suite, Name) {
+    ASSERT_EQ(4, add(2, 2));
+  }
+
+  The tests are run in order of declaration, the state of the simulated board
+  is reset before every test. A failed assertion stops the test.
+*/
+
+#ifndef ArduinoTest_h
+#define ArduinoTest_h
+
+#include <sstream>
+#include <string>
+
+#include "Arduino.h"
+
+namespace arduino_test {
+
+typedef void (*TestFunction)();
+
+// Registers a test, used by the TEST macro
+struct Registration {
+  Registration(const char *suite, const char *name, const char *file, int line, TestFunction function);
+};
+
+// Thrown by a failed assertion to stop the test
+struct AssertionFailure {
+  std::string message;
+};
+
+void fail(const char *file, int line, const std::string &message);
+
+template <typename T>
+std::string toString(const T &value) {
+  std::ostringstream res;
+  res << value;
+  return res.str();
+}
+
+inline std::string toString(const String &value) { return "\"" + std::string(value.c_str()) + "\""; }
+inline std::string toString(const char *value) { return value ? "\"" + std::string(value) + "\"" : "NULL"; }
+inline std::string toString(char *value) { return toString((const char *)value); }
+inline std::string toString(const bool &value) { return value ? "true" : "false"; }
+inline std::string toString(const char &value) { return "'" + std::string(1, value) + "'"; }
+inline std::string toString(const unsigned char &value) { return toString((unsigned int)value); }
+inline std::string toString(const signed char &value) { return toString((int)value); }
+
+// Defines a function failing the test if the comparison of the two values is false
+#define ARDUINO_TEST_COMPARISON(function, op)                                                       \
+  template <typename E, typename A>                                                                \
+  void function(const E &expected, const A &actual, const char *expectedExpr, const char *actualExpr, \
+                const char *file, int line) {                                                      \
+    if (!(expected op actual)) {                                                                   \
+      fail(file, line, std::string("expected ") + expectedExpr + " " #op " " + actualExpr +        \
+                           ", got " + toString(expected) + " and " + toString(actual));            \
+    }                                                                                              \
+  }
+
+ARDUINO_TEST_COMPARISON(assertEq, ==)
+ARDUINO_TEST_COMPARISON(assertNe, !=)
+ARDUINO_TEST_COMPARISON(assertLt, <)
+ARDUINO_TEST_COMPARISON(assertLe, <=)
+ARDUINO_TEST_COMPARISON(assertGt, >)
+ARDUINO_TEST_COMPARISON(assertGe, >=)
+
+inline void assertStrEq(const char *expected, const char *actual, const char *expectedExpr, const char *actualExpr,
+                        const char *file, int line) {
+  if (expected == NULL || actual == NULL ? expected != actual : strcmp(expected, actual) != 0) {
+    fail(file, line, std::string("expected ") + expectedExpr + " == " + actualExpr +
+                         ", got " + toString(expected) + " and " + toString(actual));
+  }
+}
+
+inline void assertNear(double expected, double actual, double tolerance, const char *expectedExpr,
+                       const char *actualExpr, const char *file, int line) {
+  if (fabs(expected - actual) > tolerance) {
+    fail(file, line, std::string("expected ") + expectedExpr + " to be near " + actualExpr +
+                         ", got " + toString(expected) + " and " + toString(actual));
+  }
+}
+
+} // namespace arduino_test
+
+#define TEST(suite, name)                                                        \
+  static void suite##_##name##_test();                                           \
+  static arduino_test::Registration suite##_##name##_registration(               \
+      #suite, #name, __FILE__, __LINE__, suite##_##name##_test);                 \
+  static void suite##_##name##_test()
+
+#define FAIL(message) arduino_test::fail(__FILE__, __LINE__, message)
+
+#define ASSERT_TRUE(condition) \
+  do {                         \
+    if (!(condition)) {        \
+      FAIL("expected " #condition " to be true"); \
+    }                          \
+  } while (0)
+
+#define ASSERT_FALSE(condition) \
+  do {                          \
+    if (condition) {            \
+      FAIL("expected " #condition " to be false"); \
+    }                           \
+  } while (0)
+
+#define ASSERT_EQ(expected, actual) arduino_test::assertEq((expected), (actual), #expected, #actual, __FILE__, __LINE__)
+#define ASSERT_NE(expected, actual) arduino_test::assertNe((expected), (actual), #expected, #actual, __FILE__, __LINE__)
+#define ASSERT_LT(a, b) arduino_test::assertLt((a), (b), #a, #b, __FILE__, __LINE__)
+#define ASSERT_LE(a, b) arduino_test::assertLe((a), (b), #a, #b, __FILE__, __LINE__)
+#define ASSERT_GT(a, b) arduino_test::assertGt((a), (b), #a, #b, __FILE__, __LINE__)
+#define ASSERT_GE(a, b) arduino_test::assertGe((a), (b), #a, #b, __FILE__, __LINE__)
+#define ASSERT_STREQ(expected, actual) arduino_test::assertStrEq((expected), (actual), #expected, #actual, __FILE__, __LINE__)
+#define ASSERT_NEAR(expected, actual, tolerance) \
+  arduino_test::assertNear((expected), (actual), (tolerance), #expected, #actual, __FILE__, __LINE__)
+
+#endif // ArduinoTest_h
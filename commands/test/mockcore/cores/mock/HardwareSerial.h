@@ -0,0 +1,34 @@
+/*
+  HardwareSerial.h - Mock of the serial port of the board. The data written is
+  kept in memory and the data read is the one given with mock::serialInput.
+*/
+
+#ifndef HardwareSerial_h
+#define HardwareSerial_h
+
+#include <string>
+
+#include "Stream.h"
+
+class HardwareSerial : public Stream {
+public:
+  void begin(unsigned long baud) { (void)baud; }
+  void begin(unsigned long baud, uint8_t config) { (void)baud, (void)config; }
+  void end() {}
+
+  int available() override;
+  int read() override;
+  int peek() override;
+  size_t write(uint8_t c) override;
+  using Print::write;
+
+  operator bool() { return true; }
+
+  // Used by the functions of the mock namespace
+  std::string input;
+  std::string output;
+};
+
+extern HardwareSerial Serial;
+
+#endif // HardwareSerial_h
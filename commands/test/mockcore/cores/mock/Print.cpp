@@ -0,0 +1,45 @@
+/*
+  Print.cpp - Mock of the Arduino Print class.
+*/
+
+#include "Print.h"
+
+#include <string.h>
+
+size_t Print::write(const uint8_t *buffer, size_t size) {
+  size_t n = 0;
+  while (size--) {
+    n += write(*buffer++);
+  }
+  return n;
+}
+
+size_t Print::write(const char *str) {
+  if (str == NULL) {
+    return 0;
+  }
+  return write((const uint8_t *)str, strlen(str));
+}
+
+size_t Print::print(const __FlashStringHelper *str) { return write(reinterpret_cast<const char *>(str)); }
+size_t Print::print(const String &str) { return write(str.c_str()); }
+size_t Print::print(const char *str) { return write(str); }
+size_t Print::print(char c) { return write((uint8_t)c); }
+size_t Print::print(unsigned char value, int base) { return print(String(value, base)); }
+size_t Print::print(int value, int base) { return print(String(value, base)); }
+size_t Print::print(unsigned int value, int base) { return print(String(value, base)); }
+size_t Print::print(long value, int base) { return print(String(value, base)); }
+size_t Print::print(unsigned long value, int base) { return print(String(value, base)); }
+size_t Print::print(double value, int digits) { return print(String(value, digits)); }
+
+size_t Print::println(void) { return write("\r\n"); }
+size_t Print::println(const __FlashStringHelper *str) { return print(str) + println(); }
+size_t Print::println(const String &str) { return print(str) + println(); }
+size_t Print::println(const char *str) { return print(str) + println(); }
+size_t Print::println(char c) { return print(c) + println(); }
+size_t Print::println(unsigned char value, int base) { return print(value, base) + println(); }
+size_t Print::println(int value, int base) { return print(value, base) + println(); }
+size_t Print::println(unsigned int value, int base) { return print(value, base) + println(); }
+size_t Print::println(long value, int base) { return print(value, base) + println(); }
+size_t Print::println(unsigned long value, int base) { return print(value, base) + println(); }
+size_t Print::println(double value, int digits) { return print(value, digits) + println(); }
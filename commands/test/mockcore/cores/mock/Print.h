@@ -0,0 +1,53 @@
+/*
+  Print.h - Mock of the Arduino Print class.
+*/
+
+#ifndef Print_h
+#define Print_h
+
+#include <stddef.h>
+#include <stdint.h>
+
+#include "WString.h"
+
+#define DEC 10
+#define HEX 16
+#define OCT 8
+#define BIN 2
+
+class Print {
+public:
+  virtual ~Print() {}
+
+  virtual size_t write(uint8_t c) = 0;
+  virtual size_t write(const uint8_t *buffer, size_t size);
+  size_t write(const char *str);
+  size_t write(const char *buffer, size_t size) { return write((const uint8_t *)buffer, size); }
+
+  size_t print(const __FlashStringHelper *str);
+  size_t print(const String &str);
+  size_t print(const char *str);
+  size_t print(char c);
+  size_t print(unsigned char value, int base = DEC);
+  size_t print(int value, int base = DEC);
+  size_t print(unsigned int value, int base = DEC);
+  size_t print(long value, int base = DEC);
+  size_t print(unsigned long value, int base = DEC);
+  size_t print(double value, int digits = 2);
+
+  size_t println(const __FlashStringHelper *str);
+  size_t println(const String &str);
+  size_t println(const char *str);
+  size_t println(char c);
+  size_t println(unsigned char value, int base = DEC);
+  size_t println(int value, int base = DEC);
+  size_t println(unsigned int value, int base = DEC);
+  size_t println(long value, int base = DEC);
+  size_t println(unsigned long value, int base = DEC);
+  size_t println(double value, int digits = 2);
+  size_t println(void);
+
+  virtual void flush() {}
+};
+
+#endif // Print_h
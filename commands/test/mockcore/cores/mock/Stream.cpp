@@ -0,0 +1,62 @@
+/*
+  Stream.cpp - Mock of the Arduino Stream class. The simulated streams never
+  wait for data: the reads stop as soon as the available data is consumed.
+*/
+
+#include "Stream.h"
+
+size_t Stream::readBytes(char *buffer, size_t length) {
+  size_t n = 0;
+  while (n < length && available() > 0) {
+    buffer[n++] = (char)read();
+  }
+  return n;
+}
+
+size_t Stream::readBytesUntil(char terminator, char *buffer, size_t length) {
+  size_t n = 0;
+  while (n < length && available() > 0) {
+    int c = read();
+    if (c == terminator) {
+      break;
+    }
+    buffer[n++] = (char)c;
+  }
+  return n;
+}
+
+String Stream::readString() {
+  String res;
+  while (available() > 0) {
+    res += (char)read();
+  }
+  return res;
+}
+
+String Stream::readStringUntil(char terminator) {
+  String res;
+  while (available() > 0) {
+    int c = read();
+    if (c == terminator) {
+      break;
+    }
+    res += (char)c;
+  }
+  return res;
+}
+
+long Stream::parseInt() {
+  while (available() > 0 && peek() != '-' && (peek() < '0' || peek() > '9')) {
+    read();
+  }
+  bool negative = false;
+  if (available() > 0 && peek() == '-') {
+    negative = true;
+    read();
+  }
+  long value = 0;
+  while (available() > 0 && peek() >= '0' && peek() <= '9') {
+    value = value * 10 + read() - '0';
+  }
+  return negative ? -value : value;
+}
@@ -0,0 +1,25 @@
+/*
+  Stream.h - Mock of the Arduino Stream class.
+*/
+
+#ifndef Stream_h
+#define Stream_h
+
+#include "Print.h"
+
+class Stream : public Print {
+public:
+  virtual int available() = 0;
+  virtual int read() = 0;
+  virtual int peek() = 0;
+
+  void setTimeout(unsigned long timeout) { (void)timeout; }
+
+  size_t readBytes(char *buffer, size_t length);
+  size_t readBytesUntil(char terminator, char *buffer, size_t length);
+  String readString();
+  String readStringUntil(char terminator);
+  long parseInt();
+};
+
+#endif // Stream_h
@@ -0,0 +1,230 @@
+/*
+  WString.cpp - Mock of the Arduino String class, backed by std::string.
+*/
+
+#include "WString.h"
+
+#include <ctype.h>
+#include <stdio.h>
+#include <stdlib.h>
+
+static std::string formatNumber(unsigned long value, unsigned char base, bool negative) {
+  if (base < 2 || base > 36) {
+    base = 10;
+  }
+  std::string res;
+  do {
+    int digit = value % base;
+    res.insert(res.begin(), digit < 10 ? '0' + digit : 'a' + digit - 10);
+    value /= base;
+  } while (value > 0);
+  if (negative) {
+    res.insert(res.begin(), '-');
+  }
+  return res;
+}
+
+static std::string formatSigned(long value, unsigned char base) {
+  if (base == 10 && value < 0) {
+    return formatNumber(-(unsigned long)value, base, true);
+  }
+  return formatNumber((unsigned long)value, base, false);
+}
+
+static std::string formatFloat(double value, unsigned char decimalPlaces) {
+  char buf[64];
+  snprintf(buf, sizeof(buf), "%.*f", decimalPlaces, value);
+  return buf;
+}
+
+String::String(const char *cstr) : buffer(cstr ? cstr : "") {}
+String::String(const std::string &str) : buffer(str) {}
+String::String(const __FlashStringHelper *str) : buffer(reinterpret_cast<const char *>(str)) {}
+String::String(char c) : buffer(1, c) {}
+String::String(unsigned char value, unsigned char base) : buffer(formatNumber(value, base, false)) {}
+String::String(int value, unsigned char base) : buffer(formatSigned(value, base)) {}
+String::String(unsigned int value, unsigned char base) : buffer(formatNumber(value, base, false)) {}
+String::String(long value, unsigned char base) : buffer(formatSigned(value, base)) {}
+String::String(unsigned long value, unsigned char base) : buffer(formatNumber(value, base, false)) {}
+String::String(float value, unsigned char decimalPlaces) : buffer(formatFloat(value, decimalPlaces)) {}
+String::String(double value, unsigned char decimalPlaces) : buffer(formatFloat(value, decimalPlaces)) {}
+
+bool String::reserve(unsigned int size) {
+  buffer.reserve(size);
+  return true;
+}
+
+bool String::concat(const String &str) {
+  buffer += str.buffer;
+  return true;
+}
+
+bool String::concat(const char *cstr) {
+  if (cstr == NULL) {
+    return false;
+  }
+  buffer += cstr;
+  return true;
+}
+
+bool String::concat(char c) {
+  buffer += c;
+  return true;
+}
+
+bool String::concat(int value) { return concat(String(value)); }
+bool String::concat(unsigned int value) { return concat(String(value)); }
+bool String::concat(long value) { return concat(String(value)); }
+bool String::concat(unsigned long value) { return concat(String(value)); }
+bool String::concat(double value) { return concat(String(value)); }
+
+bool String::equalsIgnoreCase(const String &str) const {
+  if (length() != str.length()) {
+    return false;
+  }
+  for (unsigned int i = 0; i < length(); i++) {
+    if (tolower(buffer[i]) != tolower(str.buffer[i])) {
+      return false;
+    }
+  }
+  return true;
+}
+
+bool String::startsWith(const String &prefix) const {
+  return buffer.compare(0, prefix.length(), prefix.buffer) == 0;
+}
+
+bool String::endsWith(const String &suffix) const {
+  return length() >= suffix.length() &&
+         buffer.compare(length() - suffix.length(), suffix.length(), suffix.buffer) == 0;
+}
+
+char String::charAt(unsigned int index) const {
+  return index < length() ? buffer[index] : 0;
+}
+
+void String::setCharAt(unsigned int index, char c) {
+  if (index < length()) {
+    buffer[index] = c;
+  }
+}
+
+char &String::operator[](unsigned int index) {
+  static char dummy;
+  if (index >= length()) {
+    dummy = 0;
+    return dummy;
+  }
+  return buffer[index];
+}
+
+int String::indexOf(char c, unsigned int fromIndex) const {
+  std::string::size_type pos = buffer.find(c, fromIndex);
+  return pos == std::string::npos ? -1 : (int)pos;
+}
+
+int String::indexOf(const String &str, unsigned int fromIndex) const {
+  std::string::size_type pos = buffer.find(str.buffer, fromIndex);
+  return pos == std::string::npos ? -1 : (int)pos;
+}
+
+int String::lastIndexOf(char c) const {
+  std::string::size_type pos = buffer.rfind(c);
+  return pos == std::string::npos ? -1 : (int)pos;
+}
+
+int String::lastIndexOf(const String &str) const {
+  std::string::size_type pos = buffer.rfind(str.buffer);
+  return pos == std::string::npos ? -1 : (int)pos;
+}
+
+String String::substring(unsigned int beginIndex) const {
+  return substring(beginIndex, length());
+}
+
+String String::substring(unsigned int beginIndex, unsigned int endIndex) const {
+  if (beginIndex > endIndex) {
+    unsigned int tmp = beginIndex;
+    beginIndex = endIndex;
+    endIndex = tmp;
+  }
+  if (beginIndex >= length()) {
+    return String();
+  }
+  if (endIndex > length()) {
+    endIndex = length();
+  }
+  return String(buffer.substr(beginIndex, endIndex - beginIndex));
+}
+
+void String::replace(char find, char replace) {
+  for (unsigned int i = 0; i < length(); i++) {
+    if (buffer[i] == find) {
+      buffer[i] = replace;
+    }
+  }
+}
+
+void String::replace(const String &find, const String &replace) {
+  if (find.length() == 0) {
+    return;
+  }
+  std::string::size_type pos = 0;
+  while ((pos = buffer.find(find.buffer, pos)) != std::string::npos) {
+    buffer.replace(pos, find.length(), replace.buffer);
+    pos += replace.length();
+  }
+}
+
+void String::remove(unsigned int index) {
+  if (index < length()) {
+    buffer.erase(index);
+  }
+}
+
+void String::remove(unsigned int index, unsigned int count) {
+  if (index < length()) {
+    buffer.erase(index, count);
+  }
+}
+
+void String::toLowerCase() {
+  for (unsigned int i = 0; i < length(); i++) {
+    buffer[i] = tolower(buffer[i]);
+  }
+}
+
+void String::toUpperCase() {
+  for (unsigned int i = 0; i < length(); i++) {
+    buffer[i] = toupper(buffer[i]);
+  }
+}
+
+void String::trim() {
+  std::string::size_type begin = buffer.find_first_not_of(" \t\r\n\f\v");
+  if (begin == std::string::npos) {
+    buffer.clear();
+    return;
+  }
+  std::string::size_type end = buffer.find_last_not_of(" \t\r\n\f\v");
+  buffer = buffer.substr(begin, end - begin + 1);
+}
+
+long String::toInt() const { return atol(buffer.c_str()); }
+float String::toFloat() const { return (float)atof(buffer.c_str()); }
+double String::toDouble() const { return atof(buffer.c_str()); }
+
+String operator+(const String &lhs, const String &rhs) {
+  String res(lhs);
+  res.concat(rhs);
+  return res;
+}
+
+String operator+(const String &lhs, const char *rhs) { return lhs + String(rhs); }
+String operator+(const char *lhs, const String &rhs) { return String(lhs) + rhs; }
+String operator+(const String &lhs, char rhs) { return lhs + String(rhs); }
+String operator+(const String &lhs, int rhs) { return lhs + String(rhs); }
+String operator+(const String &lhs, unsigned int rhs) { return lhs + String(rhs); }
+String operator+(const String &lhs, long rhs) { return lhs + String(rhs); }
+String operator+(const String &lhs, unsigned long rhs) { return lhs + String(rhs); }
+String operator+(const String &lhs, double rhs) { return lhs + String(rhs); }
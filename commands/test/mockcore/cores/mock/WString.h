@@ -0,0 +1,104 @@
+/*
+  WString.h - Mock of the Arduino String class, backed by std::string.
+*/
+
+#ifndef WString_h
+#define WString_h
+
+#ifdef __cplusplus
+
+#include <string>
+
+class __FlashStringHelper;
+#define F(string_literal) (reinterpret_cast<const __FlashStringHelper *>(string_literal))
+
+class String {
+public:
+  String(const char *cstr = "");
+  String(const std::string &str);
+  String(const __FlashStringHelper *str);
+  explicit String(char c);
+  explicit String(unsigned char value, unsigned char base = 10);
+  explicit String(int value, unsigned char base = 10);
+  explicit String(unsigned int value, unsigned char base = 10);
+  explicit String(long value, unsigned char base = 10);
+  explicit String(unsigned long value, unsigned char base = 10);
+  explicit String(float value, unsigned char decimalPlaces = 2);
+  explicit String(double value, unsigned char decimalPlaces = 2);
+
+  unsigned int length() const { return buffer.length(); }
+  const char *c_str() const { return buffer.c_str(); }
+  bool reserve(unsigned int size);
+
+  bool concat(const String &str);
+  bool concat(const char *cstr);
+  bool concat(char c);
+  bool concat(int value);
+  bool concat(unsigned int value);
+  bool concat(long value);
+  bool concat(unsigned long value);
+  bool concat(double value);
+
+  template <typename T>
+  String &operator+=(const T &value) {
+    concat(value);
+    return *this;
+  }
+
+  bool equals(const String &str) const { return buffer == str.buffer; }
+  bool equals(const char *cstr) const { return buffer == cstr; }
+  bool equalsIgnoreCase(const String &str) const;
+  bool startsWith(const String &prefix) const;
+  bool endsWith(const String &suffix) const;
+  int compareTo(const String &str) const { return buffer.compare(str.buffer); }
+
+  bool operator==(const String &str) const { return equals(str); }
+  bool operator==(const char *cstr) const { return equals(cstr); }
+  bool operator!=(const String &str) const { return !equals(str); }
+  bool operator!=(const char *cstr) const { return !equals(cstr); }
+  bool operator<(const String &str) const { return buffer < str.buffer; }
+  bool operator>(const String &str) const { return buffer > str.buffer; }
+  bool operator<=(const String &str) const { return buffer <= str.buffer; }
+  bool operator>=(const String &str) const { return buffer >= str.buffer; }
+
+  char charAt(unsigned int index) const;
+  void setCharAt(unsigned int index, char c);
+  char operator[](unsigned int index) const { return charAt(index); }
+  char &operator[](unsigned int index);
+
+  int indexOf(char c, unsigned int fromIndex = 0) const;
+  int indexOf(const String &str, unsigned int fromIndex = 0) const;
+  int lastIndexOf(char c) const;
+  int lastIndexOf(const String &str) const;
+  String substring(unsigned int beginIndex) const;
+  String substring(unsigned int beginIndex, unsigned int endIndex) const;
+
+  void replace(char find, char replace);
+  void replace(const String &find, const String &replace);
+  void remove(unsigned int index);
+  void remove(unsigned int index, unsigned int count);
+  void toLowerCase();
+  void toUpperCase();
+  void trim();
+
+  long toInt() const;
+  float toFloat() const;
+  double toDouble() const;
+
+private:
+  std::string buffer;
+};
+
+String operator+(const String &lhs, const String &rhs);
+String operator+(const String &lhs, const char *rhs);
+String operator+(const char *lhs, const String &rhs);
+String operator+(const String &lhs, char rhs);
+String operator+(const String &lhs, int rhs);
+String operator+(const String &lhs, unsigned int rhs);
+String operator+(const String &lhs, long rhs);
+String operator+(const String &lhs, unsigned long rhs);
+String operator+(const String &lhs, double rhs);
+
+#endif // __cplusplus
+
+#endif // WString_h
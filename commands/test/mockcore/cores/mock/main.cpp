@@ -0,0 +1,115 @@
+/*
+  main.cpp - Runner of the unit tests of a sketch.
+
+  The tests are run in order of declaration and their results are printed on
+  the standard output. If a file is given as argument, the results are also
+  written to it, one per line with tab separated fields:
+
+    run   <suite> <name> <file> <line>
+    pass  <suite> <name> <microseconds>
+    fail  <suite> <name> <microseconds> <message>
+*/
+
+#include <chrono>
+#include <exception>
+#include <stdio.h>
+#include <vector>
+
+#include "ArduinoTest.h"
+
+namespace arduino_test {
+
+struct Test {
+  const char *suite;
+  const char *name;
+  const char *file;
+  int line;
+  TestFunction function;
+};
+
+static std::vector<Test> &tests() {
+  static std::vector<Test> res;
+  return res;
+}
+
+Registration::Registration(const char *suite, const char *name, const char *file, int line, TestFunction function) {
+  Test test = {suite, name, file, line, function};
+  tests().push_back(test);
+}
+
+void fail(const char *file, int line, const std::string &message) {
+  AssertionFailure failure;
+  failure.message = std::string(file) + ":" + toString(line) + ": " + message;
+  throw failure;
+}
+
+static std::string singleLine(std::string s) {
+  for (size_t i = 0; i < s.size(); i++) {
+    if (s[i] == '\t' || s[i] == '\n' || s[i] == '\r') {
+      s[i] = ' ';
+    }
+  }
+  return s;
+}
+
+} // namespace arduino_test
+
+using namespace arduino_test;
+
+int main(int argc, char **argv) {
+  FILE *results = NULL;
+  if (argc > 1) {
+    results = fopen(argv[1], "w");
+    if (results == NULL) {
+      perror(argv[1]);
+      return 2;
+    }
+  }
+
+  int failed = 0;
+  for (size_t i = 0; i < tests().size(); i++) {
+    const Test &test = tests()[i];
+    printf("RUN  %s.%s\n", test.suite, test.name);
+    fflush(stdout);
+    if (results) {
+      fprintf(results, "run\t%s\t%s\t%s\t%d\n", test.suite, test.name, singleLine(test.file).c_str(), test.line);
+      fflush(results);
+    }
+
+    mock::reset();
+    std::string failure;
+    std::chrono::steady_clock::time_point start = std::chrono::steady_clock::now();
+    try {
+      test.function();
+    } catch (const AssertionFailure &e) {
+      failure = e.message;
+    } catch (const std::exception &e) {
+      failure = std::string("unexpected exception: ") + e.what();
+    } catch (...) {
+      failure = "unexpected exception";
+    }
+    long long elapsed = std::chrono::duration_cast<std::chrono::microseconds>(std::chrono::steady_clock::now() - start).count();
+
+    if (failure.empty()) {
+      printf("PASS %s.%s\n", test.suite, test.name);
+      if (results) {
+        fprintf(results, "pass\t%s\t%s\t%lld\n", test.suite, test.name, elapsed);
+      }
+    } else {
+      failed++;
+      printf("FAIL %s.%s\n     %s\n", test.suite, test.name, failure.c_str());
+      if (results) {
+        fprintf(results, "fail\t%s\t%s\t%lld\t%s\n", test.suite, test.name, elapsed, singleLine(failure).c_str());
+      }
+    }
+    fflush(stdout);
+    if (results) {
+      fflush(results);
+    }
+  }
+
+  if (results) {
+    fclose(results);
+  }
+  return failed > 0 ? 1 : 0;
+}
@@ -0,0 +1,68 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package test
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// parseResults reads the results written by the test runner of the mock core,
+// one per line with tab separated fields:
+//
+//	run   <suite> <name> <file> <line>
+//	pass  <suite> <name> <microseconds>
+//	fail  <suite> <name> <microseconds> <message>
+//
+// If the runner terminated while running a test, that test is reported as
+// failed with the given message.
+func parseResults(r io.Reader, interrupted string) ([]*rpc.TestCaseResult, error) {
+	results := []*rpc.TestCaseResult{}
+	var running *rpc.TestCaseResult
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), "\t")
+		switch {
+		case fields[0] == "run" && len(fields) == 5:
+			line, _ := strconv.ParseInt(fields[4], 10, 64)
+			running = &rpc.TestCaseResult{
+				Suite: fields[1],
+				Name:  fields[2],
+				File:  fields[3],
+				Line:  line,
+			}
+			results = append(results, running)
+		case (fields[0] == "pass" || fields[0] == "fail") && len(fields) >= 4 && running != nil:
+			running.DurationUs, _ = strconv.ParseInt(fields[3], 10, 64)
+			running.Passed = fields[0] == "pass"
+			if !running.Passed && len(fields) > 4 {
+				running.Failure = strings.Join(fields[4:], " ")
+			}
+			running = nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if running != nil {
+		running.Failure = interrupted
+	}
+	return results, nil
+}
@@ -0,0 +1,189 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package test
+
+import (
+	"context"
+	"io"
+	"os/exec"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/executils"
+	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/arduino-cli/legacy/builder"
+	legacyi18n "github.com/arduino/arduino-cli/legacy/builder/i18n"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+var tr = i18n.Tr
+
+// TestsFolder is the folder of the sketch containing the unit tests
+const TestsFolder = "test"
+
+// defaultArchitecture is the architecture used to resolve the libraries when
+// the target board is not known
+const defaultArchitecture = "host"
+
+// Test builds the unit tests of the sketch, in the "test" folder, together with
+// the .cpp and .h files of the sketch and a mock of the Arduino API, using the
+// compiler of the host system. Then runs them and returns their results.
+// The .ino files of the sketch are not built, since they contain the setup and
+// loop functions of the real program.
+// The libraries are discovered and resolved as in the build for the target board.
+func Test(ctx context.Context, req *rpc.TestRequest, outStream, errStream io.Writer) (*rpc.TestResponse, error) {
+	if commands.GetPackageManager(req.GetInstance().GetId()) == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	return runTests(ctx, req, outStream, errStream)
+}
+
+func runTests(ctx context.Context, req *rpc.TestRequest, outStream, errStream io.Writer) (*rpc.TestResponse, error) {
+	logrus.Tracef("Test %s started", req.GetSketchPath())
+	if req.GetSketchPath() == "" {
+		return nil, &arduino.MissingSketchPathError{}
+	}
+	sk, err := sketch.New(paths.New(req.GetSketchPath()))
+	if err != nil {
+		return nil, &arduino.CantOpenSketchError{Cause: err}
+	}
+	if !sk.FullPath.Join(TestsFolder).IsDir() {
+		return nil, &arduino.InvalidArgumentError{Message: tr("The sketch has no %s folder", TestsFolder)}
+	}
+
+	// The mock core takes the architecture of the target board
+	architecture := defaultArchitecture
+	fqbnIn := req.GetFqbn()
+	if fqbnIn == "" && sk.Metadata != nil {
+		fqbnIn = sk.Metadata.CPU.Fqbn
+	}
+	if fqbnIn != "" {
+		fqbn, err := cores.ParseFQBN(fqbnIn)
+		if err != nil {
+			return nil, &arduino.InvalidFQBNError{Cause: err}
+		}
+		architecture = fqbn.PlatformArch
+	}
+	hardwareDir := configuration.MockCoreHardwareDir(configuration.Settings)
+	if err := installMockCore(hardwareDir, architecture); err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Cannot install the mock core"), Cause: err}
+	}
+	mockFqbn, err := cores.ParseFQBN(mockCorePackage + ":" + architecture + ":" + mockCoreBoard)
+	if err != nil {
+		return nil, &arduino.InvalidFQBNError{Cause: err}
+	}
+
+	builderCtx := &types.Context{}
+	builderCtx.FQBN = mockFqbn
+	builderCtx.SketchLocation = sk.FullPath
+	builderCtx.HardwareDirs = paths.PathList{hardwareDir}
+	builderCtx.OtherLibrariesDirs = paths.NewPathList(req.GetLibraries()...)
+	builderCtx.OtherLibrariesDirs.Add(configuration.LibrariesDir(configuration.Settings))
+	builderCtx.LibraryDirs = paths.NewPathList(req.GetLibrary()...)
+	builderCtx.SketchExtraSourceFolders = []string{TestsFolder}
+	builderCtx.LibraryHeaderOverrides = configuration.LibraryHeaderOverrides(configuration.Settings)
+
+	// The build of the tests must not reuse the files of the build for the board
+	if req.GetBuildPath() == "" {
+		builderCtx.BuildPath = paths.New(sk.BuildPath.String() + "-test")
+	} else {
+		builderCtx.BuildPath = paths.New(req.GetBuildPath()).Canonical()
+	}
+	if err = builderCtx.BuildPath.MkdirAll(); err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Cannot create build directory"), Cause: err}
+	}
+
+	// The .ino files are replaced with empty ones
+	builderCtx.SourceOverride = map[string]string{}
+	for _, file := range append(paths.PathList{sk.MainFile}, sk.OtherSketchFiles...) {
+		relPath, err := sk.FullPath.RelTo(file)
+		if err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error reading the sketch"), Cause: err}
+		}
+		builderCtx.SourceOverride[relPath.String()] = ""
+	}
+
+	builderCtx.Verbose = req.GetVerbose()
+	builderCtx.Jobs = int(req.GetJobs())
+	builderCtx.WarningsLevel = req.GetWarnings()
+	builderCtx.DebugLevel = 5
+	builderCtx.CustomBuildProperties = []string{}
+	builderCtx.ArduinoAPIVersion = "10607"
+	builderCtx.ExecStdout = outStream
+	builderCtx.ExecStderr = errStream
+	builderCtx.SetLogger(legacyi18n.LoggerToCustomStreams{Stdout: outStream, Stderr: errStream})
+	builderCtx.SetContext(ctx)
+
+	if err := builder.RunBuilder(builderCtx); err != nil {
+		if ctx.Err() != nil {
+			return nil, &arduino.CanceledError{Message: tr("Build canceled"), Cause: ctx.Err()}
+		}
+		return nil, &arduino.CompileFailedError{Message: err.Error()}
+	}
+
+	executable := builderCtx.BuildPath.Join(builderCtx.BuildProperties.Get("build.project_name") + ".test")
+	resultsPath := builderCtx.BuildPath.Join("test-results.txt")
+	if err := resultsPath.Remove(); err != nil && resultsPath.Exist() {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Cannot remove the results of the previous run"), Cause: err}
+	}
+	cmd := exec.Command(executable.String(), resultsPath.String())
+	cmd.Dir = sk.FullPath.String()
+	cmd.Stdout = outStream
+	cmd.Stderr = errStream
+	runErr := executils.RunWithinContext(ctx, cmd)
+	if ctx.Err() != nil {
+		return nil, &arduino.CanceledError{Message: tr("Test run canceled"), Cause: ctx.Err()}
+	}
+	if _, isExitError := runErr.(*exec.ExitError); runErr != nil && !isExitError {
+		return nil, &arduino.FailedTestRunError{Message: tr("Cannot run the tests"), Cause: runErr}
+	}
+
+	resultsFile, err := resultsPath.Open()
+	if err != nil {
+		return nil, &arduino.FailedTestRunError{Message: tr("Cannot read the results of the tests"), Cause: err}
+	}
+	defer resultsFile.Close()
+	interrupted := ""
+	if runErr != nil {
+		interrupted = tr("The test terminated unexpectedly: %s", runErr)
+	}
+	results, err := parseResults(resultsFile, interrupted)
+	if err != nil {
+		return nil, &arduino.FailedTestRunError{Message: tr("Cannot read the results of the tests"), Cause: err}
+	}
+
+	res := &rpc.TestResponse{Results: results, Success: true}
+	for _, result := range results {
+		res.Success = res.Success && result.GetPassed()
+	}
+	if runErr != nil && res.Success {
+		// The runner failed outside of the tests
+		return nil, &arduino.FailedTestRunError{Message: tr("Error running the tests"), Cause: runErr}
+	}
+
+	if junitReport := req.GetJunitReport(); junitReport != "" {
+		if err := writeJUnitReport(paths.New(junitReport), sk.Name, results); err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error writing the JUnit report"), Cause: err}
+		}
+	}
+	return res, nil
+}
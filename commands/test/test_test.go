@@ -0,0 +1,115 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package test
+
+import (
+	"bytes"
+	"context"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseResults(t *testing.T) {
+	input := "run\tMath\tAdd\ttest/math_test.cpp\t3\n" +
+		"pass\tMath\tAdd\t12\n" +
+		"run\tMath\tSub\ttest/math_test.cpp\t7\n" +
+		"fail\tMath\tSub\t5\ttest/math_test.cpp:8: expected 1 == sub(2, 2), got 1 and 0\n" +
+		"run\tMath\tDiv\ttest/math_test.cpp\t11\n"
+	results, err := parseResults(strings.NewReader(input), "crashed")
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	require.Equal(t, "Math", results[0].GetSuite())
+	require.Equal(t, "Add", results[0].GetName())
+	require.Equal(t, "test/math_test.cpp", results[0].GetFile())
+	require.Equal(t, int64(3), results[0].GetLine())
+	require.Equal(t, int64(12), results[0].GetDurationUs())
+	require.True(t, results[0].GetPassed())
+
+	require.False(t, results[1].GetPassed())
+	require.Equal(t, "test/math_test.cpp:8: expected 1 == sub(2, 2), got 1 and 0", results[1].GetFailure())
+
+	require.False(t, results[2].GetPassed())
+	require.Equal(t, "crashed", results[2].GetFailure())
+}
+
+func TestJUnitReport(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "junit_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	report := tmp.Join("report.xml")
+	require.NoError(t, writeJUnitReport(report, "Sketch", []*rpc.TestCaseResult{
+		{Suite: "Math", Name: "Add", File: "test/math_test.cpp", Line: 3, Passed: true, DurationUs: 1500000},
+		{Suite: "Math", Name: "Sub", File: "test/math_test.cpp", Line: 7, Failure: "expected 1 < 0"},
+		{Suite: "Pins", Name: "Blink", File: "test/pins_test.cpp", Line: 2, Passed: true},
+	}))
+	data, err := report.ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="Sketch" tests="3" failures="1" time="1.500000">
+  <testsuite name="Math" tests="2" failures="1" time="1.500000">
+    <testcase name="Add" classname="Math" file="test/math_test.cpp" line="3" time="1.500000"></testcase>
+    <testcase name="Sub" classname="Math" file="test/math_test.cpp" line="7" time="0.000000">
+      <failure message="expected 1 &lt; 0">expected 1 &lt; 0</failure>
+    </testcase>
+  </testsuite>
+  <testsuite name="Pins" tests="1" failures="0" time="0.000000">
+    <testcase name="Blink" classname="Pins" file="test/pins_test.cpp" line="2" time="0.000000"></testcase>
+  </testsuite>
+</testsuites>
+`, string(data))
+}
+
+func TestRunTests(t *testing.T) {
+	if _, err := exec.LookPath("g++"); err != nil {
+		t.Skip("the host compiler is not available")
+	}
+	tmp, err := paths.MkTempDir("", "run_tests")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	configuration.Settings = configuration.Init("")
+	configuration.Settings.Set("directories.Data", tmp.Join("data").String())
+	configuration.Settings.Set("directories.User", tmp.Join("user").String())
+
+	out := &bytes.Buffer{}
+	res, err := runTests(context.Background(), &rpc.TestRequest{
+		SketchPath:  paths.New("testdata", "SketchWithTests").String(),
+		Fqbn:        "arduino:avr:uno",
+		BuildPath:   tmp.Join("build").String(),
+		JunitReport: tmp.Join("report.xml").String(),
+	}, out, out)
+	require.NoError(t, err, out.String())
+	require.False(t, res.GetSuccess())
+
+	require.Len(t, res.GetResults(), 3)
+	require.Equal(t, "StartsOff", res.GetResults()[0].GetName())
+	require.True(t, res.GetResults()[0].GetPassed())
+	require.Equal(t, "TogglesAfterPeriod", res.GetResults()[1].GetName())
+	require.True(t, res.GetResults()[1].GetPassed())
+	require.Equal(t, "WrongExpectation", res.GetResults()[2].GetName())
+	require.False(t, res.GetResults()[2].GetPassed())
+	require.Contains(t, res.GetResults()[2].GetFailure(), "expected HIGH == mock::digitalWritten(13), got 1 and 0")
+
+	require.True(t, tmp.Join("data", "internal", "mock-core", "mock", "avr", "platform.txt").Exist())
+	require.True(t, tmp.Join("report.xml").Exist())
+}
@@ -0,0 +1,14 @@
+#include "Blinker.h"
+
+void Blinker::begin() {
+  pinMode(pin, OUTPUT);
+  digitalWrite(pin, LOW);
+}
+
+void Blinker::update() {
+  if (millis() - lastToggle >= period) {
+    lastToggle = millis();
+    on = !on;
+    digitalWrite(pin, on ? HIGH : LOW);
+  }
+}
@@ -0,0 +1,19 @@
+#ifndef BLINKER_H
+#define BLINKER_H
+
+#include <Arduino.h>
+
+class Blinker {
+public:
+  Blinker(uint8_t pin, unsigned long period) : pin(pin), period(period) {}
+  void begin();
+  void update();
+
+private:
+  uint8_t pin;
+  unsigned long period;
+  unsigned long lastToggle = 0;
+  bool on = false;
+};
+
+#endif
@@ -0,0 +1,11 @@
+#include "Blinker.h"
+
+Blinker blinker(LED_BUILTIN, 500);
+
+void setup() {
+  blinker.begin();
+}
+
+void loop() {
+  blinker.update();
+}
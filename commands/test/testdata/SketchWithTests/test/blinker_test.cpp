@@ -0,0 +1,26 @@
+#include <ArduinoTest.h>
+
+#include "../Blinker.h"
+
+TEST(Blinker, StartsOff) {
+  Blinker blinker(13, 500);
+  blinker.begin();
+  ASSERT_EQ(OUTPUT, mock::pinModeOf(13));
+  ASSERT_EQ(LOW, mock::digitalWritten(13));
+}
+
+TEST(Blinker, TogglesAfterPeriod) {
+  Blinker blinker(13, 500);
+  blinker.begin();
+  mock::advanceMillis(500);
+  blinker.update();
+  ASSERT_EQ(HIGH, mock::digitalWritten(13));
+}
+
+TEST(Blinker, WrongExpectation) {
+  Blinker blinker(13, 500);
+  blinker.begin();
+  mock::advanceMillis(100);
+  blinker.update();
+  ASSERT_EQ(HIGH, mock::digitalWritten(13));
+}
@@ -35,6 +35,7 @@ func BurnBootloader(ctx context.Context, req *rpc.BurnBootloaderRequest, outStre
 	pm := commands.GetPackageManager(req.GetInstance().GetId())
 
 	err := runProgramAction(
+		ctx,
 		pm,
 		nil, // sketch
 		"",  // importFile
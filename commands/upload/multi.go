@@ -0,0 +1,136 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upload
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/commands/board"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// MultiUpload uploads the sketch on many boards at the same time, running an
+// Upload for every port. The output of the uploads is sent to responseCB,
+// together with the port it comes from, and the result of each upload is sent
+// when it's completed: the error of an upload doesn't stop the others. The
+// calls to responseCB are serialized.
+func MultiUpload(ctx context.Context, req *rpc.MultiUploadRequest, responseCB func(*rpc.MultiUploadResponse)) error {
+	uploadReq := req.GetUpload()
+	if uploadReq == nil {
+		return &arduino.InvalidArgumentError{Message: tr("Missing upload request")}
+	}
+	ports := req.GetPorts()
+	if req.GetAllMatchingBoards() {
+		if len(ports) > 0 {
+			return &arduino.InvalidArgumentError{Message: tr("Can't give the ports when uploading to all the matching boards")}
+		}
+		matchingPorts, err := findMatchingBoardsPorts(uploadReq, req.GetDiscoveryTimeout())
+		if err != nil {
+			return err
+		}
+		ports = matchingPorts
+	}
+	if len(ports) == 0 {
+		return &arduino.MissingPortError{}
+	}
+	addresses := map[string]bool{}
+	for _, port := range ports {
+		if addresses[port.GetAddress()] {
+			return &arduino.InvalidArgumentError{Message: tr("The port %s is given more than once", port.GetAddress())}
+		}
+		addresses[port.GetAddress()] = true
+	}
+
+	uploadToPort := func(port *rpc.Port, outStream, errStream io.Writer) error {
+		portReq := proto.Clone(uploadReq).(*rpc.UploadRequest)
+		portReq.Port = port
+		_, err := Upload(ctx, portReq, outStream, errStream)
+		return err
+	}
+	uploadToPorts(ports, uploadToPort, responseCB)
+	return nil
+}
+
+// uploadToPorts runs uploadToPort concurrently for all the ports, the output
+// and the result of each upload are sent to responseCB with serialized calls
+func uploadToPorts(ports []*rpc.Port, uploadToPort func(port *rpc.Port, outStream, errStream io.Writer) error, responseCB func(*rpc.MultiUploadResponse)) {
+	var mutex sync.Mutex
+	send := func(res *rpc.MultiUploadResponse) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		responseCB(res)
+	}
+	var wg sync.WaitGroup
+	for _, port := range ports {
+		wg.Add(1)
+		go func(port *rpc.Port) {
+			defer wg.Done()
+			outStream := &responseWriter{send: func(data []byte) { send(&rpc.MultiUploadResponse{Port: port, OutStream: data}) }}
+			errStream := &responseWriter{send: func(data []byte) { send(&rpc.MultiUploadResponse{Port: port, ErrStream: data}) }}
+			result := &rpc.MultiUploadPortResult{}
+			if err := uploadToPort(port, outStream, errStream); err != nil {
+				result.Error = err.Error()
+			}
+			send(&rpc.MultiUploadResponse{Port: port, Result: result})
+		}(port)
+	}
+	wg.Wait()
+}
+
+// findMatchingBoardsPorts returns the ports of the connected boards matching
+// the FQBN of the upload, the configuration options of the FQBN are ignored
+func findMatchingBoardsPorts(req *rpc.UploadRequest, timeout int64) ([]*rpc.Port, error) {
+	if req.GetFqbn() == "" {
+		return nil, &arduino.MissingFQBNError{}
+	}
+	fqbn, err := cores.ParseFQBN(req.GetFqbn())
+	if err != nil {
+		return nil, &arduino.InvalidFQBNError{Cause: err}
+	}
+	detectedPorts, err := board.List(&rpc.BoardListRequest{Instance: req.GetInstance(), Timeout: timeout})
+	if err != nil {
+		return nil, err
+	}
+	ports := []*rpc.Port{}
+	for _, detectedPort := range detectedPorts {
+		for _, matchingBoard := range detectedPort.GetMatchingBoards() {
+			if boardFqbn, err := cores.ParseFQBN(matchingBoard.GetFqbn()); err == nil && boardFqbn.StringWithoutConfig() == fqbn.StringWithoutConfig() {
+				ports = append(ports, detectedPort.GetPort())
+				break
+			}
+		}
+	}
+	if len(ports) == 0 {
+		return nil, &arduino.NotFoundError{Message: tr("No boards matching %s found", fqbn.StringWithoutConfig())}
+	}
+	return ports, nil
+}
+
+// responseWriter sends the data written to it, the data is copied since the
+// writers may reuse their buffer
+type responseWriter struct {
+	send func(data []byte)
+}
+
+func (w *responseWriter) Write(p []byte) (int, error) {
+	w.send(append([]byte{}, p...))
+	return len(p), nil
+}
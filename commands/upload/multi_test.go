@@ -0,0 +1,94 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upload
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiUploadRequestValidation(t *testing.T) {
+	noop := func(*rpc.MultiUploadResponse) {}
+	uploadReq := &rpc.UploadRequest{Fqbn: "alice:avr:board1"}
+
+	err := MultiUpload(context.Background(), &rpc.MultiUploadRequest{}, noop)
+	require.IsType(t, &arduino.InvalidArgumentError{}, err)
+
+	err = MultiUpload(context.Background(), &rpc.MultiUploadRequest{Upload: uploadReq}, noop)
+	require.IsType(t, &arduino.MissingPortError{}, err)
+
+	err = MultiUpload(context.Background(), &rpc.MultiUploadRequest{
+		Upload:            uploadReq,
+		Ports:             []*rpc.Port{{Address: "/dev/ttyACM0", Protocol: "serial"}},
+		AllMatchingBoards: true,
+	}, noop)
+	require.IsType(t, &arduino.InvalidArgumentError{}, err)
+
+	err = MultiUpload(context.Background(), &rpc.MultiUploadRequest{
+		Upload: uploadReq,
+		Ports: []*rpc.Port{
+			{Address: "/dev/ttyACM0", Protocol: "serial"},
+			{Address: "/dev/ttyACM0", Protocol: "serial"},
+		},
+	}, noop)
+	require.IsType(t, &arduino.InvalidArgumentError{}, err)
+}
+
+func TestUploadToPorts(t *testing.T) {
+	ports := []*rpc.Port{
+		{Address: "/dev/ttyACM0", Protocol: "serial"},
+		{Address: "/dev/ttyACM1", Protocol: "serial"},
+		{Address: "/dev/ttyACM2", Protocol: "serial"},
+	}
+	uploadToPort := func(port *rpc.Port, outStream, errStream io.Writer) error {
+		fmt.Fprintf(outStream, "uploading to %s\n", port.GetAddress())
+		fmt.Fprintf(outStream, "done %s\n", port.GetAddress())
+		if port.GetAddress() == "/dev/ttyACM1" {
+			fmt.Fprintf(errStream, "no device\n")
+			return fmt.Errorf("upload failed")
+		}
+		return nil
+	}
+
+	out := map[string]string{}
+	errOut := map[string]string{}
+	results := map[string]string{}
+	uploadToPorts(ports, uploadToPort, func(res *rpc.MultiUploadResponse) {
+		address := res.GetPort().GetAddress()
+		require.NotContains(t, results, address, "output sent after the result")
+		out[address] += string(res.GetOutStream())
+		errOut[address] += string(res.GetErrStream())
+		if res.GetResult() != nil {
+			results[address] = res.GetResult().GetError()
+		}
+	})
+
+	for _, port := range ports {
+		address := port.GetAddress()
+		require.Equal(t, "uploading to "+address+"\ndone "+address+"\n", out[address])
+		require.Contains(t, results, address)
+	}
+	require.Equal(t, "", results["/dev/ttyACM0"])
+	require.Equal(t, "upload failed", results["/dev/ttyACM1"])
+	require.Equal(t, "no device\n", errOut["/dev/ttyACM1"])
+	require.Equal(t, "", results["/dev/ttyACM2"])
+}
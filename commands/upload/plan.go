@@ -0,0 +1,144 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upload
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/sirupsen/logrus"
+)
+
+// secretFieldMask replaces the values of the secret user fields in the plans
+const secretFieldMask = "********"
+
+// UploadPlan returns the steps the upload would run, with the command lines
+// of the tools fully expanded, without running them. The board is not reset:
+// the reset is returned in the plan instead.
+func UploadPlan(ctx context.Context, req *rpc.UploadPlanRequest) (*rpc.UploadPlanResponse, error) {
+	uploadReq := req.GetUpload()
+	if uploadReq == nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Missing upload request")}
+	}
+	logrus.Tracef("Upload plan %s on %s started", uploadReq.GetSketchPath(), uploadReq.GetFqbn())
+
+	sketchPath := paths.New(uploadReq.GetSketchPath())
+	sk, err := sketch.New(sketchPath)
+	if err != nil && uploadReq.GetImportDir() == "" && uploadReq.GetImportFile() == "" && !req.GetBurnBootloader() {
+		return nil, &arduino.CantOpenSketchError{Cause: err}
+	}
+
+	pm := commands.GetPackageManager(uploadReq.GetInstance().GetId())
+	if pm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+
+	port := uploadReq.GetPort()
+	if port == nil {
+		port = &rpc.Port{}
+	}
+	prepared, err := prepareProgramAction(pm, sk,
+		uploadReq.GetImportFile(), uploadReq.GetImportDir(), uploadReq.GetFqbn(), port,
+		uploadReq.GetProgrammer(), uploadReq.GetVerbose(), uploadReq.GetVerify(), req.GetBurnBootloader(),
+		ioutil.Discard, uploadReq.GetUserFields())
+	if err != nil {
+		return nil, err
+	}
+	uploadProperties := prepared.properties.Clone()
+	// The commands are planned with the selected port, the upload port found
+	// after the reset is not known yet
+	setPortProperties(uploadProperties, port, port)
+	// The plan can be shown or logged, unless they're explicitly requested the
+	// secrets given by the user are left out of the properties and of the
+	// command lines
+	maskedFields := []string{}
+	if prepared.uploadToolPlatform != nil && !req.GetShowSecretFields() {
+		maskedFields = maskSecretUserFields(uploadProperties, prepared.action, prepared.uploadToolID, prepared.uploadToolPlatform)
+	}
+
+	res := &rpc.UploadPlanResponse{
+		Action:       prepared.action,
+		ToolId:       prepared.uploadToolID,
+		MaskedFields: maskedFields,
+	}
+	if prepared.uploadToolPlatform != nil && !prepared.uf2Upload {
+		res.ToolPlatform = prepared.uploadToolPlatform.String()
+	}
+	if reset := prepared.reset; reset != nil && reset.touch {
+		res.PortReset = &rpc.UploadPlanPortReset{
+			PortToTouch:       reset.portToTouch,
+			WaitForUploadPort: reset.wait,
+			WaitTimeout:       reset.opts.WaitTimeout.Milliseconds(),
+			SettleTime:        reset.opts.SettleTime.Milliseconds(),
+			TouchRetries:      int32(reset.opts.TouchRetries),
+		}
+	}
+	if prepared.uf2Upload {
+		res.Steps = append(res.Steps, &rpc.UploadPlanStep{Builtin: uf2UploadToolID})
+	}
+	for _, step := range prepared.steps() {
+		cmdLine, cmdArgs, err := toolCommandLine(step.recipeID, uploadProperties)
+		if err != nil {
+			return nil, &arduino.FailedUploadError{Message: step.errorMessage, Cause: err}
+		}
+		planStep := &rpc.UploadPlanStep{
+			Recipe:      step.recipeID,
+			CommandLine: cmdLine,
+			Args:        cmdArgs,
+		}
+		if len(maskedFields) > 0 {
+			planStep.MaskedArgs = maskedArgs(cmdArgs)
+		}
+		res.Steps = append(res.Steps, planStep)
+	}
+	res.Properties = uploadProperties.AsMap()
+	return res, nil
+}
+
+// maskSecretUserFields replaces the values of the secret user fields of the
+// tool, set in the properties for the action, with secretFieldMask. The
+// secret fields are masked even if they're not given, the plan doesn't leak
+// that they're empty. It returns the names of the masked fields.
+func maskSecretUserFields(props *properties.Map, action, toolID string, toolPlatform *cores.PlatformRelease) []string {
+	masked := []string{}
+	for _, field := range getUserFields(toolID, toolPlatform) {
+		if field.GetSecret() {
+			props.Set(fmt.Sprintf("%s.field.%s", action, field.GetName()), secretFieldMask)
+			masked = append(masked, field.GetName())
+		}
+	}
+	return masked
+}
+
+// maskedArgs returns the indexes of the args containing secretFieldMask
+func maskedArgs(args []string) []int32 {
+	var res []int32
+	for i, arg := range args {
+		if strings.Contains(arg, secretFieldMask) {
+			res = append(res, int32(i))
+		}
+	}
+	return res
+}
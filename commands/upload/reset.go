@@ -0,0 +1,111 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upload
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/discovery"
+	"github.com/arduino/arduino-cli/arduino/serialutils"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/sirupsen/logrus"
+)
+
+// resetBoard performs the 1200-bps touch of portToTouch and, if wait is set,
+// waits for the upload port. The address of the upload port is returned, or
+// the empty string if it isn't found. The upload port is detected using the
+// events of the discoveries, when they aren't available the serial ports of
+// the OS are polled instead.
+func resetBoard(pm *packagemanager.PackageManager, port *rpc.Port, portToTouch string, wait bool, opts *serialutils.ResetOptions, cb *serialutils.ResetProgressCallbacks, dryRun bool) (string, error) {
+	if dryRun || !wait || portToTouch == "" {
+		return serialutils.Reset(portToTouch, wait, opts, cb, dryRun)
+	}
+
+	dm := pm.DiscoveryManager()
+	if len(dm.IDs()) == 0 || dm.IsSyncing() {
+		// The events of the discoveries are not available, the discoveries are
+		// missing or they're already sending their events to someone else
+		return serialutils.Reset(portToTouch, wait, opts, cb, dryRun)
+	}
+	if errs := dm.RunAll(); len(errs) == len(dm.IDs()) {
+		logrus.WithField("phase", "board reset").Warnf("Discoveries not started, polling the serial ports: %v", errs)
+		return serialutils.Reset(portToTouch, wait, opts, cb, dryRun)
+	} else if len(errs) > 0 {
+		logrus.WithField("phase", "board reset").Warnf("Some discoveries not started: %v", errs)
+	}
+	events, errs := dm.StartSyncAll()
+	if len(errs) > 0 {
+		logrus.WithField("phase", "board reset").Warnf("Some discoveries not syncing: %v", errs)
+	}
+	defer func() {
+		// Quit all discoveries at the end.
+		if errs := dm.QuitAll(); len(errs) > 0 {
+			logrus.WithField("phase", "board reset").Errorf("Quitting discoveries: %v", errs)
+		}
+	}()
+
+	newPort, err := serialutils.ResetAndWatch(&discovery.Port{
+		Address:    portToTouch,
+		Protocol:   port.GetProtocol(),
+		Properties: properties.NewFromHashmap(port.GetProperties()),
+	}, events, opts, cb)
+	if err != nil || newPort == nil {
+		return "", err
+	}
+	return newPort.Address, nil
+}
+
+// resetOptions returns the options of the board reset, the defaults may be
+// changed with the upload properties:
+// - upload.wait_for_upload_port.timeout: the max time to wait for the upload port, in milliseconds
+// - upload.wait_for_upload_port.settle_time: the time the upload port must stay connected, in milliseconds
+// - upload.use_1200bps_touch.retries: the number of times the touch is repeated
+func resetOptions(uploadProperties *properties.Map) (*serialutils.ResetOptions, error) {
+	opts := serialutils.NewResetOptions()
+	getInt := func(property string, value *int) error {
+		if !uploadProperties.ContainsKey(property) {
+			return nil
+		}
+		v, err := strconv.Atoi(uploadProperties.Get(property))
+		if err != nil || v < 0 {
+			return &arduino.InvalidArgumentError{Message: tr("Invalid value for %[1]s: %[2]s", property, uploadProperties.Get(property))}
+		}
+		*value = v
+		return nil
+	}
+	getMilliseconds := func(property string, value *time.Duration) error {
+		ms := int(*value / time.Millisecond)
+		if err := getInt(property, &ms); err != nil {
+			return err
+		}
+		*value = time.Duration(ms) * time.Millisecond
+		return nil
+	}
+	if err := getMilliseconds("upload.wait_for_upload_port.timeout", &opts.WaitTimeout); err != nil {
+		return nil, err
+	}
+	if err := getMilliseconds("upload.wait_for_upload_port.settle_time", &opts.SettleTime); err != nil {
+		return nil, err
+	}
+	if err := getInt("upload.use_1200bps_touch.retries", &opts.TouchRetries); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
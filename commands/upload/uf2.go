@@ -0,0 +1,152 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upload
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/uf2"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// uf2UploadToolID is the upload tool of the boards uploaded copying the
+	// UF2 file on the drive of their bootloader
+	uf2UploadToolID = "builtin:uf2"
+	// uf2DriveTimeout is how long the drive is waited for, after the reset of
+	// the board it takes some seconds to be mounted
+	uf2DriveTimeout = 10 * time.Second
+)
+
+// findUF2Drives returns the mounted drives of UF2 bootloaders
+var findUF2Drives = uf2.FindDrives
+
+// uf2DrivesInUse are the drives where a UF2 file is being copied, guarded by
+// resetMutex
+var uf2DrivesInUse = map[string]bool{}
+
+// waitUF2Drive waits for the drive of the UF2 bootloader of the board, the
+// drives are recognized by the upload.uf2.volume_label property or by the
+// INFO_UF2.TXT file. If the board has been reset the drive is the one mounted
+// after the reset, knownDrives are the drives mounted before it. The drive
+// must be unique: the drives of the other uploads are skipped and, if the
+// board hasn't been reset, it fails while other UF2 uploads are running. In
+// a dry run it doesn't wait and it returns nil if the drive isn't found.
+// resetMutex must be held by the caller.
+func waitUF2Drive(ctx context.Context, label string, knownDrives paths.PathList, reset bool, outStream io.Writer, verbose, dryRun bool) (*paths.Path, error) {
+	if !reset && len(uf2DrivesInUse) > 0 {
+		return nil, fmt.Errorf(tr("another upload is copying to a UF2 drive, select the drive of the board as upload port"))
+	}
+	if verbose {
+		outStream.Write([]byte(fmt.Sprintln(tr("Waiting for UF2 drive..."))))
+	}
+	deadline := time.Now().Add(uf2DriveTimeout)
+	for {
+		candidates := paths.PathList{}
+		for _, drive := range findUF2Drives(label) {
+			if uf2DrivesInUse[drive.String()] || (reset && knownDrives.Contains(drive)) {
+				continue
+			}
+			candidates.Add(drive)
+		}
+		if len(candidates) == 1 {
+			return candidates[0], nil
+		}
+		if len(candidates) > 1 {
+			return nil, fmt.Errorf(tr("several UF2 drives found (%s), select the drive of the board as upload port"), strings.Join(candidates.AsStrings(), ", "))
+		}
+		if dryRun {
+			// The drive is mounted only after the reset of the board, that
+			// isn't done in a dry run
+			return nil, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf(tr("no UF2 drive found"))
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(250 * time.Millisecond):
+		}
+	}
+}
+
+// uploadUF2 copies the UF2 file of the build on the drive of a UF2
+// bootloader. The drive is the address of the port if its protocol is
+// "drive", otherwise it's the one found by waitUF2Drive, nil in a dry run if
+// it wasn't found. If the build doesn't have a UF2 file, the binary is
+// converted.
+func uploadUF2(uploadProperties *properties.Map, port *rpc.Port, drive *paths.Path, outStream io.Writer, verbose, dryRun bool) error {
+	buildPath := uploadProperties.GetPath("build.path")
+	projectName := uploadProperties.Get("build.project_name")
+	uf2File := buildPath.Join(projectName + ".uf2")
+	var data []byte
+	var err error
+	if uf2File.Exist() {
+		data, err = uf2File.ReadFile()
+	} else {
+		data, err = uf2.FromBuild(buildPath, projectName, uploadProperties)
+	}
+	if err != nil {
+		return err
+	}
+
+	if port.Protocol == uf2.DriveProtocol && port.Address != "" {
+		drive = paths.New(port.Address)
+		if !drive.IsDir() {
+			return fmt.Errorf(tr("drive %s not found"), drive)
+		}
+	}
+	if drive == nil {
+		if verbose {
+			outStream.Write([]byte(fmt.Sprintln(tr("Copying UF2 file to the UF2 drive"))))
+		}
+		return nil
+	}
+
+	target := drive.Join(projectName + ".uf2")
+	logrus.WithField("phase", "upload").Tracef("Copying UF2 file to %s", target)
+	if verbose {
+		outStream.Write([]byte(fmt.Sprintln(tr("Copying UF2 file to %s", target))))
+	}
+	if dryRun {
+		return nil
+	}
+	file, err := target.Create()
+	if err != nil {
+		return fmt.Errorf(tr("writing UF2 file: %s"), err)
+	}
+	// The board reboots when the whole file is written, the data is flushed
+	// to the drive before closing it
+	_, err = file.Write(data)
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf(tr("writing UF2 file: %s"), err)
+	}
+	return nil
+}
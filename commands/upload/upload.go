@@ -21,6 +21,7 @@ import (
 	"io"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/cores"
@@ -28,6 +29,7 @@ import (
 	"github.com/arduino/arduino-cli/arduino/globals"
 	"github.com/arduino/arduino-cli/arduino/serialutils"
 	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/arduino/uf2"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/executils"
 	"github.com/arduino/arduino-cli/i18n"
@@ -63,6 +65,9 @@ func SupportedUserFields(ctx context.Context, req *rpc.SupportedUserFieldsReques
 	}
 
 	toolID, err := getToolID(board.Properties, "upload", req.Protocol)
+	if req.Protocol == uf2.DriveProtocol {
+		toolID, err = uf2UploadToolID, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -130,6 +135,7 @@ func Upload(ctx context.Context, req *rpc.UploadRequest, outStream io.Writer, er
 	pm := commands.GetPackageManager(req.GetInstance().GetId())
 
 	if err := runProgramAction(
+		ctx,
 		pm,
 		sk,
 		req.GetImportFile(),
@@ -173,16 +179,69 @@ func UsingProgrammer(ctx context.Context, req *rpc.UploadUsingProgrammerRequest,
 	return &rpc.UploadUsingProgrammerResponse{}, err
 }
 
-func runProgramAction(pm *packagemanager.PackageManager,
+// resetMutex is held during the reset of a board and the wait for its upload
+// port or its UF2 drive
+var resetMutex sync.Mutex
+
+// programAction is an upload action ready to run: the upload tool with its
+// properties and the reset of the board to do before running it
+type programAction struct {
+	// action is "upload", "program" or "bootloader"
+	action             string
+	uploadToolID       string
+	uploadToolPlatform *cores.PlatformRelease
+	// uf2Upload is set if the UF2 file is copied on the drive of the bootloader
+	uf2Upload  bool
+	properties *properties.Map
+	// reset is set if the board is reset before the upload
+	reset *boardReset
+}
+
+// boardReset is the 1200-bps touch of the port done before the upload
+type boardReset struct {
+	touch       bool
+	portToTouch string
+	wait        bool
+	opts        *serialutils.ResetOptions
+}
+
+// programStep is a recipe run by an upload action
+type programStep struct {
+	recipeID string
+	// errorMessage is the message of the error returned if the recipe fails
+	errorMessage string
+}
+
+// steps returns the recipes run by the action, in order
+func (a *programAction) steps() []*programStep {
+	switch {
+	case a.action == "bootloader":
+		return []*programStep{
+			{recipeID: "erase.pattern", errorMessage: tr("Failed chip erase")},
+			{recipeID: "bootloader.pattern", errorMessage: tr("Failed to burn bootloader")},
+		}
+	case a.action == "program":
+		return []*programStep{{recipeID: "program.pattern", errorMessage: tr("Failed programming")}}
+	case a.uf2Upload:
+		// The UF2 file is copied without running recipes
+		return nil
+	default:
+		return []*programStep{{recipeID: "upload.pattern", errorMessage: tr("Failed uploading")}}
+	}
+}
+
+// prepareProgramAction finds the upload tool and builds its properties, the
+// board is not reset and the properties of the port are not set yet
+func prepareProgramAction(pm *packagemanager.PackageManager,
 	sk *sketch.Sketch,
 	importFile, importDir, fqbnIn string, port *rpc.Port,
 	programmerID string,
 	verbose, verify, burnBootloader bool,
-	outStream, errStream io.Writer,
-	dryRun bool, userFields map[string]string) error {
+	errStream io.Writer,
+	userFields map[string]string) (*programAction, error) {
 
 	if burnBootloader && programmerID == "" {
-		return &arduino.MissingProgrammerError{}
+		return nil, &arduino.MissingProgrammerError{}
 	}
 
 	logrus.WithField("port", port).Tracef("Upload port")
@@ -191,18 +250,18 @@ func runProgramAction(pm *packagemanager.PackageManager,
 		fqbnIn = sk.Metadata.CPU.Fqbn
 	}
 	if fqbnIn == "" {
-		return &arduino.MissingFQBNError{}
+		return nil, &arduino.MissingFQBNError{}
 	}
 	fqbn, err := cores.ParseFQBN(fqbnIn)
 	if err != nil {
-		return &arduino.InvalidFQBNError{Cause: err}
+		return nil, &arduino.InvalidFQBNError{Cause: err}
 	}
 	logrus.WithField("fqbn", fqbn).Tracef("Detected FQBN")
 
 	// Find target board and board properties
 	_, boardPlatform, board, boardProperties, buildPlatform, err := pm.ResolveFQBN(fqbn)
 	if err != nil {
-		return &arduino.UnknownFQBNError{Cause: err}
+		return nil, &arduino.UnknownFQBNError{Cause: err}
 	}
 	logrus.
 		WithField("boardPlatform", boardPlatform).
@@ -219,7 +278,7 @@ func runProgramAction(pm *packagemanager.PackageManager,
 			programmer = buildPlatform.Programmers[programmerID]
 		}
 		if programmer == nil {
-			return &arduino.ProgrammerNotFoundError{Programmer: programmerID}
+			return nil, &arduino.ProgrammerNotFoundError{Programmer: programmerID}
 		}
 	}
 
@@ -239,9 +298,14 @@ func runProgramAction(pm *packagemanager.PackageManager,
 		action = "program"
 	}
 	uploadToolID, err := getToolID(props, action, port.Protocol)
+	if action == "upload" && port.Protocol == uf2.DriveProtocol {
+		uploadToolID, err = uf2UploadToolID, nil
+	}
 	if err != nil {
-		return err
+		return nil, err
 	}
+	// The UF2 file is copied on the drive of the bootloader without tools
+	uf2Upload := action == "upload" && uploadToolID == uf2UploadToolID
 
 	var uploadToolPlatform *cores.PlatformRelease
 	if programmer != nil {
@@ -254,8 +318,10 @@ func runProgramAction(pm *packagemanager.PackageManager,
 		WithField("uploadToolPlatform", uploadToolPlatform).
 		Trace("Upload tool")
 
-	if split := strings.Split(uploadToolID, ":"); len(split) > 2 {
-		return &arduino.InvalidPlatformPropertyError{
+	if uf2Upload {
+		// The upload doesn't need a tool of a platform
+	} else if split := strings.Split(uploadToolID, ":"); len(split) > 2 {
+		return nil, &arduino.InvalidPlatformPropertyError{
 			Property: fmt.Sprintf("%s.tool.%s", action, port.Protocol), // TODO: Can be done better, maybe inline getToolID(...)
 			Value:    uploadToolID}
 	} else if len(split) == 2 {
@@ -304,8 +370,8 @@ func runProgramAction(pm *packagemanager.PackageManager,
 		uploadProperties.Set(fmt.Sprintf("%s.field.%s", action, name), value)
 	}
 
-	if !uploadProperties.ContainsKey("upload.protocol") && programmer == nil {
-		return &arduino.ProgrammerRequiredForUploadError{}
+	if !uploadProperties.ContainsKey("upload.protocol") && programmer == nil && !uf2Upload {
+		return nil, &arduino.ProgrammerRequiredForUploadError{}
 	}
 
 	// Set properties for verbose upload
@@ -353,35 +419,81 @@ func runProgramAction(pm *packagemanager.PackageManager,
 	if !burnBootloader {
 		importPath, sketchName, err := determineBuildPathAndSketchName(importFile, importDir, sk, fqbn)
 		if err != nil {
-			return &arduino.NotFoundError{Message: tr("Error finding build artifacts"), Cause: err}
+			return nil, &arduino.NotFoundError{Message: tr("Error finding build artifacts"), Cause: err}
 		}
 		if !importPath.Exist() {
-			return &arduino.NotFoundError{Message: tr("Compiled sketch not found in %s", importPath)}
+			return nil, &arduino.NotFoundError{Message: tr("Compiled sketch not found in %s", importPath)}
 		}
 		if !importPath.IsDir() {
-			return &arduino.NotFoundError{Message: tr("Expected compiled sketch in directory %s, but is a file instead", importPath)}
+			return nil, &arduino.NotFoundError{Message: tr("Expected compiled sketch in directory %s, but is a file instead", importPath)}
 		}
 		uploadProperties.SetPath("build.path", importPath)
 		uploadProperties.Set("build.project_name", sketchName)
 	}
 
+	prepared := &programAction{
+		action:             action,
+		uploadToolID:       uploadToolID,
+		uploadToolPlatform: uploadToolPlatform,
+		uf2Upload:          uf2Upload,
+		properties:         uploadProperties,
+	}
+
 	// If not using programmer perform some action required
 	// to set the board in bootloader mode
-	actualPort := port
 	if programmer == nil && !burnBootloader && port.Protocol == "serial" {
-
 		// Perform reset via 1200bps touch if requested and wait for upload port also if requested.
-		touch := uploadProperties.GetBoolean("upload.use_1200bps_touch")
-		wait := false
-		portToTouch := ""
-		if touch {
-			portToTouch = port.Address
+		reset := &boardReset{
+			touch: uploadProperties.GetBoolean("upload.use_1200bps_touch"),
+		}
+		if reset.touch {
+			reset.portToTouch = port.Address
 			// Waits for upload port only if a 1200bps touch is done
-			wait = uploadProperties.GetBoolean("upload.wait_for_upload_port")
+			reset.wait = uploadProperties.GetBoolean("upload.wait_for_upload_port")
 		}
+		reset.opts, err = resetOptions(uploadProperties)
+		if err != nil {
+			return nil, err
+		}
+		prepared.reset = reset
+	}
+	return prepared, nil
+}
+
+func runProgramAction(ctx context.Context, pm *packagemanager.PackageManager,
+	sk *sketch.Sketch,
+	importFile, importDir, fqbnIn string, port *rpc.Port,
+	programmerID string,
+	verbose, verify, burnBootloader bool,
+	outStream, errStream io.Writer,
+	dryRun bool, userFields map[string]string) error {
+
+	prepared, err := prepareProgramAction(pm, sk, importFile, importDir, fqbnIn, port, programmerID,
+		verbose, verify, burnBootloader, errStream, userFields)
+	if err != nil {
+		return err
+	}
+	uploadProperties := prepared.properties
+
+	// The new port is recognized among all the serial ports and the UF2 drive
+	// among the mounted drives, the resets of concurrent uploads are done one
+	// at a time to find the right ones
+	searchUF2Drive := prepared.uf2Upload && !(port.Protocol == uf2.DriveProtocol && port.Address != "")
+	if prepared.reset != nil || searchUF2Drive {
+		resetMutex.Lock()
+	}
+	uf2Label := uploadProperties.Get("upload.uf2.volume_label")
+	var knownUF2Drives paths.PathList
+	if searchUF2Drive {
+		knownUF2Drives = findUF2Drives(uf2Label)
+	}
 
+	// If not using programmer perform some action required
+	// to set the board in bootloader mode
+	actualPort := port
+	if reset := prepared.reset; reset != nil {
 		// if touch is requested but port is not specified, print a warning
-		if touch && portToTouch == "" {
+		if reset.touch && reset.portToTouch == "" {
 			outStream.Write([]byte(fmt.Sprintln(tr("Skipping 1200-bps touch reset: no serial port selected!"))))
 		}
 
@@ -417,7 +529,8 @@ func runProgramAction(pm *packagemanager.PackageManager,
 			},
 		}
 
-		if newPort, err := serialutils.Reset(portToTouch, wait, cb, dryRun); err != nil {
+		newPort, err := resetBoard(pm, port, reset.portToTouch, reset.wait, reset.opts, cb, dryRun)
+		if err != nil {
 			outStream.Write([]byte(fmt.Sprintln(tr("Cannot perform port reset: %s", err))))
 		} else {
 			if newPort != "" {
@@ -426,6 +539,48 @@ func runProgramAction(pm *packagemanager.PackageManager,
 		}
 	}
 
+	var uf2Drive *paths.Path
+	var uf2Err error
+	if searchUF2Drive {
+		boardReset := prepared.reset != nil && prepared.reset.touch && prepared.reset.portToTouch != ""
+		uf2Drive, uf2Err = waitUF2Drive(ctx, uf2Label, knownUF2Drives, boardReset, outStream, verbose, dryRun)
+		if uf2Drive != nil {
+			uf2DrivesInUse[uf2Drive.String()] = true
+			defer func() {
+				resetMutex.Lock()
+				delete(uf2DrivesInUse, uf2Drive.String())
+				resetMutex.Unlock()
+			}()
+		}
+	}
+	if prepared.reset != nil || searchUF2Drive {
+		resetMutex.Unlock()
+	}
+	if uf2Err != nil {
+		return toolError(ctx, tr("Failed uploading"), uf2Err)
+	}
+
+	setPortProperties(uploadProperties, port, actualPort)
+
+	// Run recipes for upload
+	if prepared.uf2Upload {
+		if err := uploadUF2(uploadProperties, actualPort, uf2Drive, outStream, verbose, dryRun); err != nil {
+			return toolError(ctx, tr("Failed uploading"), err)
+		}
+	}
+	for _, step := range prepared.steps() {
+		if err := runTool(ctx, step.recipeID, uploadProperties, outStream, errStream, verbose, dryRun); err != nil {
+			return toolError(ctx, step.errorMessage, err)
+		}
+	}
+
+	logrus.Tracef("Upload successful")
+	return nil
+}
+
+// setPortProperties sets the properties of the upload port, actualPort is the
+// port found after the reset of the board
+func setPortProperties(uploadProperties *properties.Map, port, actualPort *rpc.Port) {
 	if actualPort.Address != "" {
 		// Set serial port property
 		uploadProperties.Set("serial.port", actualPort.Address)
@@ -444,44 +599,45 @@ func runProgramAction(pm *packagemanager.PackageManager,
 	for prop, value := range actualPort.Properties {
 		uploadProperties.Set(fmt.Sprintf("upload.port.properties.%s", prop), value)
 	}
+}
 
-	// Run recipes for upload
-	if burnBootloader {
-		if err := runTool("erase.pattern", uploadProperties, outStream, errStream, verbose, dryRun); err != nil {
-			return &arduino.FailedUploadError{Message: tr("Failed chip erase"), Cause: err}
-		}
-		if err := runTool("bootloader.pattern", uploadProperties, outStream, errStream, verbose, dryRun); err != nil {
-			return &arduino.FailedUploadError{Message: tr("Failed to burn bootloader"), Cause: err}
-		}
-	} else if programmer != nil {
-		if err := runTool("program.pattern", uploadProperties, outStream, errStream, verbose, dryRun); err != nil {
-			return &arduino.FailedUploadError{Message: tr("Failed programming"), Cause: err}
-		}
-	} else {
-		if err := runTool("upload.pattern", uploadProperties, outStream, errStream, verbose, dryRun); err != nil {
-			return &arduino.FailedUploadError{Message: tr("Failed uploading"), Cause: err}
-		}
+// toolError converts the error of an upload tool, the tool has been killed by
+// ctx if canceled
+func toolError(ctx context.Context, message string, err error) error {
+	if ctx.Err() != nil {
+		return &arduino.CanceledError{Message: tr("Upload canceled"), Cause: ctx.Err()}
 	}
-
-	logrus.Tracef("Upload successful")
-	return nil
+	return &arduino.FailedUploadError{Message: message, Cause: err}
 }
 
-func runTool(recipeID string, props *properties.Map, outStream, errStream io.Writer, verbose bool, dryRun bool) error {
+// toolCommandLine expands the recipe in the command line of the tool, split
+// in arguments too. The arguments are nil if the recipe is empty.
+func toolCommandLine(recipeID string, props *properties.Map) (string, []string, error) {
 	recipe, ok := props.GetOk(recipeID)
 	if !ok {
-		return fmt.Errorf(tr("recipe not found '%s'"), recipeID)
+		return "", nil, fmt.Errorf(tr("recipe not found '%s'"), recipeID)
 	}
 	if strings.TrimSpace(recipe) == "" {
-		return nil // Nothing to run
+		return "", nil, nil // Nothing to run
 	}
 	if props.IsPropertyMissingInExpandPropsInString("serial.port", recipe) {
-		return fmt.Errorf(tr("no upload port provided"))
+		return "", nil, fmt.Errorf(tr("no upload port provided"))
 	}
 	cmdLine := props.ExpandPropsInString(recipe)
 	cmdArgs, err := properties.SplitQuotedString(cmdLine, `"'`, false)
 	if err != nil {
-		return fmt.Errorf(tr("invalid recipe '%[1]s': %[2]s"), recipe, err)
+		return "", nil, fmt.Errorf(tr("invalid recipe '%[1]s': %[2]s"), recipe, err)
+	}
+	return cmdLine, cmdArgs, nil
+}
+
+func runTool(ctx context.Context, recipeID string, props *properties.Map, outStream, errStream io.Writer, verbose bool, dryRun bool) error {
+	cmdLine, cmdArgs, err := toolCommandLine(recipeID, props)
+	if err != nil {
+		return err
+	}
+	if cmdArgs == nil {
+		return nil // Nothing to run
 	}
 
 	// Run Tool
@@ -500,11 +656,8 @@ func runTool(recipeID string, props *properties.Map, outStream, errStream io.Wri
 	cmd.RedirectStdoutTo(outStream)
 	cmd.RedirectStderrTo(errStream)
 
-	if err := cmd.Start(); err != nil {
-		return fmt.Errorf(tr("cannot execute upload tool: %s"), err)
-	}
-
-	if err := cmd.Wait(); err != nil {
+	// The tool is killed, together with the processes it starts, if the upload is canceled
+	if err := cmd.RunWithinContext(ctx); err != nil {
 		return fmt.Errorf(tr("uploading error: %s"), err)
 	}
 
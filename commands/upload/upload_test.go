@@ -17,13 +17,16 @@ package upload
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/arduino/uf2"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	paths "github.com/arduino/go-paths-helper"
 	properties "github.com/arduino/go-properties-orderedmap"
@@ -181,6 +184,7 @@ func TestUploadPropertiesComposition(t *testing.T) {
 		outStream := &bytes.Buffer{}
 		errStream := &bytes.Buffer{}
 		err := runProgramAction(
+			context.Background(),
 			pm,
 			nil,                     // sketch
 			"",                      // importFile
@@ -221,6 +225,120 @@ func TestUploadPropertiesComposition(t *testing.T) {
 	}
 }
 
+func TestUploadUF2(t *testing.T) {
+	pm := packagemanager.NewPackageManager(nil, nil, nil, nil)
+	errs := pm.LoadHardwareFromDirectory(paths.New("testdata", "hardware"))
+	require.Len(t, errs, 0)
+
+	buildPath, err := paths.MkTempDir("", "upload-test")
+	require.NoError(t, err)
+	defer buildPath.RemoveAll()
+	require.NoError(t, buildPath.Join("sketch.ino.bin").WriteFile([]byte{1, 2, 3, 4}))
+	drive := buildPath.Join("drive")
+	require.NoError(t, drive.MkdirAll())
+
+	upload := func(fqbn string, port *rpc.Port) (string, error) {
+		outStream := &bytes.Buffer{}
+		err := runProgramAction(context.Background(), pm, nil, "", buildPath.String(), fqbn, port,
+			"", true, false, false, outStream, &bytes.Buffer{}, false, map[string]string{})
+		return outStream.String(), err
+	}
+
+	// The binary is converted and copied on the drive
+	out, err := upload("alice:avr:board3", &rpc.Port{Address: drive.String(), Protocol: "drive"})
+	require.NoError(t, err)
+	require.Contains(t, out, "Copying UF2 file to "+drive.Join("sketch.ino.uf2").String())
+	data, err := drive.Join("sketch.ino.uf2").ReadFile()
+	require.NoError(t, err)
+	segments, familyID, err := uf2.Decode(data)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0xe48bff56), familyID)
+	require.Equal(t, uint32(0x2000), segments[0].Address)
+	require.Equal(t, []byte{1, 2, 3, 4}, segments[0].Data[:4])
+
+	// The UF2 file of the build is preferred
+	require.NoError(t, buildPath.Join("sketch.ino.uf2").WriteFile([]byte("uf2")))
+	_, err = upload("alice:avr:board3", &rpc.Port{Address: drive.String(), Protocol: "drive"})
+	require.NoError(t, err)
+	data, err = drive.Join("sketch.ino.uf2").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "uf2", string(data))
+
+	_, err = upload("alice:avr:board3", &rpc.Port{Address: buildPath.Join("missing").String(), Protocol: "drive"})
+	require.Error(t, err)
+
+	// The drive is not searched in a dry run, the board is not reset
+	outStream := &bytes.Buffer{}
+	err = runProgramAction(context.Background(), pm, nil, "", buildPath.String(), "alice:avr:board3",
+		&rpc.Port{Address: "/dev/ttyACM0", Protocol: "serial"},
+		"", true, false, false, outStream, &bytes.Buffer{}, true, map[string]string{})
+	require.NoError(t, err)
+	require.Contains(t, outStream.String(), "Copying UF2 file to the UF2 drive")
+}
+
+func TestWaitUF2Drive(t *testing.T) {
+	driveA := paths.New("/media", "RPI-RP2")
+	driveB := paths.New("/media", "RPI-RP2 1")
+	driveC := paths.New("/media", "RPI-RP2 2")
+	mounted := []paths.PathList{}
+	findUF2Drives = func(label string) paths.PathList {
+		res := mounted[0]
+		if len(mounted) > 1 {
+			mounted = mounted[1:]
+		}
+		return res
+	}
+	defer func() { findUF2Drives = uf2.FindDrives }()
+	wait := func(knownDrives paths.PathList, reset, dryRun bool) (*paths.Path, error) {
+		return waitUF2Drive(context.Background(), "RPI-RP2", knownDrives, reset, &bytes.Buffer{}, false, dryRun)
+	}
+
+	// After the reset the drive mounted before is skipped
+	mounted = []paths.PathList{{driveA}, {driveA}, {driveA, driveB}}
+	drive, err := wait(paths.PathList{driveA}, true, false)
+	require.NoError(t, err)
+	require.Equal(t, driveB, drive)
+
+	// The drives of the other uploads are skipped
+	uf2DrivesInUse[driveB.String()] = true
+	mounted = []paths.PathList{{driveA, driveB, driveC}}
+	drive, err = wait(paths.PathList{driveA}, true, false)
+	require.NoError(t, err)
+	require.Equal(t, driveC, drive)
+
+	// Without the reset the drive can't be told apart from the ones of the
+	// other uploads
+	mounted = []paths.PathList{{driveC}}
+	_, err = wait(nil, false, false)
+	require.Error(t, err)
+	delete(uf2DrivesInUse, driveB.String())
+
+	// The drive is never guessed
+	mounted = []paths.PathList{{driveA, driveB}}
+	_, err = wait(nil, false, false)
+	require.EqualError(t, err, fmt.Sprintf("several UF2 drives found (%s, %s), select the drive of the board as upload port", driveA, driveB))
+	mounted = []paths.PathList{{driveA, driveB, driveC}}
+	_, err = wait(paths.PathList{driveA}, true, false)
+	require.Error(t, err)
+
+	// Without the reset the mounted drive is used
+	mounted = []paths.PathList{{driveA}}
+	drive, err = wait(nil, false, false)
+	require.NoError(t, err)
+	require.Equal(t, driveA, drive)
+
+	// In a dry run it doesn't wait for the drive
+	mounted = []paths.PathList{{driveA}}
+	drive, err = wait(paths.PathList{driveA}, true, true)
+	require.NoError(t, err)
+	require.Nil(t, drive)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+	_, err = waitUF2Drive(ctx, "RPI-RP2", paths.PathList{driveA}, true, &bytes.Buffer{}, false, false)
+	require.Equal(t, context.DeadlineExceeded, err)
+}
+
 func TestGetToolId(t *testing.T) {
 	props, err := properties.LoadFromBytes([]byte(`
 bootloader.tool=avrdude
@@ -268,6 +386,38 @@ upload.tool.network=arduino_ota`))
 	require.Equal(t, "avrdude", toolID)
 }
 
+func TestMaskSecretUserFields(t *testing.T) {
+	platformRelease := &cores.PlatformRelease{}
+	props, err := properties.LoadFromBytes([]byte(`
+tools.arduino_ota.upload.field.username=Username
+tools.arduino_ota.upload.field.password=Password
+tools.arduino_ota.upload.field.password.secret=true
+tools.arduino_ota.upload.pattern=arduino_ota -u "{upload.field.username}" -p "{upload.field.password}"`))
+	require.NoError(t, err)
+	platformRelease.Properties = props
+
+	uploadProperties := props.SubTree("tools.arduino_ota")
+	uploadProperties.Set("upload.field.username", "user")
+	uploadProperties.Set("upload.field.password", "s3cret")
+	masked := maskSecretUserFields(uploadProperties, "upload", "arduino_ota", platformRelease)
+	require.Equal(t, []string{"password"}, masked)
+	require.Equal(t, "user", uploadProperties.Get("upload.field.username"))
+	require.Equal(t, "********", uploadProperties.Get("upload.field.password"))
+	cmdLine, cmdArgs, err := toolCommandLine("upload.pattern", uploadProperties)
+	require.NoError(t, err)
+	require.Equal(t, `arduino_ota -u "user" -p "********"`, cmdLine)
+	require.Equal(t, []int32{4}, maskedArgs(cmdArgs))
+
+	// The secret fields not given are masked too
+	uploadProperties = props.SubTree("tools.arduino_ota")
+	uploadProperties.Set("upload.field.username", "user")
+	masked = maskSecretUserFields(uploadProperties, "upload", "arduino_ota", platformRelease)
+	require.Equal(t, []string{"password"}, masked)
+	require.Equal(t, "********", uploadProperties.Get("upload.field.password"))
+
+	require.Nil(t, maskedArgs([]string{"arduino_ota", "-u", "user"}))
+}
+
 func TestGetUserFields(t *testing.T) {
 	platformRelease := &cores.PlatformRelease{}
 
@@ -345,3 +495,70 @@ func TestOverrideProtocolProperties(t *testing.T) {
 	require.Equal(t, res.Get("upload.unrelated_property"), "ok")
 
 }
+
+func TestResetOptions(t *testing.T) {
+	props := properties.NewMap()
+	opts, err := resetOptions(props)
+	require.NoError(t, err)
+	require.Equal(t, 10*time.Second, opts.WaitTimeout)
+	require.Equal(t, time.Second, opts.SettleTime)
+	require.Equal(t, 0, opts.TouchRetries)
+
+	props.Set("upload.wait_for_upload_port.timeout", "15000")
+	props.Set("upload.wait_for_upload_port.settle_time", "500")
+	props.Set("upload.use_1200bps_touch.retries", "2")
+	opts, err = resetOptions(props)
+	require.NoError(t, err)
+	require.Equal(t, 15*time.Second, opts.WaitTimeout)
+	require.Equal(t, 500*time.Millisecond, opts.SettleTime)
+	require.Equal(t, 2, opts.TouchRetries)
+
+	props.Set("upload.use_1200bps_touch.retries", "many")
+	_, err = resetOptions(props)
+	require.Error(t, err)
+}
+
+func TestPrepareProgramAction(t *testing.T) {
+	pm := packagemanager.NewPackageManager(nil, nil, nil, nil)
+	errs := pm.LoadHardwareFromDirectory(paths.New("testdata", "hardware"))
+	require.Len(t, errs, 0)
+	buildPath1 := paths.New("testdata", "build_path_1")
+	port := &rpc.Port{Address: "port", Protocol: "serial"}
+
+	prepared, err := prepareProgramAction(pm, nil, "", buildPath1.String(), "alice:avr:board1", port,
+		"", false, false, false, &bytes.Buffer{}, map[string]string{})
+	require.NoError(t, err)
+	require.Equal(t, "upload", prepared.action)
+	require.Equal(t, "one", prepared.uploadToolID)
+	require.Equal(t, "alice:avr@1.0.0", prepared.uploadToolPlatform.String())
+	require.NotNil(t, prepared.reset)
+	require.False(t, prepared.reset.touch)
+	steps := prepared.steps()
+	require.Len(t, steps, 1)
+	require.Equal(t, "upload.pattern", steps[0].recipeID)
+
+	// The command line is expanded without running the tool
+	setPortProperties(prepared.properties, port, port)
+	cmdLine, cmdArgs, err := toolCommandLine(steps[0].recipeID, prepared.properties)
+	require.NoError(t, err)
+	require.Equal(t, `echo conf-board1 conf-general conf-upload quiet noverify protocol "port" -bspeed "testdata/build_path_1/sketch.ino.hex"`, cmdLine)
+	require.Equal(t, []string{"echo", "conf-board1", "conf-general", "conf-upload", "quiet", "noverify", "protocol", "port", "-bspeed", "testdata/build_path_1/sketch.ino.hex"}, cmdArgs)
+
+	// Burn bootloader
+	prepared, err = prepareProgramAction(pm, nil, "", "", "alice:avr:board1", port,
+		"progr1", false, false, true, &bytes.Buffer{}, map[string]string{})
+	require.NoError(t, err)
+	require.Equal(t, "bootloader", prepared.action)
+	require.Nil(t, prepared.reset)
+	steps = prepared.steps()
+	require.Len(t, steps, 2)
+	require.Equal(t, "erase.pattern", steps[0].recipeID)
+	require.Equal(t, "bootloader.pattern", steps[1].recipeID)
+
+	// The UF2 upload doesn't run recipes
+	prepared, err = prepareProgramAction(pm, nil, "", buildPath1.String(), "alice:avr:board3", &rpc.Port{Address: "drive", Protocol: "drive"},
+		"", false, false, false, &bytes.Buffer{}, map[string]string{})
+	require.NoError(t, err)
+	require.True(t, prepared.uf2Upload)
+	require.Empty(t, prepared.steps())
+}
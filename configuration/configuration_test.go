@@ -136,3 +136,21 @@ func TestFindConfigFile(t *testing.T) {
 	configFile = FindConfigFileInArgsOrWorkingDirectory([]string{})
 	require.Equal(t, filepath.Join(target, "arduino-cli.yaml"), configFile)
 }
+
+func TestLibraryHeaderOverrides(t *testing.T) {
+	settings := Init("")
+	require.Empty(t, LibraryHeaderOverrides(settings))
+
+	SetLibraryHeaderOverride(settings, "Servo.h", "ServoESP32")
+	SetLibraryHeaderOverride(settings, "SD.h", "SdFat")
+	require.Equal(t, map[string]string{"Servo.h": "ServoESP32", "SD.h": "SdFat"}, LibraryHeaderOverrides(settings))
+
+	SetLibraryHeaderOverride(settings, "Servo.h", "MyServo")
+	require.Equal(t, map[string]string{"Servo.h": "MyServo", "SD.h": "SdFat"}, LibraryHeaderOverrides(settings))
+
+	SetLibraryHeaderOverride(settings, "SD.h", "")
+	require.Equal(t, map[string]string{"Servo.h": "MyServo"}, LibraryHeaderOverrides(settings))
+
+	settings.Set("library.header_overrides", []string{"Servo.h=MyServo", "invalid", "=Lib", "Wire.h="})
+	require.Equal(t, map[string]string{"Servo.h": "MyServo"}, LibraryHeaderOverrides(settings))
+}
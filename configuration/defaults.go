@@ -30,6 +30,7 @@ func SetDefaults(settings *viper.Viper) {
 
 	// Libraries
 	settings.SetDefault("library.enable_unsafe_install", false)
+	settings.SetDefault("library.header_overrides", []string{})
 
 	// Boards Manager
 	settings.SetDefault("board_manager.additional_urls", []string{})
@@ -42,6 +43,13 @@ func SetDefaults(settings *viper.Viper) {
 	// Sketch compilation
 	settings.SetDefault("sketch.always_export_binaries", false)
 
+	// Build cache
+	settings.SetDefault("build_cache.path", "")
+	settings.SetDefault("build_cache.remote_url", "")
+	settings.SetDefault("build_cache.remote_mode", "read-only")
+	settings.SetDefault("build_cache.objects_enabled", true)
+	settings.SetDefault("build_cache.objects_max_size", 1024)
+
 	// daemon settings
 	settings.SetDefault("daemon.port", "50051")
 
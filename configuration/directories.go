@@ -90,3 +90,30 @@ func LibrariesDir(settings *viper.Viper) *paths.Path {
 func PackagesDir(settings *viper.Viper) *paths.Path {
 	return paths.New(settings.GetString("directories.Data")).Join("packages")
 }
+
+// ProfilesPackagesDir returns the full path to the folder containing the platforms
+// and tools pinned by the sketch build profiles, kept apart from the packages folder
+func ProfilesPackagesDir(settings *viper.Viper) *paths.Path {
+	return paths.New(settings.GetString("directories.Data")).Join("internal", "packages")
+}
+
+// ProfilesLibrariesDir returns the full path to the folder containing the libraries
+// pinned by the sketch build profiles, kept apart from the user libraries
+func ProfilesLibrariesDir(settings *viper.Viper) *paths.Path {
+	return paths.New(settings.GetString("directories.Data")).Join("internal", "libraries")
+}
+
+// MockCoreHardwareDir returns the full path to the hardware folder containing the
+// mock core used to build the unit tests of the sketches for the host system
+func MockCoreHardwareDir(settings *viper.Viper) *paths.Path {
+	return paths.New(settings.GetString("directories.Data")).Join("internal", "mock-core")
+}
+
+// BuildCacheDir returns the full path to the folder containing the global caches
+// of the core archives and of the object files, shared between builds
+func BuildCacheDir(settings *viper.Viper) *paths.Path {
+	if dir := settings.GetString("build_cache.path"); dir != "" {
+		return paths.New(dir)
+	}
+	return paths.TempDir()
+}
@@ -0,0 +1,53 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package configuration
+
+import (
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// LibraryHeaderOverrides returns the libraries chosen by the user for the
+// headers provided by more libraries, as a map header -> library name
+func LibraryHeaderOverrides(settings *viper.Viper) map[string]string {
+	res := map[string]string{}
+	for _, entry := range settings.GetStringSlice("library.header_overrides") {
+		split := strings.SplitN(entry, "=", 2)
+		if len(split) != 2 || split[0] == "" || split[1] == "" {
+			logrus.Warnf("Invalid library override %q, it must be in the form header=library", entry)
+			continue
+		}
+		res[split[0]] = split[1]
+	}
+	return res
+}
+
+// SetLibraryHeaderOverride chooses the library for the given header, replacing
+// the previous choice. If library is empty the override of the header is removed.
+func SetLibraryHeaderOverride(settings *viper.Viper, header, library string) {
+	entries := []string{}
+	for _, entry := range settings.GetStringSlice("library.header_overrides") {
+		if !strings.HasPrefix(entry, header+"=") {
+			entries = append(entries, entry)
+		}
+	}
+	if library != "" {
+		entries = append(entries, header+"="+library)
+	}
+	settings.Set("library.header_overrides", entries)
+}
@@ -17,6 +17,7 @@ package executils
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"os/exec"
 
@@ -69,6 +70,34 @@ func call(stack []*exec.Cmd, pipes []*io.PipeWriter) (err error) {
 	return stack[0].Wait()
 }
 
+// RunWithinContext starts the command and waits for it to complete. If the given
+// context is canceled before the command terminates, the command is killed
+// together with all the processes it started, and the error of the context is
+// returned.
+func RunWithinContext(ctx context.Context, cmd *exec.Cmd) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	startInNewProcessGroup(cmd)
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	completed := make(chan struct{})
+	defer close(completed)
+	go func() {
+		select {
+		case <-ctx.Done():
+			killProcessGroup(cmd)
+		case <-completed:
+		}
+	}()
+	err := cmd.Wait()
+	if err != nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
 // TellCommandNotToSpawnShell avoids that the specified Cmd display a small
 // command prompt while runnning on Windows. It has no effects on other OS.
 func TellCommandNotToSpawnShell(cmd *exec.Cmd) {
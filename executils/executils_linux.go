@@ -15,7 +15,22 @@
 
 package executils
 
-import "os/exec"
+import (
+	"os/exec"
+	"syscall"
+)
 
 func tellCommandNotToSpawnShell(_ *exec.Cmd) {
 }
+
+func startInNewProcessGroup(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setpgid = true
+}
+
+func killProcessGroup(cmd *exec.Cmd) error {
+	// the process is the leader of its group, a negative pid kills the whole group
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
@@ -17,9 +17,27 @@ package executils
 
 import (
 	"os/exec"
+	"strconv"
 	"syscall"
 )
 
 func tellCommandNotToSpawnShell(oscmd *exec.Cmd) {
 	oscmd.SysProcAttr = &syscall.SysProcAttr{HideWindow: true}
 }
+
+func startInNewProcessGroup(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.CreationFlags |= syscall.CREATE_NEW_PROCESS_GROUP
+}
+
+func killProcessGroup(cmd *exec.Cmd) error {
+	// taskkill kills the process together with all the processes it started
+	kill := exec.Command("taskkill", "/T", "/F", "/PID", strconv.Itoa(cmd.Process.Pid))
+	tellCommandNotToSpawnShell(kill)
+	if err := kill.Run(); err != nil {
+		return cmd.Process.Kill()
+	}
+	return nil
+}
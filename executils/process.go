@@ -144,17 +144,8 @@ func (p *Process) SetEnvironment(values []string) {
 }
 
 // RunWithinContext starts the specified command and waits for it to complete. If the given context
-// is canceled before the normal process termination, the process is killed.
+// is canceled before the normal process termination, the process is killed together with all the
+// processes it started, and the error of the context is returned.
 func (p *Process) RunWithinContext(ctx context.Context) error {
-	completed := make(chan struct{})
-	defer close(completed)
-	go func() {
-		select {
-		case <-ctx.Done():
-			p.Kill()
-		case <-completed:
-		}
-	}()
-	res := p.cmd.Run()
-	return res
+	return RunWithinContext(ctx, p.cmd)
 }
@@ -17,6 +17,12 @@ package executils
 
 import (
 	"context"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -40,3 +46,31 @@ func TestProcessWithinContext(t *testing.T) {
 	require.Less(t, time.Since(start), 500*time.Millisecond)
 	cancel()
 }
+
+func TestRunWithinContextKillsChildren(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test uses a POSIX shell")
+	}
+	pidFile := filepath.Join(t.TempDir(), "pid")
+	// The shell waits for a child process, both must be killed
+	cmd := exec.Command("sh", "-c", "sleep 30 & echo $! > "+pidFile+"; wait")
+	ctx, cancel := context.WithTimeout(context.Background(), 250*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := RunWithinContext(ctx, cmd)
+	require.Equal(t, context.DeadlineExceeded, err)
+	require.Less(t, time.Since(start), 5*time.Second)
+
+	data, err := ioutil.ReadFile(pidFile)
+	require.NoError(t, err)
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		// the process may remain as a zombie until reaped by its new parent
+		status, _ := exec.Command("ps", "-o", "stat=", "-p", strconv.Itoa(pid)).Output()
+		return len(status) == 0 || status[0] == 'Z'
+	}, 2*time.Second, 50*time.Millisecond)
+
+	// A context already canceled doesn't start the command
+	require.Equal(t, context.DeadlineExceeded, RunWithinContext(ctx, exec.Command("true")))
+}
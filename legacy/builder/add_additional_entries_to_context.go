@@ -16,6 +16,7 @@
 package builder
 
 import (
+	"github.com/arduino/arduino-cli/arduino/builder/buildcache"
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/legacy/builder/constants"
 	"github.com/arduino/arduino-cli/legacy/builder/types"
@@ -57,6 +58,19 @@ func (*AddAdditionalEntriesToContext) Run(ctx *types.Context) error {
 		}
 
 		ctx.CoreBuildCachePath = coreBuildCachePath
+
+		objectsBuildCachePath, err := ctx.BuildCachePath.Join(constants.FOLDER_OBJECTS).Abs()
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		ctx.ObjectsBuildCachePath = objectsBuildCachePath
+	}
+
+	if ctx.ObjectsBuildCachePath != nil && ctx.ObjectsCache == nil && !ctx.ObjectsCacheDisabled {
+		ctx.ObjectsCache = buildcache.New(ctx.ObjectsBuildCachePath)
+		ctx.ObjectsCache.SetRemote(ctx.RemoteBuildCache)
+		ctx.ObjectsCache.SetMaxSize(ctx.ObjectsCacheMaxSize)
 	}
 
 	if ctx.WarningsLevel == "" {
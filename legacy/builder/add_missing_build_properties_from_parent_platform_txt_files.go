@@ -16,16 +16,24 @@
 package builder
 
 import (
-	"github.com/arduino/arduino-cli/legacy/builder/ctags"
 	"github.com/arduino/arduino-cli/legacy/builder/types"
+	properties "github.com/arduino/go-properties-orderedmap"
 )
 
+// defaultPlatformProperties are the platform properties that may be missing
+// from the platform.txt of old platforms
+var defaultPlatformProperties = properties.NewFromHashmap(map[string]string{
+	"tools.avrdude.path": "{runtime.tools.avrdude.path}",
+
+	"preproc.macros.flags": "-w -x c++ -E -CC",
+})
+
 type AddMissingBuildPropertiesFromParentPlatformTxtFiles struct{}
 
 func (s *AddMissingBuildPropertiesFromParentPlatformTxtFiles) Run(ctx *types.Context) error {
 	buildProperties := ctx.BuildProperties
 
-	newBuildProperties := ctags.CtagsProperties.Clone()
+	newBuildProperties := defaultPlatformProperties.Clone()
 	newBuildProperties.Merge(ArduinoPreprocessorProperties)
 	newBuildProperties.Merge(buildProperties)
 	ctx.BuildProperties = newBuildProperties
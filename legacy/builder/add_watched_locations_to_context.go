@@ -0,0 +1,49 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	"github.com/arduino/go-paths-helper"
+)
+
+// AddWatchedLocationsToContext fills ctx.WatchedLocations with the folders that
+// may be edited by the user: the sketch, the libraries passed explicitly to the
+// build and the used libraries installed in the user's sketchbook.
+// The libraries bundled with the platforms or the IDE are not watched.
+type AddWatchedLocationsToContext struct{}
+
+func (s *AddWatchedLocationsToContext) Run(ctx *types.Context) error {
+	watched := paths.NewPathList()
+	if ctx.SketchLocation != nil {
+		sketchFolder := ctx.SketchLocation
+		if !sketchFolder.IsDir() {
+			sketchFolder = sketchFolder.Parent()
+		}
+		watched.AddIfMissing(sketchFolder)
+	}
+	for _, dir := range ctx.LibraryDirs {
+		watched.AddIfMissing(dir)
+	}
+	for _, library := range ctx.ImportedLibraries {
+		if library.Location == libraries.User || library.Location == libraries.Unmanaged {
+			watched.AddIfMissing(library.InstallDir)
+		}
+	}
+	ctx.WatchedLocations = watched
+	return nil
+}
@@ -21,9 +21,11 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/arduino/arduino-cli/arduino/builder/buildtrace"
 	"github.com/arduino/arduino-cli/arduino/sketch"
 	"github.com/arduino/arduino-cli/i18n"
 	"github.com/arduino/arduino-cli/legacy/builder/builder_utils"
+	"github.com/arduino/arduino-cli/legacy/builder/constants"
 	"github.com/arduino/arduino-cli/legacy/builder/phases"
 	"github.com/arduino/arduino-cli/legacy/builder/types"
 	"github.com/arduino/arduino-cli/legacy/builder/utils"
@@ -47,6 +49,11 @@ func (s *Builder) Run(ctx *types.Context) error {
 		return err
 	}
 
+	// The sketch, the libraries and the core are compiled concurrently, the
+	// jobs only read the outputs of the context
+	setDefaultExecOutputs(ctx)
+	ctx.ResetScheduler()
+
 	commands := []types.Command{
 		&ContainerSetupHardwareToolsLibsSketchAndProps{},
 
@@ -60,6 +67,7 @@ func (s *Builder) Run(ctx *types.Context) error {
 
 		utils.LogIfVerbose("info", tr("Detecting libraries used...")),
 		&ContainerFindIncludes{},
+		&AddWatchedLocationsToContext{},
 
 		&WarnAboutArchIncompatibleLibraries{},
 
@@ -69,23 +77,30 @@ func (s *Builder) Run(ctx *types.Context) error {
 		utils.LogIfVerbose("info", tr("Compiling sketch...")),
 		&RecipeByPrefixSuffixRunner{Prefix: "recipe.hooks.sketch.prebuild", Suffix: ".pattern"},
 		&phases.SketchBuilder{},
+		&WaitScheduledCompilations{IfHooksPrefix: "recipe.hooks.sketch.postbuild"},
 		&RecipeByPrefixSuffixRunner{Prefix: "recipe.hooks.sketch.postbuild", Suffix: ".pattern", SkipIfOnlyUpdatingCompilationDatabase: true},
 
 		utils.LogIfVerbose("info", tr("Compiling libraries...")),
+		&WaitScheduledCompilations{IfHooksPrefix: "recipe.hooks.libraries.prebuild"},
 		&RecipeByPrefixSuffixRunner{Prefix: "recipe.hooks.libraries.prebuild", Suffix: ".pattern"},
 		&UnusedCompiledLibrariesRemover{},
 		&phases.LibrariesBuilder{},
+		&WaitScheduledCompilations{IfHooksPrefix: "recipe.hooks.libraries.postbuild"},
 		&RecipeByPrefixSuffixRunner{Prefix: "recipe.hooks.libraries.postbuild", Suffix: ".pattern", SkipIfOnlyUpdatingCompilationDatabase: true},
 
 		utils.LogIfVerbose("info", tr("Compiling core...")),
+		&WaitScheduledCompilations{IfHooksPrefix: "recipe.hooks.core.prebuild"},
 		&RecipeByPrefixSuffixRunner{Prefix: "recipe.hooks.core.prebuild", Suffix: ".pattern"},
 		&phases.CoreBuilder{},
+		&WaitScheduledCompilations{IfHooksPrefix: "recipe.hooks.core.postbuild"},
 		&RecipeByPrefixSuffixRunner{Prefix: "recipe.hooks.core.postbuild", Suffix: ".pattern", SkipIfOnlyUpdatingCompilationDatabase: true},
 
+		&WaitScheduledCompilations{},
 		utils.LogIfVerbose("info", tr("Linking everything together...")),
 		&RecipeByPrefixSuffixRunner{Prefix: "recipe.hooks.linking.prelink", Suffix: ".pattern"},
 		&phases.Linker{},
 		&RecipeByPrefixSuffixRunner{Prefix: "recipe.hooks.linking.postlink", Suffix: ".pattern", SkipIfOnlyUpdatingCompilationDatabase: true},
+		&phases.SizeReporter{},
 
 		&RecipeByPrefixSuffixRunner{Prefix: "recipe.hooks.objcopy.preobjcopy", Suffix: ".pattern"},
 		&RecipeByPrefixSuffixRunner{Prefix: "recipe.objcopy.", Suffix: ".pattern", SkipIfOnlyUpdatingCompilationDatabase: true},
@@ -93,15 +108,33 @@ func (s *Builder) Run(ctx *types.Context) error {
 
 		&MergeSketchWithBootloader{},
 
+		&ConvertBinaryToUF2{},
+
+		&ExportMergedImage{},
+
 		&RecipeByPrefixSuffixRunner{Prefix: "recipe.hooks.postbuild", Suffix: ".pattern", SkipIfOnlyUpdatingCompilationDatabase: true},
 	}
 
 	mainErr := runCommands(ctx, commands)
+	if mainErr != nil {
+		// Don't leave compilations running in the background
+		ctx.Scheduler.Stop(mainErr)
+		ctx.WaitScheduledCompilations()
+		ctx.Scheduler.Wait()
+	}
 
 	if ctx.CompilationDatabase != nil {
 		ctx.CompilationDatabase.SaveToFile()
 	}
 
+	// The objects cache is shared by all the builds, the least recently used
+	// objects are removed when it grows over its max size
+	if ctx.ObjectsCache != nil {
+		if err := ctx.ObjectsCache.Trim(); err != nil && ctx.Verbose {
+			ctx.GetLogger().Println(constants.LOG_LEVEL_WARN, tr("Error trimming the objects cache: {0}"), err)
+		}
+	}
+
 	commands = []types.Command{
 		&PrintUsedAndNotUsedLibraries{SketchError: mainErr != nil},
 
@@ -110,6 +143,8 @@ func (s *Builder) Run(ctx *types.Context) error {
 		&ExportProjectCMake{SketchError: mainErr != nil},
 
 		&phases.Sizer{SketchError: mainErr != nil},
+
+		&phases.SizeComparer{SketchError: mainErr != nil},
 	}
 	otherErr := runCommands(ctx, commands)
 
@@ -142,6 +177,7 @@ func (s *Preprocess) Run(ctx *types.Context) error {
 	if err := ctx.BuildPath.MkdirAll(); err != nil {
 		return err
 	}
+	setDefaultExecOutputs(ctx)
 
 	commands := []types.Command{
 		&ContainerSetupHardwareToolsLibsSketchAndProps{},
@@ -184,13 +220,43 @@ func (s *ParseHardwareAndDumpBuildProperties) Run(ctx *types.Context) error {
 	return runCommands(ctx, commands)
 }
 
+// LoadLibraries loads the libraries considered by the build for the board,
+// without building anything: the libraries resolver is set in the context
+type LoadLibraries struct{}
+
+func (s *LoadLibraries) Run(ctx *types.Context) error {
+	commands := []types.Command{
+		&HardwareLoader{},
+
+		&TargetBoardResolver{},
+
+		&LibrariesLoader{},
+	}
+
+	return runCommands(ctx, commands)
+}
+
+// setDefaultExecOutputs sends the output of the commands run by the build to
+// the standard output and error if no other output is set. It must be called
+// before the build starts, the commands are run concurrently.
+func setDefaultExecOutputs(ctx *types.Context) {
+	if ctx.ExecStdout == nil {
+		ctx.ExecStdout = os.Stdout
+	}
+	if ctx.ExecStderr == nil {
+		ctx.ExecStderr = os.Stderr
+	}
+}
+
 func runCommands(ctx *types.Context, commands []types.Command) error {
 	ctx.Progress.AddSubSteps(len(commands))
 	defer ctx.Progress.RemoveSubSteps()
 
 	for _, command := range commands {
 		PrintRingNameIfDebug(ctx, command)
+		endStep := ctx.Trace.Step(buildtrace.CategoryCommand, commandName(command))
 		err := command.Run(ctx)
+		endStep()
 		if err != nil {
 			return errors.WithStack(err)
 		}
@@ -202,8 +268,17 @@ func runCommands(ctx *types.Context, commands []types.Command) error {
 
 func PrintRingNameIfDebug(ctx *types.Context, command types.Command) {
 	if ctx.DebugLevel >= 10 {
-		ctx.GetLogger().Fprintln(os.Stdout, "debug", "Ts: {0} - Running: {1}", strconv.FormatInt(time.Now().Unix(), 10), reflect.Indirect(reflect.ValueOf(command)).Type().Name())
+		ctx.GetLogger().Fprintln(os.Stdout, "debug", "Ts: {0} - Running: {1}", strconv.FormatInt(time.Now().Unix(), 10), commandName(command))
+	}
+}
+
+// commandName returns the name of the command shown in the logs and in the
+// build trace, the recipe runners are identified by the recipes they run
+func commandName(command types.Command) string {
+	if runner, ok := command.(*RecipeByPrefixSuffixRunner); ok {
+		return "RecipeByPrefixSuffixRunner(" + runner.Prefix + "*" + runner.Suffix + ")"
 	}
+	return reflect.Indirect(reflect.ValueOf(command)).Type().Name()
 }
 
 func RunBuilder(ctx *types.Context) error {
@@ -220,3 +295,8 @@ func RunPreprocess(ctx *types.Context) error {
 	command := Preprocess{}
 	return command.Run(ctx)
 }
+
+func RunLoadLibraries(ctx *types.Context) error {
+	command := LoadLibraries{}
+	return command.Run(ctx)
+}
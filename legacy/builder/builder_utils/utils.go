@@ -16,14 +16,19 @@
 package builder_utils
 
 import (
+	"bytes"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
-	"runtime"
 	"strconv"
 	"strings"
-	"sync"
 
+	"github.com/arduino/arduino-cli/arduino/builder/buildcache"
+	"github.com/arduino/arduino-cli/arduino/builder/buildtrace"
+	"github.com/arduino/arduino-cli/arduino/builder/diagnostics"
+	"github.com/arduino/arduino-cli/arduino/builder/scheduler"
+	"github.com/arduino/arduino-cli/executils"
 	"github.com/arduino/arduino-cli/i18n"
 	"github.com/arduino/arduino-cli/legacy/builder/constants"
 	"github.com/arduino/arduino-cli/legacy/builder/types"
@@ -43,12 +48,65 @@ func PrintProgressIfProgressEnabledAndMachineLogger(ctx *types.Context) {
 
 	log := ctx.GetLogger()
 	if log.Name() == "machine" {
-		log.Println(constants.LOG_LEVEL_INFO, tr("Progress {0}"), strconv.FormatFloat(float64(ctx.Progress.Progress), 'f', 2, 32))
+		log.Println(constants.LOG_LEVEL_INFO, tr("Progress {0}"), strconv.FormatFloat(float64(ctx.Progress.Current()), 'f', 2, 32))
 	}
 }
 
+// ScheduledFiles are the files that will be produced by some scheduled jobs
+type ScheduledFiles struct {
+	// Job completes when all the files have been produced
+	Job *scheduler.Job
+	// groups of files, each group is sorted when the files are returned
+	groups []paths.PathList
+}
+
+// Wait waits for the completion of the jobs and returns the produced files
+func (f *ScheduledFiles) Wait() (paths.PathList, error) {
+	if err := f.Job.Wait(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	res := paths.NewPathList()
+	for _, group := range f.groups {
+		files := group.Clone()
+		files.Sort()
+		res.AddAll(files)
+	}
+	return res, nil
+}
+
+// AvailableFiles returns a ScheduledFiles for files that are already available
+func AvailableFiles(ctx *types.Context, files paths.PathList) *ScheduledFiles {
+	return &ScheduledFiles{
+		Job:    ctx.GetScheduler().Barrier(),
+		groups: []paths.PathList{files},
+	}
+}
+
+// MergeScheduledFiles returns the ScheduledFiles containing all the given ones, in order
+func MergeScheduledFiles(ctx *types.Context, files ...*ScheduledFiles) *ScheduledFiles {
+	res := &ScheduledFiles{}
+	jobs := []*scheduler.Job{}
+	for _, f := range files {
+		jobs = append(jobs, f.Job)
+		res.groups = append(res.groups, f.groups...)
+	}
+	res.Job = ctx.GetScheduler().Barrier(jobs...)
+	return res
+}
+
 func CompileFilesRecursive(ctx *types.Context, sourcePath *paths.Path, buildPath *paths.Path, buildProperties *properties.Map, includes []string) (paths.PathList, error) {
-	objectFiles, err := CompileFiles(ctx, sourcePath, false, buildPath, buildProperties, includes)
+	scheduled, err := ScheduleCompileFilesRecursive(ctx, sourcePath, buildPath, buildProperties, includes)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return scheduled.Wait()
+}
+
+// ScheduleCompileFilesRecursive schedules the compilation of the source files in
+// sourcePath and in all its subfolders, each subfolder is compiled in the
+// corresponding subfolder of buildPath.
+func ScheduleCompileFilesRecursive(ctx *types.Context, sourcePath *paths.Path, buildPath *paths.Path, buildProperties *properties.Map, includes []string) (*ScheduledFiles, error) {
+	scheduled, err := ScheduleCompileFiles(ctx, sourcePath, false, buildPath, buildProperties, includes)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -58,18 +116,30 @@ func CompileFilesRecursive(ctx *types.Context, sourcePath *paths.Path, buildPath
 		return nil, errors.WithStack(err)
 	}
 
+	all := []*ScheduledFiles{scheduled}
 	for _, folder := range folders {
-		subFolderObjectFiles, err := CompileFilesRecursive(ctx, sourcePath.Join(folder.Name()), buildPath.Join(folder.Name()), buildProperties, includes)
+		subFolderScheduled, err := ScheduleCompileFilesRecursive(ctx, sourcePath.Join(folder.Name()), buildPath.Join(folder.Name()), buildProperties, includes)
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
-		objectFiles.AddAll(subFolderObjectFiles)
+		all = append(all, subFolderScheduled)
 	}
 
-	return objectFiles, nil
+	return MergeScheduledFiles(ctx, all...), nil
 }
 
 func CompileFiles(ctx *types.Context, sourcePath *paths.Path, recurse bool, buildPath *paths.Path, buildProperties *properties.Map, includes []string) (paths.PathList, error) {
+	scheduled, err := ScheduleCompileFiles(ctx, sourcePath, recurse, buildPath, buildProperties, includes)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return scheduled.Wait()
+}
+
+// ScheduleCompileFiles schedules the compilation of the source files in sourcePath
+// using the Scheduler of the context. The compilation runs concurrently with
+// all the other jobs of the build.
+func ScheduleCompileFiles(ctx *types.Context, sourcePath *paths.Path, recurse bool, buildPath *paths.Path, buildProperties *properties.Map, includes []string) (*ScheduledFiles, error) {
 	sSources, err := findFilesInFolder(sourcePath, ".S", recurse)
 	if err != nil {
 		return nil, errors.WithStack(err)
@@ -86,24 +156,16 @@ func CompileFiles(ctx *types.Context, sourcePath *paths.Path, recurse bool, buil
 	ctx.Progress.AddSubSteps(len(sSources) + len(cSources) + len(cppSources))
 	defer ctx.Progress.RemoveSubSteps()
 
-	sObjectFiles, err := compileFilesWithRecipe(ctx, sourcePath, sSources, buildPath, buildProperties, includes, constants.RECIPE_S_PATTERN)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-	cObjectFiles, err := compileFilesWithRecipe(ctx, sourcePath, cSources, buildPath, buildProperties, includes, constants.RECIPE_C_PATTERN)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-	cppObjectFiles, err := compileFilesWithRecipe(ctx, sourcePath, cppSources, buildPath, buildProperties, includes, constants.RECIPE_CPP_PATTERN)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
+	// The build properties and the includes may be changed by the build phases
+	// while the compilation is running
+	buildProperties = buildProperties.Clone()
+	includes = append([]string{}, includes...)
 
-	objectFiles := paths.NewPathList()
-	objectFiles.AddAll(sObjectFiles)
-	objectFiles.AddAll(cObjectFiles)
-	objectFiles.AddAll(cppObjectFiles)
-	return objectFiles, nil
+	return MergeScheduledFiles(ctx,
+		scheduleFilesWithRecipe(ctx, sourcePath, sSources, buildPath, buildProperties, includes, constants.RECIPE_S_PATTERN),
+		scheduleFilesWithRecipe(ctx, sourcePath, cSources, buildPath, buildProperties, includes, constants.RECIPE_C_PATTERN),
+		scheduleFilesWithRecipe(ctx, sourcePath, cppSources, buildPath, buildProperties, includes, constants.RECIPE_CPP_PATTERN),
+	), nil
 }
 
 func findFilesInFolder(sourcePath *paths.Path, extension string, recurse bool) (paths.PathList, error) {
@@ -165,74 +227,34 @@ func findAllFilesInFolder(sourcePath string, recurse bool) ([]string, error) {
 	return sources, nil
 }
 
-func compileFilesWithRecipe(ctx *types.Context, sourcePath *paths.Path, sources paths.PathList, buildPath *paths.Path, buildProperties *properties.Map, includes []string, recipe string) (paths.PathList, error) {
-	objectFiles := paths.NewPathList()
-	if len(sources) == 0 {
-		return objectFiles, nil
-	}
-	var objectFilesMux sync.Mutex
-	var errorsList []error
-	var errorsMux sync.Mutex
-
-	queue := make(chan *paths.Path)
-	job := func(source *paths.Path) {
-		objectFile, err := compileFileWithRecipe(ctx, sourcePath, source, buildPath, buildProperties, includes, recipe)
-		if err != nil {
-			errorsMux.Lock()
-			errorsList = append(errorsList, err)
-			errorsMux.Unlock()
-		} else {
-			objectFilesMux.Lock()
-			objectFiles.Add(objectFile)
-			objectFilesMux.Unlock()
-		}
-	}
-
-	// Spawn jobs runners
-	var wg sync.WaitGroup
-	jobs := ctx.Jobs
-	if jobs == 0 {
-		jobs = runtime.NumCPU()
-	}
-	for i := 0; i < jobs; i++ {
-		wg.Add(1)
-		go func() {
-			for source := range queue {
-				job(source)
+func scheduleFilesWithRecipe(ctx *types.Context, sourcePath *paths.Path, sources paths.PathList, buildPath *paths.Path, buildProperties *properties.Map, includes []string, recipe string) *ScheduledFiles {
+	sched := ctx.GetScheduler()
+	// Each job stores its result in its own slot
+	objectFiles := make(paths.PathList, len(sources))
+	jobs := []*scheduler.Job{}
+	for i, source := range sources {
+		i, source := i, source
+		// The step is counted in the progress once the file is compiled
+		stepDone := ctx.Progress.ScheduleStep()
+		jobs = append(jobs, sched.Add(func() error {
+			objectFile, err := compileFileWithRecipe(ctx, sourcePath, source, buildPath, buildProperties, includes, recipe)
+			if err != nil {
+				return errors.WithStack(err)
 			}
-			wg.Done()
-		}()
-	}
-
-	// Feed jobs until error or done
-	for _, source := range sources {
-		errorsMux.Lock()
-		gotError := len(errorsList) > 0
-		errorsMux.Unlock()
-		if gotError {
-			break
-		}
-		queue <- source
-
-		ctx.Progress.CompleteStep()
-		PrintProgressIfProgressEnabledAndMachineLogger(ctx)
+			objectFiles[i] = objectFile
+			stepDone()
+			PrintProgressIfProgressEnabledAndMachineLogger(ctx)
+			return nil
+		}))
 	}
-	close(queue)
-	wg.Wait()
-	if len(errorsList) > 0 {
-		// output the first error
-		return nil, errors.WithStack(errorsList[0])
+	return &ScheduledFiles{
+		Job:    sched.Barrier(jobs...),
+		groups: []paths.PathList{objectFiles},
 	}
-	objectFiles.Sort()
-	return objectFiles, nil
 }
 
 func compileFileWithRecipe(ctx *types.Context, sourcePath *paths.Path, source *paths.Path, buildPath *paths.Path, buildProperties *properties.Map, includes []string, recipe string) (*paths.Path, error) {
 	logger := ctx.GetLogger()
-	properties := buildProperties.Clone()
-	properties.Set(constants.BUILD_PROPERTIES_COMPILER_WARNING_FLAGS, properties.Get(constants.BUILD_PROPERTIES_COMPILER_WARNING_FLAGS+"."+ctx.WarningsLevel))
-	properties.Set(constants.BUILD_PROPERTIES_INCLUDES, strings.Join(includes, constants.SPACE))
-	properties.SetPath(constants.BUILD_PROPERTIES_SOURCE_FILE, source)
 	relativeSource, err := sourcePath.RelTo(source)
 	if err != nil {
 		return nil, errors.WithStack(err)
@@ -240,7 +262,6 @@ func compileFileWithRecipe(ctx *types.Context, sourcePath *paths.Path, source *p
 	depsFile := buildPath.Join(relativeSource.String() + ".d")
 	objectFile := buildPath.Join(relativeSource.String() + ".o")
 
-	properties.SetPath(constants.BUILD_PROPERTIES_OBJECT_FILE, objectFile)
 	err = objectFile.Parent().MkdirAll()
 	if err != nil {
 		return nil, errors.WithStack(err)
@@ -250,18 +271,72 @@ func compileFileWithRecipe(ctx *types.Context, sourcePath *paths.Path, source *p
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	command, err := PrepareCommandForRecipe(properties, recipe, false)
+	command, err := PrepareCompileCommand(ctx, source, objectFile, buildPath, buildProperties, includes, recipe)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 	if ctx.CompilationDatabase != nil {
-		ctx.CompilationDatabase.Add(source, command)
+		ctx.CompilationDatabase.Add(source, objectFile, command)
 	}
 	if !objIsUpToDate && !ctx.OnlyUpdateCompilationDatabase {
-		_, _, err = utils.ExecCommand(ctx, command, utils.ShowIfVerbose /* stdout */, utils.Show /* stderr */)
+		defer ctx.Trace.Job(buildtrace.CategoryCompile, source.String())()
+
+		var cacheUnit *buildcache.Unit
+		if ctx.ObjectsCache != nil {
+			cacheUnit = &buildcache.Unit{
+				Source:      source,
+				ObjectFile:  objectFile,
+				DepFile:     depsFile,
+				CommandLine: command.Args,
+				BuildPath:   ctx.BuildPath,
+			}
+			if !ctx.Clean {
+				if restored, err := ctx.ObjectsCache.Restore(cacheUnit); err != nil {
+					logger.Println(constants.LOG_LEVEL_WARN, tr("Error reading cached object file for {0}: {1}"), source, err)
+				} else if restored {
+					if ctx.Verbose {
+						logger.Println(constants.LOG_LEVEL_INFO, tr("Using cached object file: {0}"), objectFile)
+					}
+					return objectFile, nil
+				}
+			}
+			// Remove the stale dependency file, to be sure that the one stored
+			// in the cache is produced by this compilation
+			depsFile.Remove()
+		}
+
+		// The fix-it hints are added to the diagnostics only, the flag doesn't
+		// change the object file and is left out of the compilation database
+		// and of the cache key
+		if compilerSupportsFlag(ctx, command, fixItsFlag) {
+			command.Args = append(command.Args, fixItsFlag)
+		}
+		// The compiler output is streamed to the build output and kept to
+		// extract the diagnostics. The files are compiled concurrently: the
+		// default of ctx.ExecStderr is set before the build starts and only
+		// read here.
+		compilerStderr := newDiagnosticsWriter(ctx.ExecStderr)
+		command.Stderr = compilerStderr
+		_, _, err := utils.ExecCommand(ctx, command, utils.ShowIfVerbose /* stdout */, utils.Ignore /* stderr, already set */)
+		compilerStderr.Flush()
+		if output := compilerStderr.Bytes(); len(output) > 0 {
+			ctx.AddCompilerDiagnostics(diagnostics.ParseCompilerOutput(output))
+		}
 		if err != nil {
+			// A compiler killed because the build has been canceled may leave a
+			// partially written object file, that would look up to date to the
+			// next build
+			objectFile.Remove()
+			depsFile.Remove()
 			return nil, errors.WithStack(err)
 		}
+
+		// Objects without a dependency file can't be safely reused
+		if cacheUnit != nil && depsFile.Exist() {
+			if err := ctx.ObjectsCache.Store(cacheUnit); err != nil && ctx.Verbose {
+				logger.Println(constants.LOG_LEVEL_WARN, tr("Unable to cache object file {0}: {1}"), objectFile, err)
+			}
+		}
 	} else if ctx.Verbose {
 		if objIsUpToDate {
 			logger.Println(constants.LOG_LEVEL_INFO, tr("Using previously compiled file: {0}"), objectFile)
@@ -273,6 +348,103 @@ func compileFileWithRecipe(ctx *types.Context, sourcePath *paths.Path, source *p
 	return objectFile, nil
 }
 
+// PrepareCompileCommand returns the command that compiles the source into the
+// objectFile with the given recipe, as run by the build.
+func PrepareCompileCommand(ctx *types.Context, source, objectFile *paths.Path, buildPath *paths.Path, buildProperties *properties.Map, includes []string, recipe string) (*exec.Cmd, error) {
+	properties := buildProperties.Clone()
+	properties.Set(constants.BUILD_PROPERTIES_COMPILER_WARNING_FLAGS, ctx.WarningFlags(properties, buildPath))
+	properties.Set(constants.BUILD_PROPERTIES_INCLUDES, strings.Join(includes, constants.SPACE))
+	properties.SetPath(constants.BUILD_PROPERTIES_SOURCE_FILE, source)
+	properties.SetPath(constants.BUILD_PROPERTIES_OBJECT_FILE, objectFile)
+	command, err := PrepareCommandForRecipe(properties, recipe, false)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if ctx.Reproducible {
+		// the flags are added to the command line, instead of being left to the
+		// recipes, to work with every platform: the flags not supported by
+		// older compilers are left out
+		reproducibleArgs, err := reproducibleFlags(properties)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		for _, arg := range reproducibleArgs {
+			if compilerSupportsFlag(ctx, command, arg) {
+				command.Args = append(command.Args, arg)
+			}
+		}
+	}
+	return command, nil
+}
+
+// fixItsFlag makes GCC and Clang print the fix-it hints in a format that can
+// be parsed, added to the diagnostics
+const fixItsFlag = "-fdiagnostics-parseable-fixits"
+
+// compilerSupportsFlag returns if the compiler of the command accepts the
+// flag, older compilers fail on unknown flags
+func compilerSupportsFlag(ctx *types.Context, command *exec.Cmd, flag string) bool {
+	return ctx.CompilerSupportsFlag(command.Path, flag, func() bool {
+		// Preprocess an empty C source, read from the null device
+		probe := exec.Command(command.Path, flag, "-E", "-x", "c", "-")
+		if err := executils.RunWithinContext(ctx.GetContext(), probe); err != nil {
+			if ctx.Verbose {
+				ctx.GetLogger().Println(constants.LOG_LEVEL_WARN, tr("The compiler {0} doesn't support {1}"), command.Path, flag)
+			}
+			return false
+		}
+		return true
+	})
+}
+
+// diagnosticsWriter streams the compiler output to the build output without
+// the fix-it lines, that are meant only for the diagnostics. All the output,
+// fix-it lines included, is kept to parse the diagnostics. The output is
+// written line by line to not mix the lines of parallel compilers.
+type diagnosticsWriter struct {
+	out    io.Writer
+	output bytes.Buffer
+	line   []byte
+}
+
+func newDiagnosticsWriter(out io.Writer) *diagnosticsWriter {
+	return &diagnosticsWriter{out: out}
+}
+
+func (w *diagnosticsWriter) Write(p []byte) (int, error) {
+	w.output.Write(p)
+	w.line = append(w.line, p...)
+	for {
+		i := bytes.IndexByte(w.line, '\n')
+		if i == -1 {
+			break
+		}
+		w.writeLine(w.line[:i+1])
+		w.line = w.line[i+1:]
+	}
+	return len(p), nil
+}
+
+// Flush writes the last line, if not terminated by a new line
+func (w *diagnosticsWriter) Flush() {
+	if len(w.line) > 0 {
+		w.writeLine(w.line)
+		w.line = nil
+	}
+}
+
+// Bytes returns all the output of the compiler
+func (w *diagnosticsWriter) Bytes() []byte {
+	return w.output.Bytes()
+}
+
+func (w *diagnosticsWriter) writeLine(line []byte) {
+	if bytes.HasPrefix(line, []byte("fix-it:")) {
+		return
+	}
+	w.out.Write(line)
+}
+
 func ObjFileIsUpToDate(ctx *types.Context, sourceFile, objectFile, dependencyFile *paths.Path) (bool, error) {
 	logger := ctx.GetLogger()
 	debugLevel := ctx.DebugLevel
@@ -467,6 +639,29 @@ func TXTBuildRulesHaveChanged(corePath, targetCorePath, targetFile *paths.Path)
 	return true
 }
 
+// ScheduleArchiveCompiledFiles schedules the creation of an archive containing
+// the given object files, as soon as they are compiled.
+func ScheduleArchiveCompiledFiles(ctx *types.Context, buildPath *paths.Path, archiveFile *paths.Path, objectFilesToArchive *ScheduledFiles, buildProperties *properties.Map) *ScheduledFiles {
+	buildProperties = buildProperties.Clone()
+	archive := make(paths.PathList, 1)
+	job := ctx.GetScheduler().Add(func() error {
+		objectFiles, err := objectFilesToArchive.Wait()
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		archiveFilePath, err := ArchiveCompiledFiles(ctx, buildPath, archiveFile, objectFiles, buildProperties)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		archive[0] = archiveFilePath
+		return nil
+	}, objectFilesToArchive.Job)
+	return &ScheduledFiles{
+		Job:    job,
+		groups: []paths.PathList{archive},
+	}
+}
+
 func ArchiveCompiledFiles(ctx *types.Context, buildPath *paths.Path, archiveFile *paths.Path, objectFilesToArchive paths.PathList, buildProperties *properties.Map) (*paths.Path, error) {
 	logger := ctx.GetLogger()
 	archiveFilePath := buildPath.JoinPath(archiveFile)
@@ -502,6 +697,7 @@ func ArchiveCompiledFiles(ctx *types.Context, buildPath *paths.Path, archiveFile
 		}
 	}
 
+	defer ctx.Trace.Job(buildtrace.CategoryArchive, archiveFilePath.String())()
 	for _, objectFile := range objectFilesToArchive {
 		properties := buildProperties.Clone()
 		properties.Set(constants.BUILD_PROPERTIES_ARCHIVE_FILE, archiveFilePath.Base())
@@ -515,6 +711,8 @@ func ArchiveCompiledFiles(ctx *types.Context, buildPath *paths.Path, archiveFile
 
 		_, _, err = utils.ExecCommand(ctx, command, utils.ShowIfVerbose /* stdout */, utils.Show /* stderr */)
 		if err != nil {
+			// Don't leave an incomplete archive, it would look up to date to the next build
+			archiveFilePath.Remove()
 			return nil, errors.WithStack(err)
 		}
 	}
@@ -524,6 +722,12 @@ func ArchiveCompiledFiles(ctx *types.Context, buildPath *paths.Path, archiveFile
 
 const COMMANDLINE_LIMIT = 30000
 
+// reproducibleFlags returns the compiler flags needed for a reproducible build
+func reproducibleFlags(buildProperties *properties.Map) ([]string, error) {
+	flags := buildProperties.ExpandPropsInString(buildProperties.Get(constants.BUILD_PROPERTIES_COMPILER_REPRODUCIBLE_FLAGS))
+	return properties.SplitQuotedString(flags, `"'`, false)
+}
+
 func PrepareCommandForRecipe(buildProperties *properties.Map, recipe string, removeUnsetProperties bool) (*exec.Cmd, error) {
 	pattern := buildProperties.Get(recipe)
 	if pattern == "" {
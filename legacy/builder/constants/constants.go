@@ -28,6 +28,7 @@ const BUILD_PROPERTIES_COMPILER_C_ELF_FLAGS = "compiler.c.elf.flags"
 const BUILD_PROPERTIES_COMPILER_LDFLAGS = "compiler.ldflags"
 const BUILD_PROPERTIES_COMPILER_CPP_FLAGS = "compiler.cpp.flags"
 const BUILD_PROPERTIES_COMPILER_WARNING_FLAGS = "compiler.warning_flags"
+const BUILD_PROPERTIES_COMPILER_REPRODUCIBLE_FLAGS = "compiler.reproducible_flags"
 const BUILD_PROPERTIES_FQBN = "build.fqbn"
 const BUILD_PROPERTIES_INCLUDES = "includes"
 const BUILD_PROPERTIES_OBJECT_FILE = "object_file"
@@ -38,12 +39,12 @@ const BUILD_PROPERTIES_RUNTIME_PLATFORM_PATH = "runtime.platform.path"
 const BUILD_PROPERTIES_SOURCE_FILE = "source_file"
 const BUILD_PROPERTIES_TOOLS_KEY = "tools"
 const BUILD_PROPERTIES_VID = "vid"
-const CTAGS = "ctags"
 const EMPTY_STRING = ""
-const FILE_CTAGS_TARGET_FOR_GCC_MINUS_E = "ctags_target_for_gcc_minus_e.cpp"
+const FILE_SKETCH_TARGET_FOR_GCC_MINUS_E = "sketch_target_for_gcc_minus_e.cpp"
 const FILE_PLATFORM_KEYS_REWRITE_TXT = "platform.keys.rewrite.txt"
 const FOLDER_BOOTLOADERS = "bootloaders"
 const FOLDER_CORE = "core"
+const FOLDER_OBJECTS = "objects"
 const FOLDER_PREPROC = "preproc"
 const FOLDER_SKETCH = "sketch"
 const FOLDER_TOOLS = "tools"
@@ -29,7 +29,7 @@ func (s *ContainerAddPrototypes) Run(ctx *types.Context) error {
 	if err := ctx.PreprocPath.MkdirAll(); err != nil {
 		return errors.WithStack(err)
 	}
-	targetFilePath := ctx.PreprocPath.Join(constants.FILE_CTAGS_TARGET_FOR_GCC_MINUS_E)
+	targetFilePath := ctx.PreprocPath.Join(constants.FILE_SKETCH_TARGET_FOR_GCC_MINUS_E)
 
 	// Run preprocessor
 	sourceFile := ctx.SketchBuildPath.Join(ctx.Sketch.MainFile.Base() + ".cpp")
@@ -48,8 +48,7 @@ func (s *ContainerAddPrototypes) Run(ctx *types.Context) error {
 	commands := []types.Command{
 		&ReadFileAndStoreInContext{FileToRead: targetFilePath, Target: &ctx.SourceGccMinusE},
 		&FilterSketchSource{Source: &ctx.SourceGccMinusE},
-		&CTagsTargetFileSaver{Source: &ctx.SourceGccMinusE, TargetFileName: constants.FILE_CTAGS_TARGET_FOR_GCC_MINUS_E},
-		&CTagsRunner{},
+		&PrototypesGenerator{Source: &ctx.SourceGccMinusE},
 		&PrototypesAdder{},
 	}
 
@@ -99,6 +99,7 @@ import (
 	"time"
 
 	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/sketch"
 	"github.com/arduino/arduino-cli/legacy/builder/builder_utils"
 	"github.com/arduino/arduino-cli/legacy/builder/constants"
 	"github.com/arduino/arduino-cli/legacy/builder/types"
@@ -136,8 +137,7 @@ func (s *ContainerFindIncludes) findIncludes(ctx *types.Context) error {
 
 	sourceFilePaths := ctx.CollectedSourceFiles
 	queueSourceFilesFromFolder(ctx, sourceFilePaths, sketch, ctx.SketchBuildPath, false /* recurse */)
-	srcSubfolderPath := ctx.SketchBuildPath.Join("src")
-	if srcSubfolderPath.IsDir() {
+	for _, srcSubfolderPath := range ctx.SketchSourceFolders() {
 		queueSourceFilesFromFolder(ctx, sourceFilePaths, sketch, srcSubfolderPath, true /* recurse */)
 	}
 
@@ -373,7 +373,7 @@ func findIncludesUntilDone(ctx *types.Context, cache *includeCache, sourceFile t
 		library := ResolveLibrary(ctx, include)
 		if library == nil {
 			// Library could not be resolved, show error
-			// err := runCommand(ctx, &GCCPreprocRunner{SourceFilePath: sourcePath, TargetFileName: paths.New(constants.FILE_CTAGS_TARGET_FOR_GCC_MINUS_E), Includes: includes})
+			// err := runCommand(ctx, &GCCPreprocRunner{SourceFilePath: sourcePath, TargetFileName: paths.New(constants.FILE_SKETCH_TARGET_FOR_GCC_MINUS_E), Includes: includes})
 			// return errors.WithStack(err)
 			if preproc_err == nil || preproc_stderr == nil {
 				// Filename came from cache, so run preprocessor to obtain error to show
@@ -390,6 +390,10 @@ func findIncludesUntilDone(ctx *types.Context, cache *includeCache, sourceFile t
 			return errors.WithStack(preproc_err)
 		}
 
+		if ctx.RecordIncludeGraph {
+			addIncludeGraphEdge(ctx, sourceFile, include)
+		}
+
 		// Add this library to the list of libraries, the
 		// include path and queue its source files for further
 		// include scanning
@@ -403,6 +407,27 @@ func findIncludesUntilDone(ctx *types.Context, cache *includeCache, sourceFile t
 	}
 }
 
+// addIncludeGraphEdge records the resolution of the include found in the
+// given source file. The files of the sketch are reported with their path in
+// the sketch folder, instead of the one of their copy in the build path.
+func addIncludeGraphEdge(ctx *types.Context, sourceFile types.SourceFile, include string) {
+	edge := &types.IncludeGraphEdge{
+		SourceFile: sourceFile.SourcePath(ctx),
+		Resolution: ctx.LibrariesResolutionResults[include].Resolution,
+	}
+	switch origin := sourceFile.Origin.(type) {
+	case *libraries.Library:
+		edge.SourceLibrary = origin
+	case *sketch.Sketch:
+		if sourceFile.RelativePath.String() == origin.MainFile.Base()+".cpp" {
+			edge.SourceFile = origin.MainFile
+		} else {
+			edge.SourceFile = origin.FullPath.JoinPath(sourceFile.RelativePath)
+		}
+	}
+	ctx.IncludeGraph = append(ctx.IncludeGraph, edge)
+}
+
 func queueSourceFilesFromFolder(ctx *types.Context, queue *types.UniqueSourceFileQueue, origin interface{}, folder *paths.Path, recurse bool) error {
 	extensions := func(ext string) bool { return ADDITIONAL_FILE_VALID_EXTENSIONS_NO_HEADERS[ext] }
 
@@ -0,0 +1,52 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"github.com/arduino/arduino-cli/arduino/uf2"
+	"github.com/arduino/arduino-cli/legacy/builder/constants"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	"github.com/pkg/errors"
+)
+
+// ConvertBinaryToUF2 writes the {build.project_name}.uf2 file, to be copied
+// on the drive of a UF2 bootloader, if the board sets build.uf2.family_id
+type ConvertBinaryToUF2 struct{}
+
+func (s *ConvertBinaryToUF2) Run(ctx *types.Context) error {
+	if ctx.OnlyUpdateCompilationDatabase {
+		return nil
+	}
+
+	buildProperties := ctx.BuildProperties
+	if !buildProperties.ContainsKey(uf2.FamilyIDProperty) {
+		return nil
+	}
+
+	projectName := buildProperties.Get("build.project_name")
+	data, err := uf2.FromBuild(ctx.BuildPath, projectName, buildProperties)
+	if err != nil {
+		return errors.WithMessage(err, tr("Error converting the binary to UF2"))
+	}
+	uf2Path := ctx.BuildPath.Join(projectName + ".uf2")
+	if err := uf2Path.WriteFile(data); err != nil {
+		return errors.WithStack(err)
+	}
+	if ctx.Verbose {
+		ctx.GetLogger().Println(constants.LOG_LEVEL_INFO, tr("Created UF2 file {0}"), uf2Path)
+	}
+	return nil
+}
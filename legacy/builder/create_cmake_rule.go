@@ -113,7 +113,7 @@ func (s *ExportProjectCMake) Run(ctx *types.Context) error {
 		fmt.Println(err)
 	}
 
-	// Use old ctags method to generate export file
+	// Use the built-in prototypes generator to generate export file
 	commands := []types.Command{
 		//&ContainerMergeCopySketchFiles{},
 		&ContainerAddPrototypes{},
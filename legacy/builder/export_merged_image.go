@@ -0,0 +1,176 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/firmware"
+	"github.com/arduino/arduino-cli/arduino/uf2"
+	"github.com/arduino/arduino-cli/legacy/builder/constants"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	paths "github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/pkg/errors"
+)
+
+const (
+	// imageBaseAddressProperty is the address of the {build.project_name}.bin
+	// file, used if the .hex file is missing
+	imageBaseAddressProperty = "build.image.base_address"
+	// imageBootloaderOffsetProperty is the address of the bootloader, used if
+	// the bootloader is a .bin file without the .hex version
+	imageBootloaderOffsetProperty = "build.image.bootloader.offset"
+	// imageMergeProperty lists the images merged with the sketch, as
+	// build.image.merge.N.file and build.image.merge.N.offset
+	imageMergeProperty = "build.image.merge"
+)
+
+// ExportMergedImage writes the {build.project_name}.merged.FORMAT images,
+// for the formats set in ExportFormats: a single image with the sketch, the
+// bootloader of the board and the images listed by the build.image.merge.N.file
+// properties, each one at the offset set by build.image.merge.N.offset.
+type ExportMergedImage struct{}
+
+func (s *ExportMergedImage) Run(ctx *types.Context) error {
+	if ctx.OnlyUpdateCompilationDatabase || len(ctx.ExportFormats) == 0 {
+		return nil
+	}
+
+	buildProperties := ctx.BuildProperties
+	projectName := buildProperties.Get("build.project_name")
+	img, err := loadSketchImage(ctx.BuildPath, projectName, buildProperties)
+	if err != nil {
+		return errors.WithMessage(err, tr("Error exporting the merged image"))
+	}
+
+	if buildProperties.ContainsKey(constants.BUILD_PROPERTIES_BOOTLOADER_NOBLINK) || buildProperties.ContainsKey(constants.BUILD_PROPERTIES_BOOTLOADER_FILE) {
+		bootloaderPath, offset, err := bootloaderImage(buildProperties)
+		if err != nil {
+			return errors.WithMessage(err, tr("Error merging the bootloader"))
+		}
+		if bootloaderPath.NotExist() {
+			// The merged image is written anyway, without the bootloader
+			ctx.GetLogger().Println(constants.LOG_LEVEL_WARN, tr("Bootloader file specified but missing: {0}"), bootloaderPath)
+		} else if err := mergeImage(img, bootloaderPath, offset); err != nil {
+			return errors.WithMessage(err, tr("Error merging the bootloader"))
+		}
+	}
+
+	mergeProperties := buildProperties.SubTree(imageMergeProperty)
+	keys := mergeProperties.FirstLevelKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		// Sort the numbered images by number
+		a, errA := strconv.Atoi(keys[i])
+		b, errB := strconv.Atoi(keys[j])
+		if errA == nil && errB == nil {
+			return a < b
+		}
+		return keys[i] < keys[j]
+	})
+	for _, key := range keys {
+		file := buildProperties.ExpandPropsInString(mergeProperties.Get(key + ".file"))
+		offset := uint32(0)
+		if offsetValue, ok := mergeProperties.GetOk(key + ".offset"); ok {
+			if offset, err = firmware.ParseAddress(buildProperties.ExpandPropsInString(offsetValue)); err != nil {
+				return errors.WithMessage(err, tr("Invalid %s", imageMergeProperty+"."+key+".offset"))
+			}
+		}
+		if err := mergeImage(img, paths.New(file), offset); err != nil {
+			return errors.WithMessage(err, tr("Error merging the image %s", file))
+		}
+	}
+
+	opts := &firmware.EncodeOptions{FillByte: firmware.DefaultFillByte}
+	if familyIDValue, ok := buildProperties.GetOk(uf2.FamilyIDProperty); ok {
+		familyID, err := firmware.ParseAddress(familyIDValue)
+		if err != nil {
+			return errors.WithMessage(err, tr("Invalid %s", uf2.FamilyIDProperty))
+		}
+		opts.FamilyID = &familyID
+	}
+	for _, format := range ctx.ExportFormats {
+		imagePath := ctx.BuildPath.Join(projectName + ".merged." + format)
+		if err := img.Save(imagePath, format, opts); err != nil {
+			return errors.WithMessage(err, tr("Error exporting the merged image"))
+		}
+		if ctx.Verbose {
+			ctx.GetLogger().Println(constants.LOG_LEVEL_INFO, tr("Created merged image {0}"), imagePath)
+		}
+	}
+	return nil
+}
+
+// loadSketchImage reads the image of the sketch, from the .hex file or, if
+// missing, from the .bin file placed at build.image.base_address
+func loadSketchImage(buildPath *paths.Path, projectName string, buildProperties *properties.Map) (*firmware.Image, error) {
+	if hex := buildPath.Join(projectName + ".hex"); hex.Exist() {
+		return firmware.Load(hex, firmware.FormatHex, 0)
+	}
+	bin := buildPath.Join(projectName + ".bin")
+	if !bin.Exist() {
+		return nil, errors.Errorf(tr("no binary of the sketch found in %s"), buildPath)
+	}
+	baseAddress := uint32(0)
+	if baseAddressValue, ok := buildProperties.GetOk(imageBaseAddressProperty); ok {
+		var err error
+		if baseAddress, err = firmware.ParseAddress(baseAddressValue); err != nil {
+			return nil, err
+		}
+	}
+	return firmware.Load(bin, firmware.FormatBin, baseAddress)
+}
+
+// bootloaderImage returns the image of the bootloader of the board and its
+// offset: the .hex version of a .bin bootloader is preferred, the .bin file is
+// placed at build.image.bootloader.offset, that is required.
+func bootloaderImage(buildProperties *properties.Map) (*paths.Path, uint32, error) {
+	bootloader := bootloaderPath(buildProperties)
+	if bootloader.Ext() != ".bin" {
+		return bootloader, 0, nil
+	}
+	if hex := paths.New(strings.TrimSuffix(bootloader.String(), ".bin") + ".hex"); hex.Exist() {
+		return hex, 0, nil
+	}
+	if bootloader.NotExist() {
+		return bootloader, 0, nil
+	}
+	offsetValue, ok := buildProperties.GetOk(imageBootloaderOffsetProperty)
+	if !ok {
+		return nil, 0, errors.Errorf(tr("the address of the bootloader %[1]s must be set with %[2]s"), bootloader, imageBootloaderOffsetProperty)
+	}
+	offset, err := firmware.ParseAddress(buildProperties.ExpandPropsInString(offsetValue))
+	if err != nil {
+		return nil, 0, errors.WithMessage(err, tr("Invalid %s", imageBootloaderOffsetProperty))
+	}
+	return bootloader, offset, nil
+}
+
+// mergeImage merges the image file in img, the data of a .bin file is placed
+// at offset, the data of the other formats is moved by offset
+func mergeImage(img *firmware.Image, path *paths.Path, offset uint32) error {
+	format, err := firmware.FormatFromPath(path)
+	if err != nil {
+		return err
+	}
+	other, err := firmware.Load(path, format, 0)
+	if err != nil {
+		return err
+	}
+	return img.Merge(other, offset)
+}
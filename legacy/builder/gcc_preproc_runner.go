@@ -19,6 +19,7 @@ import (
 	"os/exec"
 	"strings"
 
+	"github.com/arduino/arduino-cli/arduino/builder/buildtrace"
 	"github.com/arduino/arduino-cli/legacy/builder/builder_utils"
 	"github.com/arduino/arduino-cli/legacy/builder/constants"
 	"github.com/arduino/arduino-cli/legacy/builder/types"
@@ -47,7 +48,9 @@ func GCCPreprocRunnerForDiscoveringIncludes(ctx *types.Context, sourceFilePath *
 		return nil, errors.WithStack(err)
 	}
 
+	endStep := ctx.Trace.Step(buildtrace.CategoryIncludes, sourceFilePath.String())
 	_, stderr, err := utils.ExecCommand(ctx, cmd /* stdout */, utils.ShowIfVerbose /* stderr */, utils.Capture)
+	endStep()
 	if err != nil {
 		return stderr, errors.WithStack(err)
 	}
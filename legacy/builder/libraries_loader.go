@@ -54,6 +54,10 @@ func (s *LibrariesLoader) Run(ctx *types.Context) error {
 		return errors.WithStack(err)
 	}
 	for _, folder := range librariesFolders {
+		if preloaded, ok := ctx.PreloadedLibraries[folder.String()]; ok {
+			lm.AddLibraries(preloaded...)
+			continue
+		}
 		lm.AddLibrariesDir(folder, libraries.User)
 	}
 
@@ -92,6 +96,9 @@ func (s *LibrariesLoader) Run(ctx *types.Context) error {
 	if err := resolver.ScanFromLibrariesManager(lm); err != nil {
 		return errors.WithStack(err)
 	}
+	for header, library := range ctx.LibraryHeaderOverrides {
+		resolver.Override(header, library)
+	}
 	ctx.LibrariesResolver = resolver
 
 	return nil
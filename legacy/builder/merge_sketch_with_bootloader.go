@@ -24,6 +24,7 @@ import (
 	"github.com/arduino/arduino-cli/legacy/builder/types"
 	"github.com/arduino/arduino-cli/legacy/builder/utils"
 	"github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
 	"github.com/marcinbor85/gohex"
 	"github.com/pkg/errors"
 )
@@ -56,15 +57,7 @@ func (s *MergeSketchWithBootloader) Run(ctx *types.Context) error {
 		return nil
 	}
 
-	bootloader := constants.EMPTY_STRING
-	if bootloaderNoBlink, ok := buildProperties.GetOk(constants.BUILD_PROPERTIES_BOOTLOADER_NOBLINK); ok {
-		bootloader = bootloaderNoBlink
-	} else {
-		bootloader = buildProperties.Get(constants.BUILD_PROPERTIES_BOOTLOADER_FILE)
-	}
-	bootloader = buildProperties.ExpandPropsInString(bootloader)
-
-	bootloaderPath := buildProperties.GetPath(constants.BUILD_PROPERTIES_RUNTIME_PLATFORM_PATH).Join(constants.FOLDER_BOOTLOADERS, bootloader)
+	bootloaderPath := bootloaderPath(buildProperties)
 	if bootloaderPath.NotExist() {
 		utils.LogIfVerbose(constants.LOG_LEVEL_WARN, tr("Bootloader file specified but missing: {0}"), bootloaderPath)
 		return nil
@@ -86,6 +79,20 @@ func (s *MergeSketchWithBootloader) Run(ctx *types.Context) error {
 	return nil
 }
 
+// bootloaderPath returns the path of the bootloader of the board, the
+// bootloader.noblink file is preferred to bootloader.file
+func bootloaderPath(buildProperties *properties.Map) *paths.Path {
+	bootloader := constants.EMPTY_STRING
+	if bootloaderNoBlink, ok := buildProperties.GetOk(constants.BUILD_PROPERTIES_BOOTLOADER_NOBLINK); ok {
+		bootloader = bootloaderNoBlink
+	} else {
+		bootloader = buildProperties.Get(constants.BUILD_PROPERTIES_BOOTLOADER_FILE)
+	}
+	bootloader = buildProperties.ExpandPropsInString(bootloader)
+
+	return buildProperties.GetPath(constants.BUILD_PROPERTIES_RUNTIME_PLATFORM_PATH).Join(constants.FOLDER_BOOTLOADERS, bootloader)
+}
+
 func merge(builtSketchPath, bootloaderPath, mergedSketchPath *paths.Path, maximumBinSize int) error {
 	if bootloaderPath.Ext() == ".bin" {
 		bootloaderPath = paths.New(strings.TrimSuffix(bootloaderPath.String(), ".bin") + ".hex")
@@ -16,7 +16,10 @@
 package phases
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/arduino/arduino-cli/i18n"
@@ -29,6 +32,9 @@ import (
 	"github.com/pkg/errors"
 )
 
+// CoreBuilder schedules the compilation of the core and the variant, the results
+// are available in ctx.CoreArchiveFilePath and ctx.CoreObjectsFiles after
+// ctx.WaitScheduledCompilations.
 type CoreBuilder struct{}
 
 var tr = i18n.Tr
@@ -54,18 +60,32 @@ func (s *CoreBuilder) Run(ctx *types.Context) error {
 		}
 	}
 
-	archiveFile, objectFiles, err := compileCore(ctx, coreBuildPath, coreBuildCachePath, buildProperties)
+	archive, variantObjects, err := scheduleCore(ctx, coreBuildPath, coreBuildCachePath, buildProperties)
 	if err != nil {
 		return errors.WithStack(err)
 	}
 
-	ctx.CoreArchiveFilePath = archiveFile
-	ctx.CoreObjectsFiles = objectFiles
+	ctx.AddScheduledCompilation(func() error {
+		archiveFile, err := archive.Wait()
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		objectFiles, err := variantObjects.Wait()
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		ctx.CoreArchiveFilePath = archiveFile[0]
+		ctx.CoreObjectsFiles = objectFiles
+		return nil
+	})
 
 	return nil
 }
 
-func compileCore(ctx *types.Context, buildPath *paths.Path, buildCachePath *paths.Path, buildProperties *properties.Map) (*paths.Path, paths.PathList, error) {
+// scheduleCore schedules the compilation of the core and of the variant, the
+// core archive is created as soon as the core files are compiled, without
+// waiting for the variant.
+func scheduleCore(ctx *types.Context, buildPath *paths.Path, buildCachePath *paths.Path, buildProperties *properties.Map) (*builder_utils.ScheduledFiles, *builder_utils.ScheduledFiles, error) {
 	logger := ctx.GetLogger()
 	coreFolder := buildProperties.GetPath("build.core.path")
 	variantFolder := buildProperties.GetPath("build.variant.path")
@@ -79,11 +99,10 @@ func compileCore(ctx *types.Context, buildPath *paths.Path, buildCachePath *path
 	}
 	includes = utils.Map(includes, utils.WrapWithHyphenI)
 
-	var err error
-
-	variantObjectFiles := paths.NewPathList()
+	variantObjectFiles := builder_utils.AvailableFiles(ctx, paths.NewPathList())
 	if variantFolder != nil && variantFolder.IsDir() {
-		variantObjectFiles, err = builder_utils.CompileFiles(ctx, variantFolder, true, buildPath, buildProperties, includes)
+		var err error
+		variantObjectFiles, err = builder_utils.ScheduleCompileFiles(ctx, variantFolder, true, buildPath, buildProperties, includes)
 		if err != nil {
 			return nil, nil, errors.WithStack(err)
 		}
@@ -93,9 +112,14 @@ func compileCore(ctx *types.Context, buildPath *paths.Path, buildCachePath *path
 	realCoreFolder := coreFolder.Parent().Parent()
 
 	var targetArchivedCore *paths.Path
+	var remoteArchivedCoreKey string
 	if buildCachePath != nil {
-		archivedCoreName := GetCachedCoreArchiveFileName(buildProperties.Get(constants.BUILD_PROPERTIES_FQBN),
-			buildProperties.Get("compiler.optimization_flags"), realCoreFolder)
+		flags := buildProperties.Get("compiler.optimization_flags")
+		if ctx.Reproducible {
+			// the flags of reproducible builds change the content of the archive
+			flags += " " + buildProperties.Get(constants.BUILD_PROPERTIES_COMPILER_REPRODUCIBLE_FLAGS)
+		}
+		archivedCoreName := GetCachedCoreArchiveFileName(buildProperties.Get(constants.BUILD_PROPERTIES_FQBN), flags, realCoreFolder)
 		targetArchivedCore = buildCachePath.Join(archivedCoreName)
 		canUseArchivedCore := !ctx.OnlyUpdateCompilationDatabase &&
 			!ctx.Clean &&
@@ -106,39 +130,190 @@ func compileCore(ctx *types.Context, buildPath *paths.Path, buildCachePath *path
 			if ctx.Verbose {
 				logger.Println(constants.LOG_LEVEL_INFO, tr("Using precompiled core: {0}"), targetArchivedCore)
 			}
-			return targetArchivedCore, variantObjectFiles, nil
+			return builder_utils.AvailableFiles(ctx, paths.PathList{targetArchivedCore}), variantObjectFiles, nil
 		}
-	}
 
-	coreObjectFiles, err := builder_utils.CompileFiles(ctx, coreFolder, true, buildPath, buildProperties, includes)
-	if err != nil {
-		return nil, nil, errors.WithStack(err)
+		if ctx.RemoteBuildCache != nil && !ctx.OnlyUpdateCompilationDatabase {
+			key, err := remoteCoreArchiveKey(ctx, buildPath, buildProperties, includes, coreFolder, variantFolder, realCoreFolder, targetCoreFolder)
+			if err != nil {
+				logger.Println(constants.LOG_LEVEL_WARN, tr("Unable to use the remote build cache for the core: {0}"), err)
+			} else if !ctx.Clean && restoreRemoteCoreArchive(ctx, key, targetArchivedCore) {
+				return builder_utils.AvailableFiles(ctx, paths.PathList{targetArchivedCore}), variantObjectFiles, nil
+			} else {
+				remoteArchivedCoreKey = key
+			}
+		}
 	}
 
-	archiveFile, err := builder_utils.ArchiveCompiledFiles(ctx, buildPath, paths.New("core.a"), coreObjectFiles, buildProperties)
+	coreObjectFiles, err := builder_utils.ScheduleCompileFiles(ctx, coreFolder, true, buildPath, buildProperties, includes)
 	if err != nil {
 		return nil, nil, errors.WithStack(err)
 	}
 
+	archive := builder_utils.ScheduleArchiveCompiledFiles(ctx, buildPath, paths.New("core.a"), coreObjectFiles, buildProperties)
+
 	// archive core.a
 	if targetArchivedCore != nil && !ctx.OnlyUpdateCompilationDatabase {
-		err := archiveFile.CopyTo(targetArchivedCore)
-		if ctx.Verbose {
-			if err == nil {
-				logger.Println(constants.LOG_LEVEL_INFO, tr("Archiving built core (caching) in: {0}"), targetArchivedCore)
-			} else if os.IsNotExist(err) {
-				logger.Println(
-					constants.LOG_LEVEL_INFO,
-					tr("Unable to cache built core, please tell {0} maintainers to follow %s",
-						"https://arduino.github.io/arduino-cli/latest/platform-specification/#recipes-to-build-the-corea-archive-file"),
-					ctx.ActualPlatform)
-			} else {
-				logger.Println(constants.LOG_LEVEL_INFO, tr("Error archiving built core (caching) in {0}: {1}"), targetArchivedCore, err)
+		// archive is replaced below by a set including this job: the job must
+		// wait for the archive job only
+		built := archive
+		cached := ctx.GetScheduler().Add(func() error {
+			archiveFile, err := built.Wait()
+			if err != nil {
+				return errors.WithStack(err)
 			}
+			cacheCoreArchive(ctx, archiveFile[0], targetArchivedCore, remoteArchivedCoreKey)
+			return nil
+		}, built.Job)
+		archive = builder_utils.MergeScheduledFiles(ctx, archive, &builder_utils.ScheduledFiles{Job: cached})
+	}
+
+	return archive, variantObjectFiles, nil
+}
+
+// cacheCoreArchive copies the built core archive in the core build cache and, if
+// remoteKey is not empty, uploads it to the remote build cache. Errors are not
+// fatal and are only reported in verbose mode.
+func cacheCoreArchive(ctx *types.Context, archiveFile, targetArchivedCore *paths.Path, remoteKey string) {
+	err := archiveFile.CopyTo(targetArchivedCore)
+	if err == nil && remoteKey != "" {
+		if data, err := archiveFile.ReadFile(); err == nil {
+			ctx.RemoteBuildCache.Put(remoteKey, data)
+		}
+	}
+	if !ctx.Verbose {
+		return
+	}
+	logger := ctx.GetLogger()
+	if err == nil {
+		logger.Println(constants.LOG_LEVEL_INFO, tr("Archiving built core (caching) in: {0}"), targetArchivedCore)
+	} else if os.IsNotExist(err) {
+		logger.Println(
+			constants.LOG_LEVEL_INFO,
+			tr("Unable to cache built core, please tell {0} maintainers to follow %s",
+				"https://arduino.github.io/arduino-cli/latest/platform-specification/#recipes-to-build-the-corea-archive-file"),
+			ctx.ActualPlatform)
+	} else {
+		logger.Println(constants.LOG_LEVEL_INFO, tr("Error archiving built core (caching) in {0}: {1}"), targetArchivedCore, err)
+	}
+}
+
+// restoreRemoteCoreArchive downloads the core archive with the given key from
+// the remote build cache into the local core build cache.
+func restoreRemoteCoreArchive(ctx *types.Context, key string, targetArchivedCore *paths.Path) bool {
+	data := ctx.RemoteBuildCache.Get(key)
+	if data == nil {
+		return false
+	}
+	// The archive is written to a temporary file and renamed, so a concurrent
+	// build never sees it partially written
+	tmp, err := paths.WriteToTempFile(data, targetArchivedCore.Parent(), "tmp-"+targetArchivedCore.Base())
+	if err == nil {
+		if err = tmp.Rename(targetArchivedCore); err != nil {
+			tmp.Remove()
+		}
+	}
+	if err != nil {
+		ctx.GetLogger().Println(constants.LOG_LEVEL_WARN, tr("Error archiving built core (caching) in {0}: {1}"), targetArchivedCore, err)
+		return false
+	}
+	if ctx.Verbose {
+		ctx.GetLogger().Println(constants.LOG_LEVEL_INFO, tr("Using precompiled core from the remote build cache: {0}"), targetArchivedCore)
+	}
+	return true
+}
+
+// remoteCoreArchiveKey returns the key of the core archive in the remote build
+// cache. Unlike the name of the archive in the local cache, that depends on the
+// path of the core, the key depends on the content of the core, of the variant
+// and of the platform configuration files, on the FQBN, on the expanded command
+// lines that compile and archive the core and on the paths of the tools. The
+// build path and the user home are left out of the command lines and of the
+// paths, so the key is the same on the machines where the tools are installed
+// in the same folders.
+func remoteCoreArchiveKey(ctx *types.Context, buildPath *paths.Path, buildProperties *properties.Map, includes []string, coreFolder, variantFolder, platformFolder, targetPlatformFolder *paths.Path) (string, error) {
+	machineIndependent := func(s string) string {
+		s = strings.Replace(s, ctx.BuildPath.String(), "{build.path}", -1)
+		if home, err := os.UserHomeDir(); err == nil && home != "" {
+			s = strings.Replace(s, home, "~", -1)
 		}
+		return s
 	}
 
-	return archiveFile, variantObjectFiles, nil
+	key := sha256.New()
+	key.Write([]byte(buildProperties.Get(constants.BUILD_PROPERTIES_FQBN) + "\x00"))
+	source := buildPath.Join("source")
+	for _, recipe := range []string{constants.RECIPE_S_PATTERN, constants.RECIPE_C_PATTERN, constants.RECIPE_CPP_PATTERN} {
+		if buildProperties.Get(recipe) == "" {
+			continue
+		}
+		command, err := builder_utils.PrepareCompileCommand(ctx, source, buildPath.Join("source.o"), buildPath, buildProperties, includes, recipe)
+		if err != nil {
+			return "", err
+		}
+		key.Write([]byte(recipe + "\x00" + machineIndependent(strings.Join(command.Args, "\x00")) + "\x00"))
+	}
+	archiveProperties := buildProperties.Clone()
+	archiveProperties.Set(constants.BUILD_PROPERTIES_ARCHIVE_FILE, "core.a")
+	archiveProperties.SetPath(constants.BUILD_PROPERTIES_ARCHIVE_FILE_PATH, buildPath.Join("core.a"))
+	archiveProperties.SetPath(constants.BUILD_PROPERTIES_OBJECT_FILE, buildPath.Join("source.o"))
+	command, err := builder_utils.PrepareCommandForRecipe(archiveProperties, constants.RECIPE_AR_PATTERN, false)
+	if err != nil {
+		return "", err
+	}
+	key.Write([]byte(constants.RECIPE_AR_PATTERN + "\x00" + machineIndependent(strings.Join(command.Args, "\x00")) + "\x00"))
+
+	// The names of the properties contain the versions of the tools
+	tools := []string{}
+	for _, k := range buildProperties.Keys() {
+		if strings.HasPrefix(k, "runtime.tools.") {
+			tools = append(tools, k+"="+machineIndependent(buildProperties.Get(k)))
+		}
+	}
+	sort.Strings(tools)
+	key.Write([]byte(strings.Join(tools, "\x00") + "\x00"))
+
+	files := paths.PathList{}
+	for _, folder := range []*paths.Path{coreFolder, variantFolder} {
+		if folder == nil || !folder.IsDir() {
+			continue
+		}
+		folderFiles, err := folder.ReadDirRecursive()
+		if err != nil {
+			return "", err
+		}
+		files.AddAll(folderFiles)
+	}
+	for _, folder := range []*paths.Path{platformFolder, targetPlatformFolder} {
+		if folder == nil {
+			continue
+		}
+		for _, name := range []string{"platform.txt", "platform.local.txt", "boards.txt"} {
+			files.Add(folder.Join(name))
+		}
+	}
+	for _, file := range files {
+		if !file.Exist() || file.IsDir() {
+			continue
+		}
+		data, err := file.ReadFile()
+		if err != nil {
+			return "", err
+		}
+		sum := sha256.Sum256(data)
+		// only the file name is used, the folders change on every machine
+		name := file.Base()
+		for _, folder := range []*paths.Path{coreFolder, variantFolder} {
+			if folder != nil {
+				if rel, err := folder.RelTo(file); err == nil && !strings.HasPrefix(rel.String(), "..") {
+					name = rel.String()
+					break
+				}
+			}
+		}
+		key.Write([]byte(name + "\x00" + hex.EncodeToString(sum[:]) + "\x00"))
+	}
+	return "core/" + hex.EncodeToString(key.Sum(nil)) + ".a", nil
 }
 
 // GetCachedCoreArchiveFileName returns the filename to be used to store
@@ -32,6 +32,8 @@ import (
 var FLOAT_ABI_CFLAG = "float-abi"
 var FPU_CFLAG = "fpu"
 
+// LibrariesBuilder schedules the compilation of the libraries, the object files are
+// available in ctx.LibrariesObjectFiles after ctx.WaitScheduledCompilations.
 type LibrariesBuilder struct{}
 
 func (s *LibrariesBuilder) Run(ctx *types.Context) error {
@@ -44,12 +46,19 @@ func (s *LibrariesBuilder) Run(ctx *types.Context) error {
 		return errors.WithStack(err)
 	}
 
-	objectFiles, err := compileLibraries(ctx, libs, librariesBuildPath, buildProperties, includes)
+	scheduled, err := scheduleLibraries(ctx, libs, librariesBuildPath, buildProperties, includes)
 	if err != nil {
 		return errors.WithStack(err)
 	}
 
-	ctx.LibrariesObjectFiles = objectFiles
+	ctx.AddScheduledCompilation(func() error {
+		objectFiles, err := scheduled.Wait()
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		ctx.LibrariesObjectFiles = objectFiles
+		return nil
+	})
 	return nil
 }
 
@@ -62,13 +71,36 @@ func directoryContainsFile(folder *paths.Path) bool {
 }
 
 func findExpectedPrecompiledLibFolder(ctx *types.Context, library *libraries.Library) *paths.Path {
-	mcu := ctx.BuildProperties.Get(constants.BUILD_PROPERTIES_BUILD_MCU)
+	logger := ctx.GetLogger()
+	logger.Fprintln(os.Stdout, constants.LOG_LEVEL_INFO, tr("Library {0} has been declared precompiled:"), library.Name)
+
+	// Try directory with full fpuSpecs first, if available
+	for _, folder := range PrecompiledLibraryFolders(ctx.BuildProperties) {
+		precompDir := library.SourceDir.Join(folder)
+		if precompDir.Exist() && directoryContainsFile(precompDir) {
+			logger.Fprintln(os.Stdout, constants.LOG_LEVEL_INFO, tr("Using precompiled library in {0}"), precompDir)
+			return precompDir
+		}
+		logger.Fprintln(os.Stdout, constants.LOG_LEVEL_INFO, tr("Precompiled library in \"{0}\" not found"), precompDir)
+	}
+	return nil
+}
+
+// PrecompiledLibraryFolders returns the folders, relative to the source folder
+// of a precompiled library, where the binaries for the given build properties
+// are searched, the most specific first: {build.mcu}/{fpu}-{float-abi}, if the
+// compiler flags select a floating point configuration, and {build.mcu}.
+func PrecompiledLibraryFolders(buildProperties *properties.Map) []string {
+	mcu := buildProperties.Get(constants.BUILD_PROPERTIES_BUILD_MCU)
 	// Add fpu specifications if they exist
 	// To do so, resolve recipe.cpp.o.pattern,
 	// search for -mfpu=xxx -mfloat-abi=yyy and add to a subfolder
-	command, _ := builder_utils.PrepareCommandForRecipe(ctx.BuildProperties, constants.RECIPE_CPP_PATTERN, true)
+	commandLine := ""
+	if command, err := builder_utils.PrepareCommandForRecipe(buildProperties, constants.RECIPE_CPP_PATTERN, true); err == nil {
+		commandLine = command.String()
+	}
 	fpuSpecs := ""
-	for _, el := range strings.Split(command.String(), " ") {
+	for _, el := range strings.Split(commandLine, " ") {
 		if strings.Contains(el, FPU_CFLAG) {
 			toAdd := strings.Split(el, "=")
 			if len(toAdd) > 1 {
@@ -77,7 +109,7 @@ func findExpectedPrecompiledLibFolder(ctx *types.Context, library *libraries.Lib
 			}
 		}
 	}
-	for _, el := range strings.Split(command.String(), " ") {
+	for _, el := range strings.Split(commandLine, " ") {
 		if strings.Contains(el, FLOAT_ABI_CFLAG) {
 			toAdd := strings.Split(el, "=")
 			if len(toAdd) > 1 {
@@ -87,49 +119,34 @@ func findExpectedPrecompiledLibFolder(ctx *types.Context, library *libraries.Lib
 		}
 	}
 
-	logger := ctx.GetLogger()
-	logger.Fprintln(os.Stdout, constants.LOG_LEVEL_INFO, tr("Library {0} has been declared precompiled:"), library.Name)
-
-	// Try directory with full fpuSpecs first, if available
 	if len(fpuSpecs) > 0 {
 		fpuSpecs = strings.TrimRight(fpuSpecs, "-")
-		fullPrecompDir := library.SourceDir.Join(mcu).Join(fpuSpecs)
-		if fullPrecompDir.Exist() && directoryContainsFile(fullPrecompDir) {
-			logger.Fprintln(os.Stdout, constants.LOG_LEVEL_INFO, tr("Using precompiled library in {0}"), fullPrecompDir)
-			return fullPrecompDir
-		}
-		logger.Fprintln(os.Stdout, constants.LOG_LEVEL_INFO, tr("Precompiled library in \"{0}\" not found"), fullPrecompDir)
-	}
-
-	precompDir := library.SourceDir.Join(mcu)
-	if precompDir.Exist() && directoryContainsFile(precompDir) {
-		logger.Fprintln(os.Stdout, constants.LOG_LEVEL_INFO, tr("Using precompiled library in {0}"), precompDir)
-		return precompDir
+		return []string{mcu + "/" + fpuSpecs, mcu}
 	}
-	logger.Fprintln(os.Stdout, constants.LOG_LEVEL_INFO, tr("Precompiled library in \"{0}\" not found"), precompDir)
-	return nil
+	return []string{mcu}
 }
 
-func compileLibraries(ctx *types.Context, libraries libraries.List, buildPath *paths.Path, buildProperties *properties.Map, includes []string) (paths.PathList, error) {
+func scheduleLibraries(ctx *types.Context, libraries libraries.List, buildPath *paths.Path, buildProperties *properties.Map, includes []string) (*builder_utils.ScheduledFiles, error) {
 	ctx.Progress.AddSubSteps(len(libraries))
 	defer ctx.Progress.RemoveSubSteps()
 
-	objectFiles := paths.NewPathList()
+	scheduled := []*builder_utils.ScheduledFiles{}
 	for _, library := range libraries {
-		libraryObjectFiles, err := compileLibrary(ctx, library, buildPath, buildProperties, includes)
+		libraryScheduled, err := scheduleLibrary(ctx, library, buildPath, buildProperties, includes)
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
-		objectFiles.AddAll(libraryObjectFiles)
+		scheduled = append(scheduled, libraryScheduled)
 
+		// The files of the library are left out of the progress until they
+		// are compiled, the progress is printed by their jobs
 		ctx.Progress.CompleteStep()
-		builder_utils.PrintProgressIfProgressEnabledAndMachineLogger(ctx)
 	}
 
-	return objectFiles, nil
+	return builder_utils.MergeScheduledFiles(ctx, scheduled...), nil
 }
 
-func compileLibrary(ctx *types.Context, library *libraries.Library, buildPath *paths.Path, buildProperties *properties.Map, includes []string) (paths.PathList, error) {
+func scheduleLibrary(ctx *types.Context, library *libraries.Library, buildPath *paths.Path, buildProperties *properties.Map, includes []string) (*builder_utils.ScheduledFiles, error) {
 	logger := ctx.GetLogger()
 	if ctx.Verbose {
 		logger.Println(constants.LOG_LEVEL_INFO, tr("Compiling library \"{0}\""), library.Name)
@@ -183,44 +200,41 @@ func compileLibrary(ctx *types.Context, library *libraries.Library, buildPath *p
 			}
 
 			if library.PrecompiledWithSources {
-				return objectFiles, nil
+				return builder_utils.AvailableFiles(ctx, objectFiles), nil
 			}
 		}
 	}
 
+	scheduled := []*builder_utils.ScheduledFiles{builder_utils.AvailableFiles(ctx, objectFiles)}
 	if library.Layout == libraries.RecursiveLayout {
-		libObjectFiles, err := builder_utils.CompileFilesRecursive(ctx, library.SourceDir, libraryBuildPath, buildProperties, includes)
+		libScheduled, err := builder_utils.ScheduleCompileFilesRecursive(ctx, library.SourceDir, libraryBuildPath, buildProperties, includes)
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
 		if library.DotALinkage {
-			archiveFile, err := builder_utils.ArchiveCompiledFiles(ctx, libraryBuildPath, paths.New(library.Name+".a"), libObjectFiles, buildProperties)
-			if err != nil {
-				return nil, errors.WithStack(err)
-			}
-			objectFiles.Add(archiveFile)
-		} else {
-			objectFiles.AddAll(libObjectFiles)
+			// The archive waits only for the objects of this library
+			libScheduled = builder_utils.ScheduleArchiveCompiledFiles(ctx, libraryBuildPath, paths.New(library.Name+".a"), libScheduled, buildProperties)
 		}
+		scheduled = append(scheduled, libScheduled)
 	} else {
 		if library.UtilityDir != nil {
 			includes = append(includes, utils.WrapWithHyphenI(library.UtilityDir.String()))
 		}
-		libObjectFiles, err := builder_utils.CompileFiles(ctx, library.SourceDir, false, libraryBuildPath, buildProperties, includes)
+		libScheduled, err := builder_utils.ScheduleCompileFiles(ctx, library.SourceDir, false, libraryBuildPath, buildProperties, includes)
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
-		objectFiles.AddAll(libObjectFiles)
+		scheduled = append(scheduled, libScheduled)
 
 		if library.UtilityDir != nil {
 			utilityBuildPath := libraryBuildPath.Join("utility")
-			utilityObjectFiles, err := builder_utils.CompileFiles(ctx, library.UtilityDir, false, utilityBuildPath, buildProperties, includes)
+			utilityScheduled, err := builder_utils.ScheduleCompileFiles(ctx, library.UtilityDir, false, utilityBuildPath, buildProperties, includes)
 			if err != nil {
 				return nil, errors.WithStack(err)
 			}
-			objectFiles.AddAll(utilityObjectFiles)
+			scheduled = append(scheduled, utilityScheduled)
 		}
 	}
 
-	return objectFiles, nil
+	return builder_utils.MergeScheduledFiles(ctx, scheduled...), nil
 }
@@ -0,0 +1,37 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package phases
+
+import (
+	"testing"
+
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrecompiledLibraryFolders(t *testing.T) {
+	buildProperties := properties.NewMap()
+	buildProperties.Set("build.mcu", "cortex-m4")
+	buildProperties.Set("recipe.cpp.o.pattern", `"{compiler.path}g++" -c {build.fpu} {build.float-abi} -mcpu={build.mcu} "{source_file}" -o "{object_file}"`)
+	require.Equal(t, []string{"cortex-m4"}, PrecompiledLibraryFolders(buildProperties))
+
+	buildProperties.Set("build.fpu", "-mfpu=fpv4-sp-d16")
+	buildProperties.Set("build.float-abi", "-mfloat-abi=softfp")
+	require.Equal(t, []string{"cortex-m4/fpv4-sp-d16-softfp", "cortex-m4"}, PrecompiledLibraryFolders(buildProperties))
+
+	buildProperties.Remove("recipe.cpp.o.pattern")
+	require.Equal(t, []string{"cortex-m4"}, PrecompiledLibraryFolders(buildProperties))
+}
@@ -18,6 +18,7 @@ package phases
 import (
 	"strings"
 
+	"github.com/arduino/arduino-cli/arduino/builder/buildtrace"
 	"github.com/arduino/arduino-cli/legacy/builder/builder_utils"
 	"github.com/arduino/arduino-cli/legacy/builder/constants"
 	"github.com/arduino/arduino-cli/legacy/builder/types"
@@ -118,6 +119,7 @@ func link(ctx *types.Context, objectFiles paths.PathList, coreDotARelPath *paths
 		return err
 	}
 
+	defer ctx.Trace.Step(buildtrace.CategoryLink, properties.Get("build.project_name"))()
 	_, _, err = utils.ExecCommand(ctx, command, utils.ShowIfVerbose /* stdout */, utils.Show /* stderr */)
 	return err
 }
@@ -0,0 +1,111 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package phases
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/builder/sizereport"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	"github.com/arduino/go-paths-helper"
+	"github.com/pkg/errors"
+)
+
+// SizeComparer compares the memory used by the executable with the one used by
+// the executable of a previous build. The total flash and RAM are the ones
+// measured by the size recipe of the platform when available, to match the ones
+// printed by the Sizer.
+type SizeComparer struct {
+	SketchError bool
+}
+
+func (s *SizeComparer) Run(ctx *types.Context) error {
+	if ctx.CompareToExecutable == nil || ctx.OnlyUpdateCompilationDatabase || s.SketchError {
+		return nil
+	}
+	if ctx.MemoryUsageReport == nil {
+		return errors.New(tr("can't compare the memory usage with the previous build: the executable of the build can't be analyzed"))
+	}
+
+	previous, err := sizereport.Analyze(ctx.CompareToExecutable, previousBuildInputs(ctx.CompareToBuildPath), func(file *paths.Path) *sizereport.Component {
+		return classifyPreviousBuildFile(ctx, file)
+	})
+	if err != nil {
+		return errors.Errorf(tr("can't compare the memory usage with the previous build: %s"), err)
+	}
+	comparison := sizereport.Compare(previous, ctx.MemoryUsageReport)
+
+	if len(ctx.ExecutableSectionsSize) > 0 {
+		properties := ctx.BuildProperties.Clone()
+		properties.SetPath("build.path", ctx.CompareToExecutable.Parent())
+		properties.Set("build.project_name", strings.TrimSuffix(ctx.CompareToExecutable.Base(), ".elf"))
+		if textSize, dataSize, _, err := execSizeRecipe(ctx, properties); err == nil {
+			for _, section := range ctx.ExecutableSectionsSize {
+				switch {
+				case section.Name == "text":
+					comparison.PreviousFlash, comparison.Flash = textSize, section.Size
+				case section.Name == "data" && dataSize >= 0:
+					comparison.PreviousRAM, comparison.RAM = dataSize, section.Size
+				}
+			}
+		}
+	}
+
+	ctx.SizeComparison = comparison
+	return nil
+}
+
+// previousBuildInputs returns the object files and the static libraries of the
+// sketch, of the libraries and of the core found in the previous build path
+func previousBuildInputs(buildPath *paths.Path) paths.PathList {
+	inputs := paths.NewPathList()
+	if buildPath == nil {
+		return inputs
+	}
+	for _, dir := range []string{"sketch", "libraries", "core"} {
+		files, err := buildPath.Join(dir).ReadDirRecursive()
+		if err != nil {
+			continue
+		}
+		files.FilterOutDirs()
+		files.FilterSuffix(".o", ".a")
+		files.Sort()
+		inputs.AddAll(files)
+	}
+	return inputs
+}
+
+// classifyPreviousBuildFile returns the component owning a source file or an
+// object file of the previous build. The files of the previous build path are
+// classified by folder, since the libraries used may be different from the
+// ones of the current build.
+func classifyPreviousBuildFile(ctx *types.Context, file *paths.Path) *sizereport.Component {
+	if ctx.CompareToBuildPath != nil {
+		if rel, err := ctx.CompareToBuildPath.RelTo(file); err == nil {
+			parts := strings.Split(filepath.ToSlash(rel.String()), "/")
+			switch {
+			case parts[0] == "sketch":
+				return &sizereport.Component{Name: sizereport.KindSketch, Kind: sizereport.KindSketch}
+			case parts[0] == "libraries" && len(parts) > 2:
+				return &sizereport.Component{Name: parts[1], Kind: sizereport.KindLibrary}
+			case parts[0] == "core":
+				return &sizereport.Component{Name: sizereport.KindCore, Kind: sizereport.KindCore}
+			}
+		}
+	}
+	return classifyBuildFile(ctx, file)
+}
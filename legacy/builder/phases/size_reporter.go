@@ -0,0 +1,96 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package phases
+
+import (
+	"github.com/arduino/arduino-cli/arduino/builder/sizereport"
+	"github.com/arduino/arduino-cli/legacy/builder/constants"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	"github.com/arduino/go-paths-helper"
+)
+
+// SizeReporter analyzes the executable produced by the Linker and computes
+// the memory used by each symbol, object file, library and core.
+// Errors are reported as warnings since the report is not essential to the build.
+type SizeReporter struct{}
+
+func (s *SizeReporter) Run(ctx *types.Context) error {
+	if (!ctx.SizeReport && ctx.CompareToExecutable == nil) || ctx.OnlyUpdateCompilationDatabase {
+		return nil
+	}
+
+	executable := ctx.BuildPath.Join(ctx.BuildProperties.Get("build.project_name") + ".elf")
+	if !executable.Exist() {
+		ctx.GetLogger().Println(constants.LOG_LEVEL_WARN, tr("Memory usage report not available: {0} not found"), executable)
+		return nil
+	}
+
+	inputs := paths.NewPathList()
+	inputs.AddAll(ctx.SketchObjectFiles)
+	inputs.AddAll(ctx.LibrariesObjectFiles)
+	inputs.AddAll(ctx.CoreObjectsFiles)
+	if ctx.CoreArchiveFilePath != nil {
+		inputs.Add(ctx.CoreArchiveFilePath)
+	}
+
+	report, err := sizereport.Analyze(executable, inputs, func(file *paths.Path) *sizereport.Component {
+		return classifyBuildFile(ctx, file)
+	})
+	if err != nil {
+		ctx.GetLogger().Println(constants.LOG_LEVEL_WARN, tr("Memory usage report not available: {0}"), err)
+		return nil
+	}
+	ctx.MemoryUsageReport = report
+	return nil
+}
+
+// classifyBuildFile returns the component (sketch, library or core) owning a
+// source file or an object file of the build.
+func classifyBuildFile(ctx *types.Context, file *paths.Path) *sizereport.Component {
+	isInside := func(dir *paths.Path) bool {
+		if dir == nil {
+			return false
+		}
+		inside, err := file.IsInsideDir(dir)
+		return err == nil && inside
+	}
+
+	if isInside(ctx.SketchBuildPath) || (ctx.Sketch != nil && isInside(ctx.Sketch.FullPath)) {
+		return &sizereport.Component{Name: sizereport.KindSketch, Kind: sizereport.KindSketch}
+	}
+	for _, library := range ctx.ImportedLibraries {
+		if (ctx.LibrariesBuildPath != nil && isInside(ctx.LibrariesBuildPath.Join(library.Name))) ||
+			isInside(library.InstallDir) {
+			return &sizereport.Component{Name: library.Name, Kind: sizereport.KindLibrary}
+		}
+	}
+	core := &sizereport.Component{Name: sizereport.KindCore, Kind: sizereport.KindCore}
+	if ctx.CoreArchiveFilePath != nil && file.EquivalentTo(ctx.CoreArchiveFilePath) {
+		return core
+	}
+	if isInside(ctx.CoreBuildPath) || isInside(ctx.CoreBuildCachePath) {
+		return core
+	}
+	if ctx.BuildProperties != nil {
+		if isInside(ctx.BuildProperties.GetPath("build.core.path")) {
+			return core
+		}
+		if ctx.BuildProperties.Get("build.variant.path") != "" && isInside(ctx.BuildProperties.GetPath("build.variant.path")) {
+			return core
+		}
+	}
+	return &sizereport.Component{Name: sizereport.KindOther, Kind: sizereport.KindOther}
+}
@@ -22,6 +22,8 @@ import (
 	"github.com/pkg/errors"
 )
 
+// SketchBuilder schedules the compilation of the sketch, the object files are
+// available in ctx.SketchObjectFiles after ctx.WaitScheduledCompilations.
 type SketchBuilder struct{}
 
 func (s *SketchBuilder) Run(ctx *types.Context) error {
@@ -33,22 +35,28 @@ func (s *SketchBuilder) Run(ctx *types.Context) error {
 		return errors.WithStack(err)
 	}
 
-	objectFiles, err := builder_utils.CompileFiles(ctx, sketchBuildPath, false, sketchBuildPath, buildProperties, includes)
+	scheduled, err := builder_utils.ScheduleCompileFiles(ctx, sketchBuildPath, false, sketchBuildPath, buildProperties, includes)
 	if err != nil {
 		return errors.WithStack(err)
 	}
 
 	// The "src/" subdirectory of a sketch is compiled recursively
-	sketchSrcPath := sketchBuildPath.Join("src")
-	if sketchSrcPath.IsDir() {
-		srcObjectFiles, err := builder_utils.CompileFiles(ctx, sketchSrcPath, true, sketchSrcPath, buildProperties, includes)
+	for _, sketchSrcPath := range ctx.SketchSourceFolders() {
+		srcScheduled, err := builder_utils.ScheduleCompileFiles(ctx, sketchSrcPath, true, sketchSrcPath, buildProperties, includes)
 		if err != nil {
 			return errors.WithStack(err)
 		}
-		objectFiles.AddAll(srcObjectFiles)
+		scheduled = builder_utils.MergeScheduledFiles(ctx, scheduled, srcScheduled)
 	}
 
-	ctx.SketchObjectFiles = objectFiles
+	ctx.AddScheduledCompilation(func() error {
+		objectFiles, err := scheduled.Wait()
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		ctx.SketchObjectFiles = objectFiles
+		return nil
+	})
 
 	return nil
 }
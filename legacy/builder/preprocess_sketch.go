@@ -16,13 +16,15 @@
 package builder
 
 import (
-	"fmt"
+	"bytes"
+	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
 	"strings"
 
 	bldr "github.com/arduino/arduino-cli/arduino/builder"
+	"github.com/arduino/arduino-cli/executils"
 	"github.com/arduino/arduino-cli/legacy/builder/constants"
 	"github.com/arduino/arduino-cli/legacy/builder/types"
 	"github.com/arduino/arduino-cli/legacy/builder/utils"
@@ -52,7 +54,7 @@ func (s *PreprocessSketchArduino) Run(ctx *types.Context) error {
 		return errors.WithStack(err)
 	}
 
-	GCCPreprocRunner(ctx, sourceFile, ctx.PreprocPath.Join(constants.FILE_CTAGS_TARGET_FOR_GCC_MINUS_E), ctx.IncludeFolders)
+	GCCPreprocRunner(ctx, sourceFile, ctx.PreprocPath.Join(constants.FILE_SKETCH_TARGET_FOR_GCC_MINUS_E), ctx.IncludeFolders)
 
 	for _, command := range commands {
 		PrintRingNameIfDebug(ctx, command)
@@ -76,7 +78,7 @@ type ArduinoPreprocessorRunner struct{}
 
 func (s *ArduinoPreprocessorRunner) Run(ctx *types.Context) error {
 	buildProperties := ctx.BuildProperties
-	targetFilePath := ctx.PreprocPath.Join(constants.FILE_CTAGS_TARGET_FOR_GCC_MINUS_E)
+	targetFilePath := ctx.PreprocPath.Join(constants.FILE_SKETCH_TARGET_FOR_GCC_MINUS_E)
 
 	preprocProperties := buildProperties.Clone()
 	toolProps := buildProperties.SubTree("tools").SubTree("arduino-preprocessor")
@@ -116,17 +118,20 @@ func (s *ArduinoPreprocessorRunner) Run(ctx *types.Context) error {
 		//command.Args[0], _ = filepath.Rel(command.Dir, command.Args[0])
 	}
 
-	verbose := ctx.Verbose
-	if verbose {
-		fmt.Println(commandLine)
+	if ctx.Verbose {
+		ctx.GetLogger().UnformattedFprintln(os.Stdout, commandLine)
 	}
 
-	buf, err := command.Output()
-	if err != nil {
-		return errors.New(errors.WithStack(err).Error() + string(err.(*exec.ExitError).Stderr))
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	command.Stdout = stdout
+	command.Stderr = stderr
+	// The preprocessor is killed if the build is canceled
+	if err := executils.RunWithinContext(ctx.GetContext(), command); err != nil {
+		return errors.New(errors.WithStack(err).Error() + stderr.String())
 	}
 
-	result := utils.NormalizeUTF8(buf)
+	result := utils.NormalizeUTF8(stdout.Bytes())
 
 	//fmt.Printf("PREPROCESSOR OUTPUT:\n%s\n", output)
 	if ctx.CodeCompleteAt != "" {
@@ -0,0 +1,421 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package prototypes
+
+import (
+	"strings"
+)
+
+// function is a function definition found at file scope
+type function struct {
+	name string
+	// key identifies the function signature, see signatureKey
+	key       string
+	prototype string
+	// qualified is true for member functions and functions of other
+	// namespaces, that can't have a prototype in the sketch
+	qualified  bool
+	static     bool
+	externC    bool
+	file       string
+	line       int
+	insertLine int
+}
+
+// declaration is a declaration, terminated by a semicolon, found at file scope
+type declaration struct {
+	tokens []*token
+	// declarator is the index of the declared name in tokens, or -1 if unknown
+	declarator int
+	file       string
+	insertLine int
+}
+
+// parser finds the function definitions and the declarations at file scope
+// and inside linkage specification blocks. Class, namespace and function
+// bodies are skipped.
+type parser struct {
+	tokens       []*token
+	pos          int
+	functions    []*function
+	declarations []*declaration
+	// declared contains the keys of the functions that have a prototype
+	declared map[string]bool
+}
+
+func parse(tokens []*token) *parser {
+	p := &parser{
+		tokens:   tokens,
+		declared: map[string]bool{},
+	}
+	p.parseDeclarations(nil, false)
+	return p
+}
+
+// parseDeclarations parses declarations until the end of the source or, if
+// block is not nil, until the end of the linkage block started by block.
+func (p *parser) parseDeclarations(block *token, externC bool) {
+	start := p.pos
+	for p.pos < len(p.tokens) {
+		switch p.tokens[p.pos].text {
+		case "(", "[":
+			p.pos = skipGroup(p.tokens, p.pos)
+		case ";":
+			p.addDeclaration(p.tokens[start:p.pos], block, externC)
+			p.pos++
+			start = p.pos
+		case "{":
+			decl := p.tokens[start:p.pos]
+			if lang, ok := linkageSpecification(decl); ok {
+				p.pos++
+				p.parseDeclarations(decl[0], lang == `"C"`)
+				start = p.pos
+			} else if isNamespace(decl) {
+				p.pos = skipGroup(p.tokens, p.pos)
+				start = p.pos
+			} else if isFunctionDefinition(decl) {
+				p.pos = skipGroup(p.tokens, p.pos)
+				p.skipHandlers()
+				p.addFunction(decl, block, externC)
+				start = p.pos
+			} else {
+				// class body or initializer, the declaration ends with a semicolon
+				p.pos = skipGroup(p.tokens, p.pos)
+			}
+		case "}":
+			p.pos++
+			if block != nil {
+				return
+			}
+			start = p.pos
+		default:
+			p.pos++
+		}
+	}
+}
+
+// skipHandlers skips the handlers of a function-try-block
+func (p *parser) skipHandlers() {
+	for p.pos < len(p.tokens) && p.tokens[p.pos].text == "catch" {
+		p.pos++
+		if p.pos < len(p.tokens) && p.tokens[p.pos].text == "(" {
+			p.pos = skipGroup(p.tokens, p.pos)
+		}
+		if p.pos < len(p.tokens) && p.tokens[p.pos].text == "{" {
+			p.pos = skipGroup(p.tokens, p.pos)
+		}
+	}
+}
+
+func (p *parser) addDeclaration(decl []*token, block *token, externC bool) {
+	if len(decl) == 0 {
+		return
+	}
+	name, params := findDeclarator(decl)
+	p.declarations = append(p.declarations, &declaration{
+		tokens:     decl,
+		declarator: name,
+		file:       decl[0].file,
+		insertLine: insertLine(decl, block),
+	})
+	if params == -1 || isQualified(decl, name) || hasInitializer(decl[:params]) || decl[0].text == "typedef" {
+		return
+	}
+	p.declared[signatureKey(decl, name, params)] = true
+}
+
+func (p *parser) addFunction(decl []*token, block *token, externC bool) {
+	name, params := findDeclarator(decl)
+	if params == -1 {
+		// the declarator is too complex to write a prototype
+		return
+	}
+
+	f := &function{
+		name:       joinTokens(decl[name:params]),
+		key:        signatureKey(decl, name, params),
+		qualified:  isQualified(decl, name),
+		externC:    externC,
+		file:       decl[0].file,
+		line:       decl[0].line,
+		insertLine: insertLine(decl, block),
+	}
+	prototype := []*token{}
+	for i := 0; i < len(decl); i++ {
+		tok := decl[i]
+		if i < name {
+			if tok.text == "static" {
+				f.static = true
+				continue
+			}
+			if tok.text == "extern" && i+1 < len(decl) && decl[i+1].text == `"C"` {
+				f.externC = true
+				i++
+				continue
+			}
+		}
+		if i == len(decl)-1 && tok.text == "try" {
+			continue
+		}
+		prototype = append(prototype, tok)
+	}
+	f.prototype = joinTokens(prototype) + ";"
+	p.functions = append(p.functions, f)
+}
+
+// insertLine returns the line where prototypes must be inserted to precede the
+// given declaration: declarations in a linkage block are preceded by the block.
+func insertLine(decl []*token, block *token) int {
+	if block != nil {
+		return block.line
+	}
+	return decl[0].line
+}
+
+// findDeclarator returns the index of the name of the function declared by
+// decl and the index of the parenthesis starting its parameters list, or -1
+// if decl doesn't declare a function with a simple declarator.
+func findDeclarator(decl []*token) (int, int) {
+	for i := skipTemplateHeaders(decl, 0); i < len(decl); i++ {
+		tok := decl[i]
+		switch tok.text {
+		case "operator":
+			params := skipOperatorName(decl, i+1)
+			if params < len(decl) && decl[params].text == "(" {
+				return i, params
+			}
+			return -1, -1
+		case "[", "{":
+			i = skipGroup(decl, i) - 1
+		case "(":
+			if i == 0 {
+				return -1, -1
+			}
+			prev := decl[i-1]
+			if attributeKeywords[prev.text] {
+				i = skipGroup(decl, i) - 1
+				continue
+			}
+			if prev.kind == tokenIdentifier && !keywords[prev.text] {
+				return i - 1, i
+			}
+			if prev.text == ">" {
+				// template specialization
+				if name := skipTemplateArgumentsBackwards(decl, i-1); name >= 0 && decl[name].kind == tokenIdentifier && !keywords[decl[name].text] {
+					return name, i
+				}
+			}
+			return -1, -1
+		}
+	}
+	return -1, -1
+}
+
+// isFunctionDefinition returns true if the body following decl is the body of
+// a function: decl must have a parameters list and no initializer.
+func isFunctionDefinition(decl []*token) bool {
+	hasParams := false
+	for i := skipTemplateHeaders(decl, 0); i < len(decl); i++ {
+		switch decl[i].text {
+		case "operator":
+			i = skipOperatorName(decl, i+1) - 1
+		case "=":
+			return false
+		case "[", "{":
+			i = skipGroup(decl, i) - 1
+		case "(":
+			if i == 0 || !attributeKeywords[decl[i-1].text] {
+				hasParams = true
+			}
+			i = skipGroup(decl, i) - 1
+		}
+	}
+	return hasParams
+}
+
+// hasInitializer returns true if decl contains an initializer at the top level
+func hasInitializer(decl []*token) bool {
+	for i := skipTemplateHeaders(decl, 0); i < len(decl); i++ {
+		switch decl[i].text {
+		case "operator":
+			i = skipOperatorName(decl, i+1) - 1
+		case "=":
+			return true
+		case "(", "[", "{":
+			i = skipGroup(decl, i) - 1
+		}
+	}
+	return false
+}
+
+// linkageSpecification returns the language of a linkage specification like
+// `extern "C"`
+func linkageSpecification(decl []*token) (string, bool) {
+	if len(decl) == 2 && decl[0].text == "extern" && decl[1].kind == tokenLiteral {
+		return decl[1].text, true
+	}
+	return "", false
+}
+
+func isNamespace(decl []*token) bool {
+	for _, tok := range decl {
+		if tok.text == "namespace" {
+			return true
+		}
+		if tok.text != "inline" {
+			return false
+		}
+	}
+	return false
+}
+
+// isClassHead returns true if decl is the head of a class, struct, union or
+// enum definition
+func isClassHead(decl []*token) bool {
+	if hasInitializer(decl) {
+		return false
+	}
+	for _, tok := range decl {
+		switch tok.text {
+		case "class", "struct", "union", "enum":
+			return true
+		}
+	}
+	return false
+}
+
+// isQualified returns true if the name at index name is qualified, like the
+// name of a member function defined outside of its class
+func isQualified(decl []*token, name int) bool {
+	return name > 0 && (decl[name-1].text == "::" || decl[name-1].text == "~")
+}
+
+// signatureKey returns a string identifying a function by name and parameters
+// types, regardless of the whitespaces
+func signatureKey(decl []*token, name, params int) string {
+	key := ""
+	for _, tok := range decl[name:skipGroup(decl, params)] {
+		key += tok.text
+	}
+	return key
+}
+
+// skipGroup returns the index following the group of balanced brackets
+// starting at index i
+func skipGroup(tokens []*token, i int) int {
+	depth := 0
+	for ; i < len(tokens); i++ {
+		switch tokens[i].text {
+		case "(", "[", "{":
+			depth++
+		case ")", "]", "}":
+			depth--
+			if depth <= 0 {
+				return i + 1
+			}
+		}
+	}
+	return i
+}
+
+// skipTemplateHeaders returns the index following the template headers
+// (like `template <typename T>`) starting at index i
+func skipTemplateHeaders(tokens []*token, i int) int {
+	for i+1 < len(tokens) && tokens[i].text == "template" && tokens[i+1].text == "<" {
+		depth := 0
+	header:
+		for i++; i < len(tokens); i++ {
+			switch tokens[i].text {
+			case "<":
+				depth++
+			case ">":
+				depth--
+				if depth == 0 {
+					i++
+					break header
+				}
+			case "(", "[", "{":
+				i = skipGroup(tokens, i) - 1
+			}
+		}
+	}
+	return i
+}
+
+// skipTemplateArgumentsBackwards returns the index of the token preceding the
+// template arguments list ending at index i
+func skipTemplateArgumentsBackwards(tokens []*token, i int) int {
+	depth := 0
+	for ; i >= 0; i-- {
+		switch tokens[i].text {
+		case ">":
+			depth++
+		case "<":
+			depth--
+			if depth == 0 {
+				return i - 1
+			}
+		}
+	}
+	return -1
+}
+
+// skipOperatorName returns the index following the name of an operator
+// function, starting at index i just after the operator keyword
+func skipOperatorName(tokens []*token, i int) int {
+	if i+1 < len(tokens) && tokens[i].text == "(" && tokens[i+1].text == ")" {
+		return i + 2
+	}
+	for i < len(tokens) && tokens[i].text != "(" {
+		i++
+	}
+	return i
+}
+
+// joinTokens returns the source code of the given tokens, the whitespaces and
+// comments between them are replaced by a single space
+func joinTokens(tokens []*token) string {
+	var res strings.Builder
+	for i, tok := range tokens {
+		if i > 0 && tok.space {
+			res.WriteString(" ")
+		}
+		res.WriteString(tok.text)
+	}
+	return res.String()
+}
+
+// attributeKeywords are followed by parenthesis that don't contain the
+// parameters of a function
+var attributeKeywords = map[string]bool{
+	"__attribute__": true, "__attribute": true, "__declspec": true, "alignas": true, "_Alignas": true,
+	"decltype": true, "__typeof__": true, "__typeof": true, "typeof": true, "noexcept": true, "throw": true,
+	"asm": true, "__asm": true, "__asm__": true, "static_assert": true, "_Static_assert": true,
+	"sizeof": true, "alignof": true,
+}
+
+// keywords can't be the name of a function
+var keywords = map[string]bool{
+	"void": true, "char": true, "short": true, "int": true, "long": true, "float": true, "double": true,
+	"signed": true, "unsigned": true, "bool": true, "auto": true, "const": true, "volatile": true,
+	"struct": true, "class": true, "union": true, "enum": true, "typename": true, "template": true,
+	"return": true, "if": true, "else": true, "while": true, "for": true, "do": true, "switch": true,
+	"case": true, "default": true, "new": true, "delete": true, "this": true, "static": true,
+	"extern": true, "inline": true, "virtual": true, "explicit": true, "friend": true, "register": true,
+	"mutable": true, "constexpr": true, "typedef": true, "using": true, "namespace": true, "public": true,
+	"private": true, "protected": true, "goto": true, "break": true, "continue": true, "try": true,
+	"catch": true, "operator": true,
+}
@@ -0,0 +1,107 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package prototypes
+
+import (
+	"strings"
+
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	"github.com/arduino/go-paths-helper"
+)
+
+const staticModifier = "static"
+const externCModifier = `extern "C"`
+
+// Generate scans the sketch source preprocessed by gcc -E, with the line
+// markers, and returns the prototypes of the functions defined at file scope
+// that are not already declared, and the line of the main file where the
+// prototypes must be inserted.
+func Generate(source string, mainFile *paths.Path) ([]*types.Prototype, int) {
+	p := parse(tokenize(source))
+	return p.prototypes(), p.lineWhereToInsertPrototypes(mainFile.String())
+}
+
+func (p *parser) prototypes() []*types.Prototype {
+	prototypes := []*types.Prototype{}
+	generated := map[string]bool{}
+	for _, f := range p.functions {
+		if f.qualified || p.declared[f.key] || generated[f.key] {
+			continue
+		}
+		generated[f.key] = true
+
+		modifiers := []string{}
+		if f.static {
+			modifiers = append(modifiers, staticModifier)
+		}
+		if f.externC {
+			modifiers = append(modifiers, externCModifier)
+		}
+		prototypes = append(prototypes, &types.Prototype{
+			FunctionName: f.name,
+			File:         f.file,
+			Prototype:    f.prototype,
+			Modifiers:    strings.Join(modifiers, " "),
+			Line:         f.line,
+		})
+	}
+	return prototypes
+}
+
+// lineWhereToInsertPrototypes returns the line of the main file preceding the
+// first function definition and the first declaration using a function, like
+// a global variable initialized with a function pointer.
+func (p *parser) lineWhereToInsertPrototypes(mainFile string) int {
+	line := -1
+	names := map[string]bool{}
+	for _, f := range p.functions {
+		if !f.qualified {
+			names[f.name] = true
+		}
+		if line == -1 && f.file == mainFile {
+			line = f.insertLine
+		}
+	}
+	for _, decl := range p.declarations {
+		if decl.file != mainFile || (line != -1 && decl.insertLine >= line) {
+			continue
+		}
+		if decl.uses(names) {
+			line = decl.insertLine
+			break
+		}
+	}
+	if line == -1 {
+		return 0
+	}
+	return line
+}
+
+// uses returns true if the declaration refers to one of the given names,
+// the members of the classes defined by the declaration are not considered
+func (d *declaration) uses(names map[string]bool) bool {
+	for i := 0; i < len(d.tokens); i++ {
+		tok := d.tokens[i]
+		if tok.text == "{" && isClassHead(d.tokens[:i]) {
+			i = skipGroup(d.tokens, i) - 1
+			continue
+		}
+		if i != d.declarator && tok.kind == tokenIdentifier && names[tok.text] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,275 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package prototypes
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+const mainFile = "/tmp/sketch/sketch.ino.cpp"
+
+func producePrototypes(source string) ([]*types.Prototype, int) {
+	return Generate("# 1 \""+mainFile+"\"\n"+source, paths.New(mainFile))
+}
+
+func prototypesOf(prototypes []*types.Prototype) []string {
+	res := []string{}
+	for _, p := range prototypes {
+		res = append(res, p.Prototype)
+	}
+	return res
+}
+
+func TestPrototypesShouldListPrototypes(t *testing.T) {
+	prototypes, line := producePrototypes(
+		"#include <Bridge.h>\n" +
+			"BridgeServer server;\n" +
+			"void setup() {\n" +
+			"  server.begin();\n" +
+			"}\n" +
+			"void loop() {\n" +
+			"  BridgeClient client = server.accept();\n" +
+			"  if (client) {\n" +
+			"    process(client);\n" +
+			"  }\n" +
+			"}\n" +
+			"void process(BridgeClient client) {\n" +
+			"  String command = client.readStringUntil('/');\n" +
+			"}\n")
+
+	require.Equal(t, []string{"void setup();", "void loop();", "void process(BridgeClient client);"}, prototypesOf(prototypes))
+	require.Equal(t, mainFile, prototypes[0].File)
+	require.Equal(t, "setup", prototypes[0].FunctionName)
+	require.Equal(t, 3, prototypes[0].Line)
+	require.Equal(t, 12, prototypes[2].Line)
+	require.Equal(t, 3, line)
+}
+
+func TestPrototypesShouldListTemplates(t *testing.T) {
+	prototypes, line := producePrototypes(
+		"template <typename T> T minimum (T a, T b) {\n" +
+			"  return (a < b) ? a : b;\n" +
+			"}\n" +
+			"template <class T> int SRAM_writeAnything(int ee, const T& value) { return 0; }\n" +
+			"void setup() {}\n" +
+			"void loop() {}\n")
+
+	require.Equal(t, []string{
+		"template <typename T> T minimum (T a, T b);",
+		"template <class T> int SRAM_writeAnything(int ee, const T& value);",
+		"void setup();",
+		"void loop();",
+	}, prototypesOf(prototypes))
+	require.Equal(t, 1, line)
+}
+
+func TestPrototypesMultilineTemplate(t *testing.T) {
+	prototypes, line := producePrototypes(
+		"template< typename T >\n" +
+			"T func(T t){\n" +
+			"  return t * t;\n" +
+			"}\n")
+
+	require.Equal(t, []string{"template< typename T > T func(T t);"}, prototypesOf(prototypes))
+	require.Equal(t, 1, line)
+}
+
+func TestPrototypesShouldDealWithClasses(t *testing.T) {
+	prototypes, line := producePrototypes(
+		"class Rectangle {\n" +
+			"    int width, height;\n" +
+			"  public:\n" +
+			"    void set_values (int,int);\n" +
+			"    int area() {return width*height;}\n" +
+			"};\n" +
+			"\n" +
+			"void Rectangle::set_values (int x, int y) {\n" +
+			"  width = x;\n" +
+			"  height = y;\n" +
+			"}\n" +
+			"\n" +
+			"void setup() {\n" +
+			"}\n")
+
+	require.Equal(t, []string{"void setup();"}, prototypesOf(prototypes))
+	require.Equal(t, 8, line)
+}
+
+func TestPrototypesShouldDealWithStructs(t *testing.T) {
+	prototypes, line := producePrototypes(
+		"struct A_NEW_TYPE {\n" +
+			"  int a = 10;\n" +
+			"  int b;\n" +
+			"} foo;\n" +
+			"\n" +
+			"void setup() {\n" +
+			"  dostuff(&foo);\n" +
+			"}\n" +
+			"\n" +
+			"void dostuff (A_NEW_TYPE * bar)\n" +
+			"{\n" +
+			"}\n")
+
+	require.Equal(t, []string{"void setup();", "void dostuff (A_NEW_TYPE * bar);"}, prototypesOf(prototypes))
+	require.Equal(t, 6, line)
+}
+
+func TestPrototypesShouldDealWithNamespaces(t *testing.T) {
+	prototypes, line := producePrototypes(
+		"namespace Test {\n" +
+			"  int value() {\n" +
+			"    return 42;\n" +
+			"  }\n" +
+			"  int ciao = 24;\n" +
+			"}\n" +
+			"void setup() {}\n" +
+			"void loop() {}\n")
+
+	require.Equal(t, []string{"void setup();", "void loop();"}, prototypesOf(prototypes))
+	require.Equal(t, 7, line)
+}
+
+func TestPrototypesShouldSkipDeclaredFunctions(t *testing.T) {
+	prototypes, _ := producePrototypes(
+		"void setup();\n" +
+			"int sum(int a, int b);\n" +
+			"void setup() {}\n" +
+			"int sum(int a,int b) { return a + b; }\n" +
+			"int sum(int a, int b, int c) { return a + b + c; }\n" +
+			"void loop() {}\n")
+
+	require.Equal(t, []string{"int sum(int a, int b, int c);", "void loop();"}, prototypesOf(prototypes))
+}
+
+func TestPrototypesDefaultArguments(t *testing.T) {
+	prototypes, line := producePrototypes(
+		"void test(int x = 1) {\n" +
+			"}\n" +
+			"void setup() {}\n")
+
+	require.Equal(t, []string{"void test(int x = 1);", "void setup();"}, prototypesOf(prototypes))
+	require.Equal(t, 1, line)
+}
+
+func TestPrototypesStaticAndInline(t *testing.T) {
+	prototypes, _ := producePrototypes(
+		"static inline int8_t testInline() {\n" +
+			"  return 1;\n" +
+			"}\n" +
+			"__attribute__((always_inline)) uint8_t testAttribute() {\n" +
+			"  return 1;\n" +
+			"}\n")
+
+	require.Equal(t, []string{"inline int8_t testInline();", "__attribute__((always_inline)) uint8_t testAttribute();"}, prototypesOf(prototypes))
+	require.Equal(t, "static", prototypes[0].Modifiers)
+	require.Equal(t, "", prototypes[1].Modifiers)
+}
+
+func TestPrototypesExternC(t *testing.T) {
+	prototypes, line := producePrototypes(
+		"int global = 0;\n" +
+			"extern \"C\" {\n" +
+			"  void foo(int a) {}\n" +
+			"}\n" +
+			"extern \"C\" void bar() {}\n" +
+			"void setup() {}\n")
+
+	require.Equal(t, []string{"void foo(int a);", "void bar();", "void setup();"}, prototypesOf(prototypes))
+	require.Equal(t, `extern "C"`, prototypes[0].Modifiers)
+	require.Equal(t, `extern "C"`, prototypes[1].Modifiers)
+	require.Equal(t, "", prototypes[2].Modifiers)
+	require.Equal(t, 2, line)
+}
+
+func TestPrototypesTypename(t *testing.T) {
+	prototypes, line := producePrototypes(
+		"template< typename T >\n" +
+			"  struct Foo{\n" +
+			"    typedef T Bar;\n" +
+			"};\n" +
+			"\n" +
+			"void setup() {\n" +
+			"  func();\n" +
+			"}\n" +
+			"\n" +
+			"void loop() {}\n" +
+			"\n" +
+			"typename Foo<char>::Bar func(){\n" +
+			"\n" +
+			"}\n")
+
+	require.Equal(t, []string{"void setup();", "void loop();", "typename Foo<char>::Bar func();"}, prototypesOf(prototypes))
+	require.Equal(t, 12, prototypes[2].Line)
+	require.Equal(t, 6, line)
+}
+
+func TestPrototypesFunctionPointer(t *testing.T) {
+	prototypes, line := producePrototypes(
+		"void t1Callback() {}\n" +
+			"void (*callback)() = t1Callback;\n" +
+			"void setup() {}\n")
+
+	require.Equal(t, []string{"void t1Callback();", "void setup();"}, prototypesOf(prototypes))
+	require.Equal(t, 1, line)
+
+	prototypes, line = producePrototypes(
+		"#include <Arduino.h>\n" +
+			"void (*callback)() = t1Callback;\n" +
+			"void setup() {}\n" +
+			"void t1Callback() {}\n")
+
+	require.Equal(t, []string{"void setup();", "void t1Callback();"}, prototypesOf(prototypes))
+	require.Equal(t, 2, line)
+}
+
+func TestPrototypesCommentsAndMultilineSignatures(t *testing.T) {
+	prototypes, _ := producePrototypes(
+		"void setup() { // a comment with a fake() {\n" +
+			"  const char *s = \"void fake() {\";\n" +
+			"}\n" +
+			"/* void other() {} */\n" +
+			"int sum(int a,\n" +
+			"        int b) {\n" +
+			"  return a + b;\n" +
+			"}\n")
+
+	require.Equal(t, []string{"void setup();", "int sum(int a, int b);"}, prototypesOf(prototypes))
+	require.Equal(t, 5, prototypes[1].Line)
+}
+
+func TestPrototypesOtherFiles(t *testing.T) {
+	prototypes, line := producePrototypes(
+		"# 1 \"/tmp/sketch/other.h\"\n" +
+			"void helper() {}\n" +
+			"# 3 \"" + mainFile + "\"\n" +
+			"void setup() {}\n")
+
+	require.Equal(t, []string{"void helper();", "void setup();"}, prototypesOf(prototypes))
+	require.Equal(t, "/tmp/sketch/other.h", prototypes[0].File)
+	require.Equal(t, 1, prototypes[0].Line)
+	require.Equal(t, 3, line)
+}
+
+func TestPrototypesNoFunctions(t *testing.T) {
+	prototypes, line := producePrototypes("int a = 0;\n")
+
+	require.Equal(t, 0, len(prototypes))
+	require.Equal(t, 0, line)
+}
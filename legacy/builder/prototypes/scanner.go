@@ -0,0 +1,297 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package prototypes
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/arduino/arduino-cli/legacy/builder/utils"
+)
+
+type tokenKind int
+
+const (
+	tokenIdentifier tokenKind = iota
+	tokenNumber
+	tokenLiteral
+	tokenPunctuation
+)
+
+// token is a C++ token of the preprocessed source
+type token struct {
+	kind tokenKind
+	text string
+	file string
+	line int
+	// space is true if the token is preceded by whitespace or comments
+	space bool
+}
+
+// tokenize splits the output of the C++ preprocessor in tokens, keeping track
+// of the original file and line of each token through the line markers.
+// Comments and preprocessor directives are skipped.
+func tokenize(source string) []*token {
+	s := &scanner{src: source, line: 1}
+	s.run()
+	return s.tokens
+}
+
+type scanner struct {
+	src    string
+	pos    int
+	file   string
+	line   int
+	space  bool
+	tokens []*token
+}
+
+func (s *scanner) run() {
+	lineStart := true
+	for s.pos < len(s.src) {
+		c := s.src[s.pos]
+		switch {
+		case c == '\n' || (c == '\r' && !strings.HasPrefix(s.src[s.pos+1:], "\n")):
+			// a lone carriage return ends the line too, like in gcc
+			s.pos++
+			s.line++
+			s.space = true
+			lineStart = true
+			continue
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f' || c == '\v':
+			s.pos++
+			s.space = true
+			continue
+		case c == '\\' && strings.HasPrefix(s.src[s.pos+1:], "\n"):
+			// line continuation
+			s.pos += 2
+			s.line++
+			continue
+		case c == '#' && lineStart:
+			s.directive()
+			continue
+		case strings.HasPrefix(s.src[s.pos:], "//"):
+			s.lineComment()
+			continue
+		case strings.HasPrefix(s.src[s.pos:], "/*"):
+			s.blockComment()
+			continue
+		}
+		lineStart = false
+
+		start, line := s.pos, s.line
+		var kind tokenKind
+		switch {
+		case c == '"' || c == '\'':
+			kind = tokenLiteral
+			s.quoted(c)
+		case isIdentifierStart(c):
+			kind = tokenIdentifier
+			for s.pos < len(s.src) && isIdentifierChar(s.src[s.pos]) {
+				s.pos++
+			}
+			if s.pos < len(s.src) && s.src[s.pos] == '"' && isStringPrefix(s.src[start:s.pos]) {
+				kind = tokenLiteral
+				if strings.HasSuffix(s.src[start:s.pos], "R") {
+					s.rawString()
+				} else {
+					s.quoted('"')
+				}
+			} else if s.pos < len(s.src) && s.src[s.pos] == '\'' && isCharPrefix(s.src[start:s.pos]) {
+				kind = tokenLiteral
+				s.quoted('\'')
+			}
+		case isDigit(c) || (c == '.' && s.pos+1 < len(s.src) && isDigit(s.src[s.pos+1])):
+			kind = tokenNumber
+			s.number()
+		default:
+			kind = tokenPunctuation
+			s.pos += punctuationLength(s.src[s.pos:])
+		}
+		s.tokens = append(s.tokens, &token{
+			kind:  kind,
+			text:  s.src[start:s.pos],
+			file:  s.file,
+			line:  line,
+			space: s.space,
+		})
+		s.space = false
+	}
+}
+
+// directive skips a preprocessor directive, the line markers are used to update
+// the current file and line
+func (s *scanner) directive() {
+	start := s.pos
+	for s.pos < len(s.src) && s.src[s.pos] != '\n' {
+		if s.src[s.pos] == '\\' && strings.HasPrefix(s.src[s.pos+1:], "\n") {
+			s.pos++
+			s.line++
+		}
+		s.pos++
+	}
+	if file, line, ok := parseLineMarker(s.src[start:s.pos]); ok {
+		s.file = file
+		// the line marker refers to the line that follows it
+		s.line = line - 1
+	}
+	s.space = true
+}
+
+// parseLineMarker parses line markers in the form `# 123 "file" flags` or
+// `#line 123 "file"`
+func parseLineMarker(directive string) (string, int, bool) {
+	fields := strings.TrimSpace(strings.TrimPrefix(directive, "#"))
+	fields = strings.TrimSpace(strings.TrimPrefix(fields, "line"))
+	split := strings.SplitN(fields, " ", 2)
+	if len(split) < 2 {
+		return "", 0, false
+	}
+	line, err := strconv.Atoi(split[0])
+	if err != nil {
+		return "", 0, false
+	}
+	file, _, ok := utils.ParseCppString(strings.TrimSpace(split[1]))
+	if !ok {
+		return "", 0, false
+	}
+	return file, line, true
+}
+
+func (s *scanner) lineComment() {
+	for s.pos < len(s.src) && s.src[s.pos] != '\n' {
+		if s.src[s.pos] == '\\' && strings.HasPrefix(s.src[s.pos+1:], "\n") {
+			s.pos++
+			s.line++
+		}
+		s.pos++
+	}
+	s.space = true
+}
+
+func (s *scanner) blockComment() {
+	end := strings.Index(s.src[s.pos+2:], "*/")
+	if end == -1 {
+		end = len(s.src)
+	} else {
+		end += s.pos + 4
+	}
+	s.line += strings.Count(s.src[s.pos:end], "\n")
+	s.pos = end
+	s.space = true
+}
+
+// quoted skips a string or char literal, an unterminated literal ends at the
+// end of the line
+func (s *scanner) quoted(quote byte) {
+	s.pos++
+	for s.pos < len(s.src) {
+		switch s.src[s.pos] {
+		case '\\':
+			if strings.HasPrefix(s.src[s.pos+1:], "\n") {
+				s.line++
+			}
+			s.pos += 2
+			continue
+		case '\n':
+			return
+		case quote:
+			s.pos++
+			return
+		}
+		s.pos++
+	}
+	if s.pos > len(s.src) {
+		s.pos = len(s.src)
+	}
+}
+
+// rawString skips a raw string literal R"delimiter(...)delimiter"
+func (s *scanner) rawString() {
+	open := strings.IndexByte(s.src[s.pos:], '(')
+	if open == -1 {
+		s.quoted('"')
+		return
+	}
+	delimiter := s.src[s.pos+1 : s.pos+open]
+	end := strings.Index(s.src[s.pos+open:], ")"+delimiter+"\"")
+	if end == -1 {
+		end = len(s.src)
+	} else {
+		end += s.pos + open + len(delimiter) + 2
+	}
+	s.line += strings.Count(s.src[s.pos:end], "\n")
+	s.pos = end
+}
+
+func (s *scanner) number() {
+	for s.pos < len(s.src) {
+		c := s.src[s.pos]
+		switch {
+		case isIdentifierChar(c) || c == '.':
+			s.pos++
+		case c == '\'' && s.pos+1 < len(s.src) && isIdentifierChar(s.src[s.pos+1]):
+			// digit separator
+			s.pos++
+		case (c == '+' || c == '-') && strings.ContainsRune("eEpP", rune(s.src[s.pos-1])):
+			s.pos++
+		default:
+			return
+		}
+	}
+}
+
+// punctuationLength returns the length of the punctuator at the start of src.
+// Only the punctuators that matter for the declarations are recognized as a
+// single token, the others (like >> that may close two template argument lists)
+// are split into single characters.
+func punctuationLength(src string) int {
+	if strings.HasPrefix(src, "::") || strings.HasPrefix(src, "->") {
+		return 2
+	}
+	if strings.HasPrefix(src, "...") {
+		return 3
+	}
+	return 1
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentifierStart(c byte) bool {
+	return c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
+
+func isIdentifierChar(c byte) bool {
+	return isIdentifierStart(c) || isDigit(c)
+}
+
+func isStringPrefix(s string) bool {
+	switch s {
+	case "L", "u", "U", "u8", "R", "LR", "uR", "UR", "u8R":
+		return true
+	}
+	return false
+}
+
+func isCharPrefix(s string) bool {
+	switch s {
+	case "L", "u", "U", "u8":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"os"
+
+	"github.com/arduino/arduino-cli/legacy/builder/constants"
+	"github.com/arduino/arduino-cli/legacy/builder/prototypes"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+)
+
+// PrototypesGenerator finds the functions defined in the preprocessed sketch
+// source and generates the missing prototypes
+type PrototypesGenerator struct {
+	Source *string
+}
+
+func (s *PrototypesGenerator) Run(ctx *types.Context) error {
+	protos, line := prototypes.Generate(*s.Source, ctx.Sketch.MainFile)
+	if ctx.DebugLevel >= 10 {
+		for _, proto := range protos {
+			ctx.GetLogger().Fprintln(os.Stdout, constants.LOG_LEVEL_DEBUG, tr("Generated prototype: {0}"), proto)
+		}
+	}
+
+	ctx.Prototypes = protos
+	ctx.PrototypesLineWhereToInsert = line
+	return nil
+}
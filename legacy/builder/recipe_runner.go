@@ -20,6 +20,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/arduino/arduino-cli/arduino/builder/buildtrace"
 	"github.com/arduino/arduino-cli/legacy/builder/builder_utils"
 	"github.com/arduino/arduino-cli/legacy/builder/constants"
 	"github.com/arduino/arduino-cli/legacy/builder/types"
@@ -61,7 +62,9 @@ func (s *RecipeByPrefixSuffixRunner) Run(ctx *types.Context) error {
 			return nil
 		}
 
+		endStep := ctx.Trace.Step(buildtrace.CategoryRecipe, recipe)
 		_, _, err = utils.ExecCommand(ctx, command, utils.ShowIfVerbose /* stdout */, utils.Show /* stderr */)
+		endStep()
 		if err != nil {
 			return errors.WithStack(err)
 		}
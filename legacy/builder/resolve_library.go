@@ -46,7 +46,8 @@ func ResolveLibrary(ctx *types.Context, header string) *libraries.Library {
 		}
 	}
 
-	selected := resolver.ResolveFor(header, ctx.TargetPlatform.Platform.Architecture)
+	resolution := resolver.Resolve(header, ctx.TargetPlatform.Platform.Architecture)
+	selected := resolution.Selected.Library
 	if alreadyImported := importedLibraries.FindByName(selected.Name); alreadyImported != nil {
 		// Certain libraries might have the same name but be different.
 		// This usually happens when the user includes two or more custom libraries that have
@@ -63,6 +64,7 @@ func ResolveLibrary(ctx *types.Context, header string) *libraries.Library {
 	ctx.LibrariesResolutionResults[header] = types.LibraryResolutionResult{
 		Library:          selected,
 		NotUsedLibraries: filterOutLibraryFrom(candidates, selected),
+		Resolution:       resolution,
 	}
 
 	return selected
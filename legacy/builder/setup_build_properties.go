@@ -18,11 +18,13 @@ package builder
 import (
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/legacy/builder/constants"
 	"github.com/arduino/arduino-cli/legacy/builder/types"
 	properties "github.com/arduino/go-properties-orderedmap"
 	timeutils "github.com/arduino/go-timeutils"
@@ -118,11 +120,15 @@ func (s *SetupBuildProperties) Run(ctx *types.Context) error {
 		buildProperties.SetPath("build.source.path", sourcePath)
 	}
 
-	now := time.Now()
-	buildProperties.Set("extra.time.utc", strconv.FormatInt(now.Unix(), 10))
-	buildProperties.Set("extra.time.local", strconv.FormatInt(timeutils.LocalUnix(now), 10))
-	buildProperties.Set("extra.time.zone", strconv.Itoa(timeutils.TimezoneOffsetNoDST(now)))
-	buildProperties.Set("extra.time.dst", strconv.Itoa(timeutils.DaylightSavingsOffset(now)))
+	if ctx.Reproducible {
+		setReproducibleBuildProperties(buildProperties)
+	} else {
+		now := time.Now()
+		buildProperties.Set("extra.time.utc", strconv.FormatInt(now.Unix(), 10))
+		buildProperties.Set("extra.time.local", strconv.FormatInt(timeutils.LocalUnix(now), 10))
+		buildProperties.Set("extra.time.zone", strconv.Itoa(timeutils.TimezoneOffsetNoDST(now)))
+		buildProperties.Set("extra.time.dst", strconv.Itoa(timeutils.DaylightSavingsOffset(now)))
+	}
 
 	buildProperties.Merge(ctx.PackageManager.CustomGlobalProperties)
 
@@ -130,3 +136,51 @@ func (s *SetupBuildProperties) Run(ctx *types.Context) error {
 
 	return nil
 }
+
+// setReproducibleBuildProperties sets the build time to the one given by the
+// SOURCE_DATE_EPOCH environment variable (or to the Unix epoch), in UTC, and
+// the default flags that remove the build path, the sketch path and the user
+// home from the compiled files, unless the platform defines its own.
+func setReproducibleBuildProperties(buildProperties *properties.Map) {
+	var buildTime int64
+	if epoch, err := strconv.ParseInt(os.Getenv("SOURCE_DATE_EPOCH"), 10, 64); err == nil {
+		buildTime = epoch
+	}
+	buildProperties.Set("extra.time.utc", strconv.FormatInt(buildTime, 10))
+	buildProperties.Set("extra.time.local", strconv.FormatInt(buildTime, 10))
+	buildProperties.Set("extra.time.zone", "0")
+	buildProperties.Set("extra.time.dst", "0")
+
+	if buildProperties.ContainsKey(constants.BUILD_PROPERTIES_COMPILER_REPRODUCIBLE_FLAGS) {
+		return
+	}
+	buildProperties.Set(constants.BUILD_PROPERTIES_COMPILER_REPRODUCIBLE_FLAGS, reproduciblePrefixMapFlags(buildProperties))
+}
+
+// reproduciblePrefixMapFlags returns the flags replacing the paths in the debug
+// information and in the macros like __FILE__ and assert. The compiler uses the
+// last flag matching a path, so the longest paths go last: the build path and
+// the sketch path win over the user home containing them.
+func reproduciblePrefixMapFlags(buildProperties *properties.Map) string {
+	type prefixMap struct {
+		from, path, to string
+	}
+	maps := []prefixMap{{from: "{build.path}", path: buildProperties.Get("build.path"), to: "."}}
+	if sourcePath, ok := buildProperties.GetOk("build.source.path"); ok {
+		maps = append(maps, prefixMap{from: "{build.source.path}", path: sourcePath, to: "."})
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		maps = append(maps, prefixMap{from: home, path: home, to: "~"})
+	}
+	sort.SliceStable(maps, func(i, j int) bool {
+		return len(maps[i].path) < len(maps[j].path)
+	})
+
+	flags := []string{}
+	for _, m := range maps {
+		flags = append(flags,
+			`"-fdebug-prefix-map=`+m.from+`=`+m.to+`"`,
+			`"-fmacro-prefix-map=`+m.from+`=`+m.to+`"`)
+	}
+	return strings.Join(flags, " ")
+}
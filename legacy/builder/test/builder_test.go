@@ -60,7 +60,7 @@ func TestBuilderEmptySketch(t *testing.T) {
 	exist, err := buildPath.Join(constants.FOLDER_CORE, "HardwareSerial.cpp.o").ExistCheck()
 	NoError(t, err)
 	require.True(t, exist)
-	exist, err = buildPath.Join(constants.FOLDER_PREPROC, constants.FILE_CTAGS_TARGET_FOR_GCC_MINUS_E).ExistCheck()
+	exist, err = buildPath.Join(constants.FOLDER_PREPROC, constants.FILE_SKETCH_TARGET_FOR_GCC_MINUS_E).ExistCheck()
 	NoError(t, err)
 	require.True(t, exist)
 	exist, err = buildPath.Join(constants.FOLDER_SKETCH, "sketch1.ino.cpp.o").ExistCheck()
@@ -90,7 +90,7 @@ func TestBuilderBridge(t *testing.T) {
 	exist, err := buildPath.Join(constants.FOLDER_CORE, "HardwareSerial.cpp.o").ExistCheck()
 	NoError(t, err)
 	require.True(t, exist)
-	exist, err = buildPath.Join(constants.FOLDER_PREPROC, constants.FILE_CTAGS_TARGET_FOR_GCC_MINUS_E).ExistCheck()
+	exist, err = buildPath.Join(constants.FOLDER_PREPROC, constants.FILE_SKETCH_TARGET_FOR_GCC_MINUS_E).ExistCheck()
 	NoError(t, err)
 	require.True(t, exist)
 	exist, err = buildPath.Join(constants.FOLDER_SKETCH, "Bridge.ino.cpp.o").ExistCheck()
@@ -123,7 +123,7 @@ func TestBuilderSketchWithConfig(t *testing.T) {
 	exist, err := buildPath.Join(constants.FOLDER_CORE, "HardwareSerial.cpp.o").ExistCheck()
 	NoError(t, err)
 	require.True(t, exist)
-	exist, err = buildPath.Join(constants.FOLDER_PREPROC, constants.FILE_CTAGS_TARGET_FOR_GCC_MINUS_E).ExistCheck()
+	exist, err = buildPath.Join(constants.FOLDER_PREPROC, constants.FILE_SKETCH_TARGET_FOR_GCC_MINUS_E).ExistCheck()
 	NoError(t, err)
 	require.True(t, exist)
 	exist, err = buildPath.Join(constants.FOLDER_SKETCH, "sketch_with_config.ino.cpp.o").ExistCheck()
@@ -161,7 +161,7 @@ func TestBuilderBridgeTwice(t *testing.T) {
 	exist, err := buildPath.Join(constants.FOLDER_CORE, "HardwareSerial.cpp.o").ExistCheck()
 	NoError(t, err)
 	require.True(t, exist)
-	exist, err = buildPath.Join(constants.FOLDER_PREPROC, constants.FILE_CTAGS_TARGET_FOR_GCC_MINUS_E).ExistCheck()
+	exist, err = buildPath.Join(constants.FOLDER_PREPROC, constants.FILE_SKETCH_TARGET_FOR_GCC_MINUS_E).ExistCheck()
 	NoError(t, err)
 	require.True(t, exist)
 	exist, err = buildPath.Join(constants.FOLDER_SKETCH, "Bridge.ino.cpp.o").ExistCheck()
@@ -201,7 +201,7 @@ func TestBuilderBridgeSAM(t *testing.T) {
 	exist, err = buildPath.Join(constants.FOLDER_CORE, "avr", "dtostrf.c.d").ExistCheck()
 	NoError(t, err)
 	require.True(t, exist)
-	exist, err = buildPath.Join(constants.FOLDER_PREPROC, constants.FILE_CTAGS_TARGET_FOR_GCC_MINUS_E).ExistCheck()
+	exist, err = buildPath.Join(constants.FOLDER_PREPROC, constants.FILE_SKETCH_TARGET_FOR_GCC_MINUS_E).ExistCheck()
 	NoError(t, err)
 	require.True(t, exist)
 	exist, err = buildPath.Join(constants.FOLDER_SKETCH, "Bridge.ino.cpp.o").ExistCheck()
@@ -241,7 +241,7 @@ func TestBuilderBridgeRedBearLab(t *testing.T) {
 	exist, err := buildPath.Join(constants.FOLDER_CORE, "HardwareSerial.cpp.o").ExistCheck()
 	NoError(t, err)
 	require.True(t, exist)
-	exist, err = buildPath.Join(constants.FOLDER_PREPROC, constants.FILE_CTAGS_TARGET_FOR_GCC_MINUS_E).ExistCheck()
+	exist, err = buildPath.Join(constants.FOLDER_PREPROC, constants.FILE_SKETCH_TARGET_FOR_GCC_MINUS_E).ExistCheck()
 	NoError(t, err)
 	require.True(t, exist)
 	exist, err = buildPath.Join(constants.FOLDER_SKETCH, "Bridge.ino.cpp.o").ExistCheck()
@@ -0,0 +1,73 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/arduino/arduino-cli/legacy/builder"
+	"github.com/arduino/arduino-cli/legacy/builder/i18n"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	paths "github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportMergedImageWithBinBootloader(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "export_merged_image")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	buildPath := tmp.Join("build")
+	require.NoError(t, buildPath.MkdirAll())
+	require.NoError(t, buildPath.Join("sketch.ino.bin").WriteFile([]byte{1, 2, 3, 4}))
+	require.NoError(t, tmp.Join("bootloaders").MkdirAll())
+	require.NoError(t, tmp.Join("bootloaders", "boot.bin").WriteFile([]byte{9, 8}))
+
+	export := func(props map[string]string) (string, error) {
+		buildProperties := properties.NewFromHashmap(map[string]string{
+			"build.project_name":       "sketch.ino",
+			"build.image.base_address": "0x10",
+			"runtime.platform.path":    tmp.String(),
+		})
+		buildProperties.Merge(properties.NewFromHashmap(props))
+		out := &bytes.Buffer{}
+		ctx := &types.Context{
+			BuildPath:       buildPath,
+			BuildProperties: buildProperties,
+			ExportFormats:   []string{"bin"},
+		}
+		ctx.SetLogger(i18n.LoggerToCustomStreams{Stdout: out, Stderr: out})
+		err := (&builder.ExportMergedImage{}).Run(ctx)
+		return out.String(), err
+	}
+
+	// The address of a .bin bootloader is required
+	_, err = export(map[string]string{"bootloader.file": "boot.bin"})
+	require.Error(t, err)
+
+	_, err = export(map[string]string{"bootloader.file": "boot.bin", "build.image.bootloader.offset": "0x4"})
+	require.NoError(t, err)
+	data, err := buildPath.Join("sketch.ino.merged.bin").ReadFile()
+	require.NoError(t, err)
+	// The bin image starts at the lowest address, the bootloader one
+	require.Equal(t, []byte{9, 8, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 1, 2, 3, 4}, data)
+
+	// A missing bootloader is reported
+	out, err := export(map[string]string{"bootloader.file": "missing.bin"})
+	require.NoError(t, err)
+	require.Contains(t, out, "missing.bin")
+}
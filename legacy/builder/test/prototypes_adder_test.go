@@ -351,10 +351,6 @@ func TestPrototypesAdderSketchWithStruct(t *testing.T) {
 
 	preprocessed := LoadAndInterpolate(t, filepath.Join("SketchWithStruct", "SketchWithStruct.preprocessed.txt"), ctx)
 	obtained := strings.Replace(ctx.Source, "\r\n", "\n", -1)
-	// ctags based preprocessing removes the space after "dostuff", but this is still OK
-	// TODO: remove this exception when moving to a more powerful parser
-	preprocessed = strings.Replace(preprocessed, "void dostuff (A_NEW_TYPE * bar);", "void dostuff(A_NEW_TYPE * bar);", 1)
-	obtained = strings.Replace(obtained, "void dostuff (A_NEW_TYPE * bar);", "void dostuff(A_NEW_TYPE * bar);", 1)
 	require.Equal(t, preprocessed, obtained)
 }
 
@@ -574,16 +570,8 @@ func TestPrototypesAdderSketchWithInlineFunction(t *testing.T) {
 
 	require.Contains(t, ctx.Source, "#include <Arduino.h>\n#line 1 "+quotedSketchLocation+"\n")
 
-	expected := "#line 1 " + quotedSketchLocation + "\nvoid setup();\n#line 2 " + quotedSketchLocation + "\nvoid loop();\n#line 4 " + quotedSketchLocation + "\nshort unsigned int testInt();\n#line 8 " + quotedSketchLocation + "\nstatic int8_t testInline();\n#line 12 " + quotedSketchLocation + "\n__attribute__((always_inline)) uint8_t testAttribute();\n#line 1 " + quotedSketchLocation + "\n"
+	expected := "#line 1 " + quotedSketchLocation + "\nvoid setup();\n#line 2 " + quotedSketchLocation + "\nvoid loop();\n#line 4 " + quotedSketchLocation + "\nshort unsigned int testInt();\n#line 8 " + quotedSketchLocation + "\nstatic inline int8_t testInline();\n#line 12 " + quotedSketchLocation + "\n__attribute__((always_inline)) uint8_t testAttribute();\n#line 1 " + quotedSketchLocation + "\n"
 	obtained := ctx.PrototypesSection
-	// ctags based preprocessing removes "inline" but this is still OK
-	// TODO: remove this exception when moving to a more powerful parser
-	expected = strings.Replace(expected, "static inline int8_t testInline();", "static int8_t testInline();", -1)
-	obtained = strings.Replace(obtained, "static inline int8_t testInline();", "static int8_t testInline();", -1)
-	// ctags based preprocessing removes "__attribute__ ....." but this is still OK
-	// TODO: remove this exception when moving to a more powerful parser
-	expected = strings.Replace(expected, "__attribute__((always_inline)) uint8_t testAttribute();", "uint8_t testAttribute();", -1)
-	obtained = strings.Replace(obtained, "__attribute__((always_inline)) uint8_t testAttribute();", "uint8_t testAttribute();", -1)
 	require.Equal(t, expected, obtained)
 }
 
@@ -714,10 +702,6 @@ func TestPrototypesAdderSketchWithTypename(t *testing.T) {
 	require.Contains(t, ctx.Source, "#include <Arduino.h>\n#line 1 "+quotedSketchLocation+"\n")
 	expected := "#line 6 " + quotedSketchLocation + "\nvoid setup();\n#line 10 " + quotedSketchLocation + "\nvoid loop();\n#line 12 " + quotedSketchLocation + "\ntypename Foo<char>::Bar func();\n#line 6 " + quotedSketchLocation + "\n"
 	obtained := ctx.PrototypesSection
-	// ctags based preprocessing ignores line with typename
-	// TODO: remove this exception when moving to a more powerful parser
-	expected = strings.Replace(expected, "#line 12 "+quotedSketchLocation+"\ntypename Foo<char>::Bar func();\n", "", -1)
-	obtained = strings.Replace(obtained, "#line 12 "+quotedSketchLocation+"\ntypename Foo<char>::Bar func();\n", "", -1)
 	require.Equal(t, expected, obtained)
 }
 
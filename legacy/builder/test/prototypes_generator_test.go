@@ -17,7 +17,6 @@ package test
 
 import (
 	"path/filepath"
-	"strings"
 	"testing"
 
 	"github.com/arduino/arduino-cli/legacy/builder"
@@ -26,7 +25,7 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestCTagsRunner(t *testing.T) {
+func TestPrototypesGenerator(t *testing.T) {
 	DownloadCoresAndToolsAndLibraries(t)
 
 	sketchLocation := Abs(t, paths.New("downloaded_libraries", "Bridge", "examples", "Bridge", "Bridge.ino"))
@@ -55,8 +54,7 @@ func TestCTagsRunner(t *testing.T) {
 
 		&builder.PrintUsedLibrariesIfVerbose{},
 		&builder.WarnAboutArchIncompatibleLibraries{},
-		&builder.CTagsTargetFileSaver{Source: &ctx.Source, TargetFileName: "ctags_target.cpp"},
-		&builder.CTagsRunner{},
+		&builder.PrototypesGenerator{Source: &ctx.Source},
 	}
 
 	for _, command := range commands {
@@ -64,19 +62,23 @@ func TestCTagsRunner(t *testing.T) {
 		NoError(t, err)
 	}
 
-	quotedSketchLocation := strings.Replace(sketchLocation.String(), "\\", "\\\\", -1)
-	expectedOutput := "server	" + quotedSketchLocation + "	/^BridgeServer server;$/;\"	kind:variable	line:31\n" +
-		"setup	" + quotedSketchLocation + "	/^void setup() {$/;\"	kind:function	line:33	signature:()	returntype:void\n" +
-		"loop	" + quotedSketchLocation + "	/^void loop() {$/;\"	kind:function	line:46	signature:()	returntype:void\n" +
-		"process	" + quotedSketchLocation + "	/^void process(BridgeClient client) {$/;\"	kind:function	line:62	signature:(BridgeClient client)	returntype:void\n" +
-		"digitalCommand	" + quotedSketchLocation + "	/^void digitalCommand(BridgeClient client) {$/;\"	kind:function	line:82	signature:(BridgeClient client)	returntype:void\n" +
-		"analogCommand	" + quotedSketchLocation + "	/^void analogCommand(BridgeClient client) {$/;\"	kind:function	line:109	signature:(BridgeClient client)	returntype:void\n" +
-		"modeCommand	" + quotedSketchLocation + "	/^void modeCommand(BridgeClient client) {$/;\"	kind:function	line:149	signature:(BridgeClient client)	returntype:void\n"
-
-	require.Equal(t, expectedOutput, strings.Replace(ctx.CTagsOutput, "\r\n", "\n", -1))
+	prototypes := []string{}
+	for _, prototype := range ctx.Prototypes {
+		require.Equal(t, sketchLocation.String(), prototype.File)
+		prototypes = append(prototypes, prototype.Prototype)
+	}
+	require.Equal(t, []string{
+		"void setup();",
+		"void loop();",
+		"void process(BridgeClient client);",
+		"void digitalCommand(BridgeClient client);",
+		"void analogCommand(BridgeClient client);",
+		"void modeCommand(BridgeClient client);",
+	}, prototypes)
+	require.Equal(t, 33, ctx.PrototypesLineWhereToInsert)
 }
 
-func TestCTagsRunnerSketchWithClass(t *testing.T) {
+func TestPrototypesGeneratorSketchWithClass(t *testing.T) {
 	DownloadCoresAndToolsAndLibraries(t)
 
 	sketchLocation := Abs(t, paths.New("sketch_with_class", "sketch_with_class.ino"))
@@ -105,8 +107,7 @@ func TestCTagsRunnerSketchWithClass(t *testing.T) {
 
 		&builder.PrintUsedLibrariesIfVerbose{},
 		&builder.WarnAboutArchIncompatibleLibraries{},
-		&builder.CTagsTargetFileSaver{Source: &ctx.Source, TargetFileName: "ctags_target.cpp"},
-		&builder.CTagsRunner{},
+		&builder.PrototypesGenerator{Source: &ctx.Source},
 	}
 
 	for _, command := range commands {
@@ -114,17 +115,19 @@ func TestCTagsRunnerSketchWithClass(t *testing.T) {
 		NoError(t, err)
 	}
 
-	quotedSketchLocation := strings.Replace(sketchLocation.String(), "\\", "\\\\", -1)
-	expectedOutput := "set_values\t" + quotedSketchLocation + "\t/^    void set_values (int,int);$/;\"\tkind:prototype\tline:4\tclass:Rectangle\tsignature:(int,int)\treturntype:void\n" +
-		"area\t" + quotedSketchLocation + "\t/^    int area() {return width*height;}$/;\"\tkind:function\tline:5\tclass:Rectangle\tsignature:()\treturntype:int\n" +
-		"set_values\t" + quotedSketchLocation + "\t/^void Rectangle::set_values (int x, int y) {$/;\"\tkind:function\tline:8\tclass:Rectangle\tsignature:(int x, int y)\treturntype:void\n" +
-		"setup\t" + quotedSketchLocation + "\t/^void setup() {$/;\"\tkind:function\tline:13\tsignature:()\treturntype:void\n" +
-		"loop\t" + quotedSketchLocation + "\t/^void loop() {$/;\"\tkind:function\tline:17\tsignature:()\treturntype:void\n"
-
-	require.Equal(t, expectedOutput, strings.Replace(ctx.CTagsOutput, "\r\n", "\n", -1))
+	prototypes := []string{}
+	for _, prototype := range ctx.Prototypes {
+		require.Equal(t, sketchLocation.String(), prototype.File)
+		prototypes = append(prototypes, prototype.Prototype)
+	}
+	require.Equal(t, []string{
+		"void setup();",
+		"void loop();",
+	}, prototypes)
+	require.Equal(t, 8, ctx.PrototypesLineWhereToInsert)
 }
 
-func TestCTagsRunnerSketchWithTypename(t *testing.T) {
+func TestPrototypesGeneratorSketchWithTypename(t *testing.T) {
 	DownloadCoresAndToolsAndLibraries(t)
 
 	sketchLocation := Abs(t, paths.New("sketch_with_typename", "sketch_with_typename.ino"))
@@ -153,8 +156,7 @@ func TestCTagsRunnerSketchWithTypename(t *testing.T) {
 
 		&builder.PrintUsedLibrariesIfVerbose{},
 		&builder.WarnAboutArchIncompatibleLibraries{},
-		&builder.CTagsTargetFileSaver{Source: &ctx.Source, TargetFileName: "ctags_target.cpp"},
-		&builder.CTagsRunner{},
+		&builder.PrototypesGenerator{Source: &ctx.Source},
 	}
 
 	for _, command := range commands {
@@ -162,16 +164,20 @@ func TestCTagsRunnerSketchWithTypename(t *testing.T) {
 		NoError(t, err)
 	}
 
-	quotedSketchLocation := strings.Replace(sketchLocation.String(), "\\", "\\\\", -1)
-	expectedOutput := "Foo\t" + quotedSketchLocation + "\t/^  struct Foo{$/;\"\tkind:struct\tline:2\n" +
-		"setup\t" + quotedSketchLocation + "\t/^void setup() {$/;\"\tkind:function\tline:6\tsignature:()\treturntype:void\n" +
-		"loop\t" + quotedSketchLocation + "\t/^void loop() {}$/;\"\tkind:function\tline:10\tsignature:()\treturntype:void\n" +
-		"func\t" + quotedSketchLocation + "\t/^typename Foo<char>::Bar func(){$/;\"\tkind:function\tline:12\tsignature:()\treturntype:Foo::Bar\n"
-
-	require.Equal(t, expectedOutput, strings.Replace(ctx.CTagsOutput, "\r\n", "\n", -1))
+	prototypes := []string{}
+	for _, prototype := range ctx.Prototypes {
+		require.Equal(t, sketchLocation.String(), prototype.File)
+		prototypes = append(prototypes, prototype.Prototype)
+	}
+	require.Equal(t, []string{
+		"void setup();",
+		"void loop();",
+		"typename Foo<char>::Bar func();",
+	}, prototypes)
+	require.Equal(t, 6, ctx.PrototypesLineWhereToInsert)
 }
 
-func TestCTagsRunnerSketchWithNamespace(t *testing.T) {
+func TestPrototypesGeneratorSketchWithNamespace(t *testing.T) {
 	DownloadCoresAndToolsAndLibraries(t)
 
 	sketchLocation := Abs(t, paths.New("sketch_with_namespace", "sketch_with_namespace.ino"))
@@ -200,8 +206,7 @@ func TestCTagsRunnerSketchWithNamespace(t *testing.T) {
 
 		&builder.PrintUsedLibrariesIfVerbose{},
 		&builder.WarnAboutArchIncompatibleLibraries{},
-		&builder.CTagsTargetFileSaver{Source: &ctx.Source, TargetFileName: "ctags_target.cpp"},
-		&builder.CTagsRunner{},
+		&builder.PrototypesGenerator{Source: &ctx.Source},
 	}
 
 	for _, command := range commands {
@@ -209,15 +214,19 @@ func TestCTagsRunnerSketchWithNamespace(t *testing.T) {
 		NoError(t, err)
 	}
 
-	quotedSketchLocation := strings.Replace(sketchLocation.String(), "\\", "\\\\", -1)
-	expectedOutput := "value\t" + quotedSketchLocation + "\t/^\tint value() {$/;\"\tkind:function\tline:2\tnamespace:Test\tsignature:()\treturntype:int\n" +
-		"setup\t" + quotedSketchLocation + "\t/^void setup() {}$/;\"\tkind:function\tline:7\tsignature:()\treturntype:void\n" +
-		"loop\t" + quotedSketchLocation + "\t/^void loop() {}$/;\"\tkind:function\tline:8\tsignature:()\treturntype:void\n"
-
-	require.Equal(t, expectedOutput, strings.Replace(ctx.CTagsOutput, "\r\n", "\n", -1))
+	prototypes := []string{}
+	for _, prototype := range ctx.Prototypes {
+		require.Equal(t, sketchLocation.String(), prototype.File)
+		prototypes = append(prototypes, prototype.Prototype)
+	}
+	require.Equal(t, []string{
+		"void setup();",
+		"void loop();",
+	}, prototypes)
+	require.Equal(t, 7, ctx.PrototypesLineWhereToInsert)
 }
 
-func TestCTagsRunnerSketchWithTemplates(t *testing.T) {
+func TestPrototypesGeneratorSketchWithTemplates(t *testing.T) {
 	DownloadCoresAndToolsAndLibraries(t)
 
 	sketchLocation := Abs(t, paths.New("sketch_with_templates_and_shift", "sketch_with_templates_and_shift.ino"))
@@ -246,8 +255,7 @@ func TestCTagsRunnerSketchWithTemplates(t *testing.T) {
 
 		&builder.PrintUsedLibrariesIfVerbose{},
 		&builder.WarnAboutArchIncompatibleLibraries{},
-		&builder.CTagsTargetFileSaver{Source: &ctx.Source, TargetFileName: "ctags_target.cpp"},
-		&builder.CTagsRunner{},
+		&builder.PrototypesGenerator{Source: &ctx.Source},
 	}
 
 	for _, command := range commands {
@@ -255,11 +263,14 @@ func TestCTagsRunnerSketchWithTemplates(t *testing.T) {
 		NoError(t, err)
 	}
 
-	quotedSketchLocation := strings.Replace(sketchLocation.String(), "\\", "\\\\", -1)
-	expectedOutput := "printGyro\t" + quotedSketchLocation + "\t/^void printGyro()$/;\"\tkind:function\tline:10\tsignature:()\treturntype:void\n" +
-		"bVar\t" + quotedSketchLocation + "\t/^c< 8 > bVar;$/;\"\tkind:variable\tline:15\n" +
-		"aVar\t" + quotedSketchLocation + "\t/^c< 1<<8 > aVar;$/;\"\tkind:variable\tline:16\n" +
-		"func\t" + quotedSketchLocation + "\t/^template<int X> func( c< 1<<X> & aParam) {$/;\"\tkind:function\tline:18\tsignature:( c< 1<<X> & aParam)\treturntype:template\n"
-
-	require.Equal(t, expectedOutput, strings.Replace(ctx.CTagsOutput, "\r\n", "\n", -1))
+	prototypes := []string{}
+	for _, prototype := range ctx.Prototypes {
+		require.Equal(t, sketchLocation.String(), prototype.File)
+		prototypes = append(prototypes, prototype.Prototype)
+	}
+	require.Equal(t, []string{
+		"void printGyro();",
+		"template<int X> func( c< 1<<X> & aParam);",
+	}, prototypes)
+	require.Equal(t, 10, ctx.PrototypesLineWhereToInsert)
 }
@@ -71,7 +71,7 @@ func TestLoadTools(t *testing.T) {
 	NoError(t, (&builder.ToolsLoader{}).Run(ctx))
 
 	tools := ctx.AllTools
-	require.Equal(t, 9, len(tools))
+	require.Equal(t, 8, len(tools))
 
 	sort.Sort(ByToolIDAndVersion(tools))
 
@@ -99,9 +99,6 @@ func TestLoadTools(t *testing.T) {
 	idx++
 	require.Equal(t, ":bossac@1.6.1-arduino", tools[idx].String())
 	requireEquivalentPaths(t, tools[idx].InstallDir.String(), "downloaded_tools/bossac/1.6.1-arduino")
-	idx++
-	require.Equal(t, ":ctags@5.8-arduino11", tools[idx].String())
-	requireEquivalentPaths(t, tools[idx].InstallDir.String(), "downloaded_tools/ctags/5.8-arduino11")
 }
 
 func TestLoadToolsWithBoardManagerFolderStructure(t *testing.T) {
@@ -141,7 +138,7 @@ func TestLoadLotsOfTools(t *testing.T) {
 	NoError(t, (&builder.ToolsLoader{}).Run(ctx))
 
 	tools := ctx.AllTools
-	require.Equal(t, 12, len(tools))
+	require.Equal(t, 11, len(tools))
 
 	sort.Sort(ByToolIDAndVersion(tools))
 
@@ -176,9 +173,6 @@ func TestLoadLotsOfTools(t *testing.T) {
 	require.Equal(t, ":bossac@1.6.1-arduino", tools[idx].String())
 	requireEquivalentPaths(t, tools[idx].InstallDir.String(), tools[idx].InstallDir.String(), "downloaded_tools/bossac/1.6.1-arduino")
 	idx++
-	require.Equal(t, ":ctags@5.8-arduino11", tools[idx].String())
-	requireEquivalentPaths(t, tools[idx].InstallDir.String(), "downloaded_tools/ctags/5.8-arduino11")
-	idx++
 	require.Equal(t, "arduino:openocd@0.9.0-arduino", tools[idx].String())
 	requireEquivalentPaths(t, tools[idx].InstallDir.String(), "downloaded_board_manager_stuff/arduino/tools/openocd/0.9.0-arduino")
 }
@@ -16,16 +16,25 @@
 package types
 
 import (
+	"context"
 	"io"
+	"os"
 	"strings"
+	"sync"
 
 	"github.com/arduino/arduino-cli/arduino/builder"
+	"github.com/arduino/arduino-cli/arduino/builder/buildcache"
+	"github.com/arduino/arduino-cli/arduino/builder/buildtrace"
+	"github.com/arduino/arduino-cli/arduino/builder/diagnostics"
+	"github.com/arduino/arduino-cli/arduino/builder/scheduler"
+	"github.com/arduino/arduino-cli/arduino/builder/sizereport"
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/arduino/libraries"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesresolver"
 	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/legacy/builder/constants"
 	"github.com/arduino/arduino-cli/legacy/builder/i18n"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	paths "github.com/arduino/go-paths-helper"
@@ -37,9 +46,17 @@ type ProgressStruct struct {
 	Progress     float32
 	StepAmount   float32
 	Parent       *ProgressStruct
+
+	// Amount of the steps whose jobs are still running
+	pending float32
+	// Last progress returned by Current
+	current float32
+	mux     sync.Mutex
 }
 
 func (p *ProgressStruct) AddSubSteps(steps int) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
 	p.Parent = &ProgressStruct{
 		Progress:   p.Progress,
 		StepAmount: p.StepAmount,
@@ -52,15 +69,47 @@ func (p *ProgressStruct) AddSubSteps(steps int) {
 }
 
 func (p *ProgressStruct) RemoveSubSteps() {
+	p.mux.Lock()
+	defer p.mux.Unlock()
 	p.Progress = p.Parent.Progress
 	p.StepAmount = p.Parent.StepAmount
 	p.Parent = p.Parent.Parent
 }
 
 func (p *ProgressStruct) CompleteStep() {
+	p.mux.Lock()
+	defer p.mux.Unlock()
 	p.Progress += p.StepAmount
 }
 
+// ScheduleStep completes a step done by a scheduled job: the step is left out
+// of the Current progress until the returned function is called, when the job
+// is completed.
+func (p *ProgressStruct) ScheduleStep() func() {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	amount := p.StepAmount
+	p.Progress += amount
+	p.pending += amount
+	return func() {
+		p.mux.Lock()
+		defer p.mux.Unlock()
+		p.pending -= amount
+	}
+}
+
+// Current returns the progress without the steps of the jobs still running.
+// The progress never goes back, even if the steps are scheduled in a different
+// order than they are completed.
+func (p *ProgressStruct) Current() float32 {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	if current := p.Progress - p.pending; current > p.current {
+		p.current = current
+	}
+	return p.current
+}
+
 // Context structure
 type Context struct {
 	// Build options
@@ -100,6 +149,7 @@ type Context struct {
 	SketchBuildPath              *paths.Path
 	CoreBuildPath                *paths.Path
 	CoreBuildCachePath           *paths.Path
+	ObjectsBuildCachePath        *paths.Path
 	CoreArchiveFilePath          *paths.Path
 	CoreObjectsFiles             paths.PathList
 	LibrariesBuildPath           *paths.Path
@@ -115,20 +165,33 @@ type Context struct {
 	SourceGccMinusE string
 	CodeCompletions string
 
+	// Formats of the merged images of the sketch to write at the end of the build
+	ExportFormats []string
+
 	WarningsLevel string
+	// Warning levels of the sketch, of the libraries and of the platform,
+	// overriding WarningsLevel
+	WarningsPolicy WarningsPolicy
 
 	// Libraries handling
-	LibrariesManager           *librariesmanager.LibrariesManager
-	LibrariesResolver          *librariesresolver.Cpp
+	LibrariesManager  *librariesmanager.LibrariesManager
+	LibrariesResolver *librariesresolver.Cpp
+	// Libraries chosen by the user for the headers provided by more libraries,
+	// by header
+	LibraryHeaderOverrides     map[string]string
 	ImportedLibraries          libraries.List
 	LibrariesResolutionResults map[string]LibraryResolutionResult
 	IncludeFolders             paths.PathList
+	// Set to true to record in IncludeGraph the #include directives resolved
+	// to a library, in order of discovery
+	RecordIncludeGraph bool
+	IncludeGraph       []*IncludeGraphEdge
+	// Libraries already loaded from some of the OtherLibrariesDirs, by the
+	// absolute path of their directory: those directories are not scanned again
+	PreloadedLibraries map[string]libraries.List
 	//OutputGccMinusM            string
 
 	// C++ Parsing
-	CTagsOutput                 string
-	CTagsTargetFile             *paths.Path
-	CTagsOfPreprocessedSource   []*CTag
 	LineOffset                  int
 	PrototypesSection           string
 	PrototypesLineWhereToInsert int
@@ -142,6 +205,10 @@ type Context struct {
 	OptimizeForDebug  bool
 	OptimizationFlags string
 
+	// Set to true to avoid leaking the build path, the user home and the build
+	// time into the compiled binaries
+	Reproducible bool
+
 	// Dry run, only create progress map
 	Progress ProgressStruct
 
@@ -160,6 +227,16 @@ type Context struct {
 
 	// Parallel processes
 	Jobs int
+	// Pool of workers running the compilation jobs, shared with other builds
+	// running at the same time. If nil a pool of Jobs workers is created.
+	Workers scheduler.Workers
+	// Scheduler of the compilation jobs, shared by all the build phases
+	Scheduler *scheduler.Scheduler
+	// Functions collecting the results of the compilations scheduled by the build phases
+	scheduledCompilations []func() error
+
+	// Canceling this context stops the build, killing the running processes
+	runContext context.Context
 
 	// Out and Err stream to redirect all Exec commands
 	ExecStdout io.Writer
@@ -168,6 +245,42 @@ type Context struct {
 	// Sizer results
 	ExecutableSectionsSize ExecutablesFileSections
 
+	// Set to true to analyze the memory used by each symbol of the executable
+	SizeReport bool
+	// Memory usage report, filled when SizeReport or CompareToExecutable is set
+	MemoryUsageReport *sizereport.Report
+	// Executable of a previous build to compare the memory usage with
+	CompareToExecutable *paths.Path
+	// Build path of the previous executable, to find its object files (may be nil)
+	CompareToBuildPath *paths.Path
+	// Difference of the memory used from the previous executable, filled when
+	// CompareToExecutable is set
+	SizeComparison *sizereport.Comparison
+
+	// Diagnostics produced by the compiler
+	CompilerDiagnostics    []*diagnostics.Diagnostic
+	compilerDiagnosticsMux sync.Mutex
+	// Callback called for every diagnostic produced by the compiler, as soon as it's available
+	OnCompilerDiagnostic func(*diagnostics.Diagnostic)
+	// The flags accepted by the compilers, by compiler path and flag
+	compilerFlags    map[string]bool
+	compilerFlagsMux sync.Mutex
+
+	// Global cache of compiled object files, shared between builds
+	ObjectsCache *buildcache.Cache
+	// If set the global cache of compiled object files isn't used
+	ObjectsCacheDisabled bool
+	// The max size in bytes of the global cache of compiled object files, the
+	// least recently used objects are removed at the end of the build. 0 means
+	// no limit.
+	ObjectsCacheMaxSize int64
+	// Timing of the steps of the build, nil if not requested
+	Trace *buildtrace.Recorder
+
+	// Remote build cache shared over HTTP, used for the core archive and the
+	// object files, nil if not configured
+	RemoteBuildCache *buildcache.Remote
+
 	// Compilation Database to build/update
 	CompilationDatabase *builder.CompilationDatabase
 	// Set to true to skip build and produce only Compilation Database
@@ -177,6 +290,10 @@ type Context struct {
 	// The provided source data is used instead of reading it from disk.
 	// The keys of the map are paths relative to sketch folder.
 	SourceOverride map[string]string
+
+	// Subfolders of the sketch compiled recursively in addition to "src", e.g.
+	// the "test" folder when building the unit tests of the sketch.
+	SketchExtraSourceFolders []string
 }
 
 // ExecutableSectionSize represents a section of the executable output file
@@ -225,6 +342,13 @@ func (ctx *Context) ExtractBuildOptions() *properties.Map {
 	opts.Set("customBuildProperties", strings.Join(ctx.CustomBuildProperties, ","))
 	opts.Set("additionalFiles", strings.Join(additionalFilesRelative, ","))
 	opts.Set("compiler.optimization_flags", ctx.OptimizationFlags)
+	if ctx.Reproducible {
+		opts.Set("reproducible", "true")
+	}
+	// The objects compiled without the warnings as errors must be rebuilt
+	if !ctx.WarningsPolicy.IsEmpty() {
+		opts.Set("warningsPolicy", ctx.WarningsPolicy.String())
+	}
 	return opts
 }
 
@@ -242,6 +366,42 @@ func (ctx *Context) InjectBuildOptions(opts *properties.Map) {
 	ctx.ArduinoAPIVersion = opts.Get("runtime.ide.version")
 	ctx.CustomBuildProperties = strings.Split(opts.Get("customBuildProperties"), ",")
 	ctx.OptimizationFlags = opts.Get("compiler.optimization_flags")
+	ctx.Reproducible = opts.Get("reproducible") == "true"
+}
+
+// AddCompilerDiagnostics records the diagnostics produced by a compiler run.
+// Locations in the generated sketch sources are mapped back to the original files.
+func (ctx *Context) AddCompilerDiagnostics(diags []*diagnostics.Diagnostic) {
+	if len(diags) == 0 {
+		return
+	}
+	diagnostics.RemapGeneratedSources(diags, ctx.SketchBuildPath)
+
+	ctx.compilerDiagnosticsMux.Lock()
+	defer ctx.compilerDiagnosticsMux.Unlock()
+	ctx.CompilerDiagnostics = append(ctx.CompilerDiagnostics, diags...)
+	if ctx.OnCompilerDiagnostic != nil {
+		for _, diag := range diags {
+			ctx.OnCompilerDiagnostic(diag)
+		}
+	}
+}
+
+// CompilerSupportsFlag returns if the compiler accepts the flag, probe checks
+// the flag the first time it's used with the compiler in the build.
+func (ctx *Context) CompilerSupportsFlag(compiler, flag string, probe func() bool) bool {
+	ctx.compilerFlagsMux.Lock()
+	defer ctx.compilerFlagsMux.Unlock()
+	if ctx.compilerFlags == nil {
+		ctx.compilerFlags = map[string]bool{}
+	}
+	key := compiler + "\x00" + flag
+	supported, ok := ctx.compilerFlags[key]
+	if !ok {
+		supported = probe()
+		ctx.compilerFlags[key] = supported
+	}
+	return supported
 }
 
 func (ctx *Context) GetLogger() i18n.Logger {
@@ -254,3 +414,107 @@ func (ctx *Context) GetLogger() i18n.Logger {
 func (ctx *Context) SetLogger(l i18n.Logger) {
 	ctx.logger = l
 }
+
+// GetContext returns the context that stops the build when canceled
+func (ctx *Context) GetContext() context.Context {
+	if ctx.runContext == nil {
+		return context.Background()
+	}
+	return ctx.runContext
+}
+
+// SetContext sets the context that stops the build when canceled
+func (ctx *Context) SetContext(c context.Context) {
+	ctx.runContext = c
+}
+
+// WarningFlags returns the compiler flags for the warnings of the files compiled
+// in buildPath, using the level set by the WarningsPolicy for the sketch, the
+// library or the core being compiled
+func (ctx *Context) WarningFlags(buildProperties *properties.Map, buildPath *paths.Path) string {
+	isInside := func(dir *paths.Path) (string, bool) {
+		if dir == nil {
+			return "", false
+		}
+		rel, err := dir.RelTo(buildPath)
+		if err != nil || rel.String() == ".." || strings.HasPrefix(rel.String(), ".."+string(os.PathSeparator)) {
+			return "", false
+		}
+		return rel.String(), true
+	}
+
+	level := ctx.WarningsPolicy.Platform
+	asErrors := false
+	if _, ok := isInside(ctx.SketchBuildPath); ok {
+		level = ctx.WarningsPolicy.Sketch
+		asErrors = ctx.WarningsPolicy.SketchWarningsAsErrors
+	} else if rel, ok := isInside(ctx.LibrariesBuildPath); ok {
+		name := strings.SplitN(rel, string(os.PathSeparator), 2)[0]
+		for _, library := range ctx.ImportedLibraries {
+			if library.Name == name && (library.Location == libraries.User || library.Location == libraries.Unmanaged) {
+				level = ctx.WarningsPolicy.Libraries
+			}
+		}
+	}
+	if level == "" {
+		level = ctx.WarningsLevel
+	}
+
+	flags := buildProperties.Get(constants.BUILD_PROPERTIES_COMPILER_WARNING_FLAGS + "." + level)
+	if asErrors {
+		flags = strings.TrimSpace(flags + " -Werror")
+	}
+	return flags
+}
+
+// SketchSourceFolders returns the subfolders of the sketch build path that are
+// compiled recursively: "src" and the SketchExtraSourceFolders, if they exist
+func (ctx *Context) SketchSourceFolders() paths.PathList {
+	res := paths.PathList{}
+	for _, folder := range append([]string{"src"}, ctx.SketchExtraSourceFolders...) {
+		if path := ctx.SketchBuildPath.Join(folder); path.IsDir() {
+			res.Add(path)
+		}
+	}
+	return res
+}
+
+// GetScheduler returns the Scheduler of the compilation jobs, creating it if needed
+func (ctx *Context) GetScheduler() *scheduler.Scheduler {
+	if ctx.Scheduler == nil {
+		ctx.Scheduler = ctx.newScheduler()
+	}
+	return ctx.Scheduler
+}
+
+// ResetScheduler replaces the Scheduler of the compilation jobs with a new one
+func (ctx *Context) ResetScheduler() {
+	ctx.Scheduler = ctx.newScheduler()
+}
+
+func (ctx *Context) newScheduler() *scheduler.Scheduler {
+	if ctx.Workers != nil {
+		return scheduler.NewWithWorkers(ctx.Workers)
+	}
+	return scheduler.New(ctx.Jobs)
+}
+
+// AddScheduledCompilation registers a function that waits for the completion
+// of a compilation scheduled by a build phase and stores its results in the context.
+func (ctx *Context) AddScheduledCompilation(wait func() error) {
+	ctx.scheduledCompilations = append(ctx.scheduledCompilations, wait)
+}
+
+// WaitScheduledCompilations waits for all the compilations scheduled so far
+// and returns the first error.
+func (ctx *Context) WaitScheduledCompilations() error {
+	scheduled := ctx.scheduledCompilations
+	ctx.scheduledCompilations = nil
+	var firstErr error
+	for _, wait := range scheduled {
+		if err := wait(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
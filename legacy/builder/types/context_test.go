@@ -0,0 +1,79 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	paths "github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarningFlags(t *testing.T) {
+	buildPath := paths.New("build")
+	ctx := &Context{
+		WarningsLevel:      "default",
+		SketchBuildPath:    buildPath.Join("sketch"),
+		LibrariesBuildPath: buildPath.Join("libraries"),
+		CoreBuildPath:      buildPath.Join("core"),
+		ImportedLibraries: libraries.List{
+			{Name: "Mine", Location: libraries.User},
+			{Name: "Bundled", Location: libraries.PlatformBuiltIn},
+		},
+	}
+	props := properties.NewMap()
+	props.Set("compiler.warning_flags.none", "-w")
+	props.Set("compiler.warning_flags.default", "")
+	props.Set("compiler.warning_flags.more", "-Wall")
+	props.Set("compiler.warning_flags.all", "-Wall -Wextra")
+
+	// Without a policy the global level is used everywhere
+	require.Equal(t, "", ctx.WarningFlags(props, ctx.SketchBuildPath))
+	require.Equal(t, "", ctx.WarningFlags(props, ctx.CoreBuildPath))
+
+	ctx.WarningsPolicy = WarningsPolicy{Sketch: "all", SketchWarningsAsErrors: true, Libraries: "more", Platform: "none"}
+	require.Equal(t, "-Wall -Wextra -Werror", ctx.WarningFlags(props, ctx.SketchBuildPath))
+	require.Equal(t, "-Wall -Wextra -Werror", ctx.WarningFlags(props, ctx.SketchBuildPath.Join("src", "utils")))
+	require.Equal(t, "-Wall", ctx.WarningFlags(props, ctx.LibrariesBuildPath.Join("Mine")))
+	require.Equal(t, "-Wall", ctx.WarningFlags(props, ctx.LibrariesBuildPath.Join("Mine", "utility")))
+	require.Equal(t, "-w", ctx.WarningFlags(props, ctx.LibrariesBuildPath.Join("Bundled")))
+	require.Equal(t, "-w", ctx.WarningFlags(props, ctx.CoreBuildPath))
+
+	// The missing levels default to the global one
+	ctx.WarningsPolicy = WarningsPolicy{SketchWarningsAsErrors: true}
+	require.Equal(t, "-Werror", ctx.WarningFlags(props, ctx.SketchBuildPath))
+	require.Equal(t, "", ctx.WarningFlags(props, ctx.LibrariesBuildPath.Join("Mine")))
+}
+
+func TestCompilerSupportsFlag(t *testing.T) {
+	ctx := &Context{}
+	probes := 0
+	probe := func(supported bool) func() bool {
+		return func() bool {
+			probes++
+			return supported
+		}
+	}
+	require.True(t, ctx.CompilerSupportsFlag("gcc", "-fmacro-prefix-map=a=b", probe(true)))
+	require.False(t, ctx.CompilerSupportsFlag("old-gcc", "-fmacro-prefix-map=a=b", probe(false)))
+	require.True(t, ctx.CompilerSupportsFlag("old-gcc", "-fdebug-prefix-map=a=b", probe(true)))
+	// The flags are probed once for every compiler
+	require.True(t, ctx.CompilerSupportsFlag("gcc", "-fmacro-prefix-map=a=b", probe(false)))
+	require.False(t, ctx.CompilerSupportsFlag("old-gcc", "-fmacro-prefix-map=a=b", probe(true)))
+	require.Equal(t, 3, probes)
+}
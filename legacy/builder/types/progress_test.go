@@ -65,3 +65,38 @@ func TestProgress(t *testing.T) {
 	require.Equal(t, float32(0.0), p.Progress)
 	require.Equal(t, float32(0.0), p.StepAmount)
 }
+
+func TestProgressScheduledSteps(t *testing.T) {
+	p := &ProgressStruct{}
+	p.AddSubSteps(2)
+	{
+		p.AddSubSteps(2)
+		first := p.ScheduleStep()
+		second := p.ScheduleStep()
+		p.RemoveSubSteps()
+		p.CompleteStep()
+		require.InEpsilon(t, 50.0, p.Progress, 0.00001)
+		// The steps of the jobs still running are left out
+		require.Equal(t, float32(0.0), p.Current())
+
+		second()
+		require.InEpsilon(t, 25.0, p.Current(), 0.00001)
+		first()
+		require.InEpsilon(t, 50.0, p.Current(), 0.00001)
+	}
+	{
+		// Steps scheduled twice in the same step don't move the progress back
+		p.AddSubSteps(1)
+		done := p.ScheduleStep()
+		p.RemoveSubSteps()
+		p.AddSubSteps(1)
+		otherDone := p.ScheduleStep()
+		p.RemoveSubSteps()
+		p.CompleteStep()
+		require.InEpsilon(t, 50.0, p.Current(), 0.00001)
+		done()
+		otherDone()
+		require.InEpsilon(t, 100.0, p.Current(), 0.00001)
+	}
+	p.RemoveSubSteps()
+}
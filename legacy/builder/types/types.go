@@ -20,6 +20,7 @@ import (
 	"strconv"
 
 	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesresolver"
 	"github.com/arduino/arduino-cli/arduino/sketch"
 	"github.com/arduino/arduino-cli/i18n"
 	paths "github.com/arduino/go-paths-helper"
@@ -135,25 +136,50 @@ func (proto *Prototype) String() string {
 type LibraryResolutionResult struct {
 	Library          *libraries.Library
 	NotUsedLibraries []*libraries.Library
+	// Resolution is the score of every library providing the header and the
+	// reason of the choice
+	Resolution *librariesresolver.Resolution
 }
 
-type CTag struct {
-	FunctionName string
-	Kind         string
-	Line         int
-	Code         string
-	Class        string
-	Struct       string
-	Namespace    string
-	Filename     string
-	Typeref      string
-	SkipMe       bool
-	Signature    string
-
-	Prototype          string
-	PrototypeModifiers string
+// IncludeGraphEdge is an #include directive of a source file that has been
+// resolved to a library
+type IncludeGraphEdge struct {
+	// SourceFile is the file containing the #include directive
+	SourceFile *paths.Path
+	// SourceLibrary is the library containing the source file, nil for the sketch
+	SourceLibrary *libraries.Library
+	Resolution    *librariesresolver.Resolution
 }
 
 type Command interface {
 	Run(ctx *Context) error
 }
+
+// WarningsPolicy sets the warning level of each part of the build, the empty
+// levels default to the WarningsLevel of the Context
+type WarningsPolicy struct {
+	// Sketch is the warning level of the sketch
+	Sketch string
+	// Libraries is the warning level of the libraries installed by the user
+	// or given with the build request
+	Libraries string
+	// Platform is the warning level of the core, of the variant and of the
+	// libraries bundled with the platforms and with the IDE
+	Platform string
+	// SketchWarningsAsErrors makes the build fail on the warnings of the sketch
+	SketchWarningsAsErrors bool
+}
+
+// IsEmpty returns true if the policy doesn't change the warning level of any
+// part of the build
+func (p *WarningsPolicy) IsEmpty() bool {
+	return p.Sketch == "" && p.Libraries == "" && p.Platform == "" && !p.SketchWarningsAsErrors
+}
+
+func (p *WarningsPolicy) String() string {
+	sketch := p.Sketch
+	if p.SketchWarningsAsErrors {
+		sketch += "+werror"
+	}
+	return fmt.Sprintf("sketch=%s,libraries=%s,platform=%s", sketch, p.Libraries, p.Platform)
+}
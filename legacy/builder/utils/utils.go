@@ -29,6 +29,7 @@ import (
 	"unicode"
 	"unicode/utf8"
 
+	"github.com/arduino/arduino-cli/executils"
 	"github.com/arduino/arduino-cli/i18n"
 	"github.com/arduino/arduino-cli/legacy/builder/gohasissues"
 	"github.com/arduino/arduino-cli/legacy/builder/types"
@@ -175,11 +176,14 @@ const (
 )
 
 func ExecCommand(ctx *types.Context, command *exec.Cmd, stdout int, stderr int) ([]byte, []byte, error) {
-	if ctx.ExecStdout == nil {
-		ctx.ExecStdout = os.Stdout
+	// The commands are run concurrently, the context is only read: the
+	// defaults of the outputs are set by the builder before it starts
+	execStdout, execStderr := ctx.ExecStdout, ctx.ExecStderr
+	if execStdout == nil {
+		execStdout = os.Stdout
 	}
-	if ctx.ExecStderr == nil {
-		ctx.ExecStderr = os.Stderr
+	if execStderr == nil {
+		execStderr = os.Stderr
 	}
 
 	if ctx.Verbose {
@@ -190,22 +194,18 @@ func ExecCommand(ctx *types.Context, command *exec.Cmd, stdout int, stderr int)
 		buffer := &bytes.Buffer{}
 		command.Stdout = buffer
 	} else if stdout == Show || stdout == ShowIfVerbose && ctx.Verbose {
-		command.Stdout = ctx.ExecStdout
+		command.Stdout = execStdout
 	}
 
 	if stderr == Capture {
 		buffer := &bytes.Buffer{}
 		command.Stderr = buffer
 	} else if stderr == Show || stderr == ShowIfVerbose && ctx.Verbose {
-		command.Stderr = ctx.ExecStderr
+		command.Stderr = execStderr
 	}
 
-	err := command.Start()
-	if err != nil {
-		return nil, nil, errors.WithStack(err)
-	}
-
-	err = command.Wait()
+	// The command and the processes it starts are killed if the build is canceled
+	err := executils.RunWithinContext(ctx.GetContext(), command)
 
 	var outbytes, errbytes []byte
 	if buf, ok := command.Stdout.(*bytes.Buffer); ok {
@@ -20,24 +20,17 @@ import (
 	"github.com/pkg/errors"
 )
 
-type CTagsTargetFileSaver struct {
-	Source         *string
-	TargetFileName string
+// WaitScheduledCompilations waits for the compilations scheduled so far by the
+// build phases. If IfHooksPrefix is set, it waits only if the platform defines
+// hooks with that prefix: the hooks may depend on the compiled files, otherwise
+// the compilations can keep running in the background.
+type WaitScheduledCompilations struct {
+	IfHooksPrefix string
 }
 
-func (s *CTagsTargetFileSaver) Run(ctx *types.Context) error {
-	source := *s.Source
-
-	preprocPath := ctx.PreprocPath
-	if err := preprocPath.MkdirAll(); err != nil {
-		return errors.WithStack(err)
-	}
-
-	ctagsTargetFilePath := preprocPath.Join(s.TargetFileName)
-	if err := ctagsTargetFilePath.WriteFile([]byte(source)); err != nil {
-		return errors.WithStack(err)
+func (s *WaitScheduledCompilations) Run(ctx *types.Context) error {
+	if s.IfHooksPrefix != "" && len(findRecipes(ctx.BuildProperties, s.IfHooksPrefix, ".pattern")) == 0 {
+		return nil
 	}
-
-	ctx.CTagsTargetFile = ctagsTargetFilePath
-	return nil
+	return errors.WithStack(ctx.WaitScheduledCompilations())
 }
@@ -58,6 +58,19 @@ type ArduinoCoreServiceClient interface {
 	BoardListWatch(ctx context.Context, opts ...grpc.CallOption) (ArduinoCoreService_BoardListWatchClient, error)
 	// Compile an Arduino sketch.
 	Compile(ctx context.Context, in *CompileRequest, opts ...grpc.CallOption) (ArduinoCoreService_CompileClient, error)
+	// Compile an Arduino sketch every time one of its files (or one of the
+	// libraries being developed by the user) is changed, until the call is
+	// canceled.
+	CompileWatch(ctx context.Context, in *CompileWatchRequest, opts ...grpc.CallOption) (ArduinoCoreService_CompileWatchClient, error)
+	// Compile an Arduino sketch for many boards at the same time.
+	MultiCompile(ctx context.Context, in *MultiCompileRequest, opts ...grpc.CallOption) (*MultiCompileResponse, error)
+	// Export the build of a sketch as a standalone project, with the sources
+	// used by the build and a Makefile or a CMakeLists.txt running the commands
+	// of the build.
+	ExportSketch(ctx context.Context, in *ExportSketchRequest, opts ...grpc.CallOption) (ArduinoCoreService_ExportSketchClient, error)
+	// Build the unit tests of a sketch for the host system, with a mock of the
+	// Arduino API, and run them.
+	Test(ctx context.Context, in *TestRequest, opts ...grpc.CallOption) (ArduinoCoreService_TestClient, error)
 	// Download and install a platform and its tool dependencies.
 	PlatformInstall(ctx context.Context, in *PlatformInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformInstallClient, error)
 	// Download a platform and its tool dependencies to the `staging/packages`
@@ -70,6 +83,11 @@ type ArduinoCoreServiceClient interface {
 	PlatformUpgrade(ctx context.Context, in *PlatformUpgradeRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformUpgradeClient, error)
 	// Upload a compiled sketch to a board.
 	Upload(ctx context.Context, in *UploadRequest, opts ...grpc.CallOption) (ArduinoCoreService_UploadClient, error)
+	// Upload a compiled sketch to many boards at the same time.
+	MultiUpload(ctx context.Context, in *MultiUploadRequest, opts ...grpc.CallOption) (ArduinoCoreService_MultiUploadClient, error)
+	// Return the steps an upload would run, with the commands of the tools
+	// fully resolved, without running them.
+	UploadPlan(ctx context.Context, in *UploadPlanRequest, opts ...grpc.CallOption) (*UploadPlanResponse, error)
 	// Upload a compiled sketch to a board using a programmer.
 	UploadUsingProgrammer(ctx context.Context, in *UploadUsingProgrammerRequest, opts ...grpc.CallOption) (ArduinoCoreService_UploadUsingProgrammerClient, error)
 	// Returns the list of users fields necessary to upload to that board
@@ -103,6 +121,15 @@ type ArduinoCoreServiceClient interface {
 	LibrarySearch(ctx context.Context, in *LibrarySearchRequest, opts ...grpc.CallOption) (*LibrarySearchResponse, error)
 	// List the installed libraries.
 	LibraryList(ctx context.Context, in *LibraryListRequest, opts ...grpc.CallOption) (*LibraryListResponse, error)
+	// List the libraries providing a header, with the priority computed for
+	// each of them, and the one chosen by the build.
+	LibraryResolve(ctx context.Context, in *LibraryResolveRequest, opts ...grpc.CallOption) (*LibraryResolveResponse, error)
+	// Compile a library for one or more boards and package it, with the
+	// binaries laid out as a precompiled library, in a zip file.
+	LibraryPrecompile(ctx context.Context, in *LibraryPrecompileRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryPrecompileClient, error)
+	// Merge firmware images, like the sketch and the bootloader, in a single
+	// image, or convert an image to another format.
+	ImageMerge(ctx context.Context, in *ImageMergeRequest, opts ...grpc.CallOption) (*ImageMergeResponse, error)
 	// Open a monitor connection to a board port
 	Monitor(ctx context.Context, opts ...grpc.CallOption) (ArduinoCoreService_MonitorClient, error)
 	// Returns the parameters that can be set in the MonitorRequest calls
@@ -471,8 +498,113 @@ func (x *arduinoCoreServiceCompileClient) Recv() (*CompileResponse, error) {
 	return m, nil
 }
 
+func (c *arduinoCoreServiceClient) CompileWatch(ctx context.Context, in *CompileWatchRequest, opts ...grpc.CallOption) (ArduinoCoreService_CompileWatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[8], "/cc.arduino.cli.commands.v1.ArduinoCoreService/CompileWatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceCompileWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_CompileWatchClient interface {
+	Recv() (*CompileWatchResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceCompileWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceCompileWatchClient) Recv() (*CompileWatchResponse, error) {
+	m := new(CompileWatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreServiceClient) MultiCompile(ctx context.Context, in *MultiCompileRequest, opts ...grpc.CallOption) (*MultiCompileResponse, error) {
+	out := new(MultiCompileResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/MultiCompile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreServiceClient) ExportSketch(ctx context.Context, in *ExportSketchRequest, opts ...grpc.CallOption) (ArduinoCoreService_ExportSketchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[9], "/cc.arduino.cli.commands.v1.ArduinoCoreService/ExportSketch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceExportSketchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_ExportSketchClient interface {
+	Recv() (*ExportSketchResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceExportSketchClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceExportSketchClient) Recv() (*ExportSketchResponse, error) {
+	m := new(ExportSketchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreServiceClient) Test(ctx context.Context, in *TestRequest, opts ...grpc.CallOption) (ArduinoCoreService_TestClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[10], "/cc.arduino.cli.commands.v1.ArduinoCoreService/Test", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceTestClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_TestClient interface {
+	Recv() (*TestResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceTestClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceTestClient) Recv() (*TestResponse, error) {
+	m := new(TestResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *arduinoCoreServiceClient) PlatformInstall(ctx context.Context, in *PlatformInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformInstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[8], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformInstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[11], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformInstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -504,7 +636,7 @@ func (x *arduinoCoreServicePlatformInstallClient) Recv() (*PlatformInstallRespon
 }
 
 func (c *arduinoCoreServiceClient) PlatformDownload(ctx context.Context, in *PlatformDownloadRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformDownloadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[9], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformDownload", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[12], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformDownload", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -536,7 +668,7 @@ func (x *arduinoCoreServicePlatformDownloadClient) Recv() (*PlatformDownloadResp
 }
 
 func (c *arduinoCoreServiceClient) PlatformUninstall(ctx context.Context, in *PlatformUninstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformUninstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[10], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformUninstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[13], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformUninstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -568,7 +700,7 @@ func (x *arduinoCoreServicePlatformUninstallClient) Recv() (*PlatformUninstallRe
 }
 
 func (c *arduinoCoreServiceClient) PlatformUpgrade(ctx context.Context, in *PlatformUpgradeRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformUpgradeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[11], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformUpgrade", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[14], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformUpgrade", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -600,7 +732,7 @@ func (x *arduinoCoreServicePlatformUpgradeClient) Recv() (*PlatformUpgradeRespon
 }
 
 func (c *arduinoCoreServiceClient) Upload(ctx context.Context, in *UploadRequest, opts ...grpc.CallOption) (ArduinoCoreService_UploadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[12], "/cc.arduino.cli.commands.v1.ArduinoCoreService/Upload", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[15], "/cc.arduino.cli.commands.v1.ArduinoCoreService/Upload", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -631,8 +763,49 @@ func (x *arduinoCoreServiceUploadClient) Recv() (*UploadResponse, error) {
 	return m, nil
 }
 
+func (c *arduinoCoreServiceClient) MultiUpload(ctx context.Context, in *MultiUploadRequest, opts ...grpc.CallOption) (ArduinoCoreService_MultiUploadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[16], "/cc.arduino.cli.commands.v1.ArduinoCoreService/MultiUpload", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceMultiUploadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_MultiUploadClient interface {
+	Recv() (*MultiUploadResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceMultiUploadClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceMultiUploadClient) Recv() (*MultiUploadResponse, error) {
+	m := new(MultiUploadResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreServiceClient) UploadPlan(ctx context.Context, in *UploadPlanRequest, opts ...grpc.CallOption) (*UploadPlanResponse, error) {
+	out := new(UploadPlanResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/UploadPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *arduinoCoreServiceClient) UploadUsingProgrammer(ctx context.Context, in *UploadUsingProgrammerRequest, opts ...grpc.CallOption) (ArduinoCoreService_UploadUsingProgrammerClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[13], "/cc.arduino.cli.commands.v1.ArduinoCoreService/UploadUsingProgrammer", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[17], "/cc.arduino.cli.commands.v1.ArduinoCoreService/UploadUsingProgrammer", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -682,7 +855,7 @@ func (c *arduinoCoreServiceClient) ListProgrammersAvailableForUpload(ctx context
 }
 
 func (c *arduinoCoreServiceClient) BurnBootloader(ctx context.Context, in *BurnBootloaderRequest, opts ...grpc.CallOption) (ArduinoCoreService_BurnBootloaderClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[14], "/cc.arduino.cli.commands.v1.ArduinoCoreService/BurnBootloader", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[18], "/cc.arduino.cli.commands.v1.ArduinoCoreService/BurnBootloader", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -732,7 +905,7 @@ func (c *arduinoCoreServiceClient) PlatformList(ctx context.Context, in *Platfor
 }
 
 func (c *arduinoCoreServiceClient) LibraryDownload(ctx context.Context, in *LibraryDownloadRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryDownloadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[15], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryDownload", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[19], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryDownload", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -764,7 +937,7 @@ func (x *arduinoCoreServiceLibraryDownloadClient) Recv() (*LibraryDownloadRespon
 }
 
 func (c *arduinoCoreServiceClient) LibraryInstall(ctx context.Context, in *LibraryInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryInstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[16], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryInstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[20], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryInstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -796,7 +969,7 @@ func (x *arduinoCoreServiceLibraryInstallClient) Recv() (*LibraryInstallResponse
 }
 
 func (c *arduinoCoreServiceClient) ZipLibraryInstall(ctx context.Context, in *ZipLibraryInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_ZipLibraryInstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[17], "/cc.arduino.cli.commands.v1.ArduinoCoreService/ZipLibraryInstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[21], "/cc.arduino.cli.commands.v1.ArduinoCoreService/ZipLibraryInstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -828,7 +1001,7 @@ func (x *arduinoCoreServiceZipLibraryInstallClient) Recv() (*ZipLibraryInstallRe
 }
 
 func (c *arduinoCoreServiceClient) GitLibraryInstall(ctx context.Context, in *GitLibraryInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_GitLibraryInstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[18], "/cc.arduino.cli.commands.v1.ArduinoCoreService/GitLibraryInstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[22], "/cc.arduino.cli.commands.v1.ArduinoCoreService/GitLibraryInstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -860,7 +1033,7 @@ func (x *arduinoCoreServiceGitLibraryInstallClient) Recv() (*GitLibraryInstallRe
 }
 
 func (c *arduinoCoreServiceClient) LibraryUninstall(ctx context.Context, in *LibraryUninstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryUninstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[19], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryUninstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[23], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryUninstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -892,7 +1065,7 @@ func (x *arduinoCoreServiceLibraryUninstallClient) Recv() (*LibraryUninstallResp
 }
 
 func (c *arduinoCoreServiceClient) LibraryUpgradeAll(ctx context.Context, in *LibraryUpgradeAllRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryUpgradeAllClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[20], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryUpgradeAll", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[24], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryUpgradeAll", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -950,8 +1123,58 @@ func (c *arduinoCoreServiceClient) LibraryList(ctx context.Context, in *LibraryL
 	return out, nil
 }
 
+func (c *arduinoCoreServiceClient) LibraryResolve(ctx context.Context, in *LibraryResolveRequest, opts ...grpc.CallOption) (*LibraryResolveResponse, error) {
+	out := new(LibraryResolveResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryResolve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreServiceClient) LibraryPrecompile(ctx context.Context, in *LibraryPrecompileRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryPrecompileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[25], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryPrecompile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceLibraryPrecompileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_LibraryPrecompileClient interface {
+	Recv() (*LibraryPrecompileResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceLibraryPrecompileClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceLibraryPrecompileClient) Recv() (*LibraryPrecompileResponse, error) {
+	m := new(LibraryPrecompileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreServiceClient) ImageMerge(ctx context.Context, in *ImageMergeRequest, opts ...grpc.CallOption) (*ImageMergeResponse, error) {
+	out := new(ImageMergeResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/ImageMerge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *arduinoCoreServiceClient) Monitor(ctx context.Context, opts ...grpc.CallOption) (ArduinoCoreService_MonitorClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[21], "/cc.arduino.cli.commands.v1.ArduinoCoreService/Monitor", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[26], "/cc.arduino.cli.commands.v1.ArduinoCoreService/Monitor", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1034,6 +1257,19 @@ type ArduinoCoreServiceServer interface {
 	BoardListWatch(ArduinoCoreService_BoardListWatchServer) error
 	// Compile an Arduino sketch.
 	Compile(*CompileRequest, ArduinoCoreService_CompileServer) error
+	// Compile an Arduino sketch every time one of its files (or one of the
+	// libraries being developed by the user) is changed, until the call is
+	// canceled.
+	CompileWatch(*CompileWatchRequest, ArduinoCoreService_CompileWatchServer) error
+	// Compile an Arduino sketch for many boards at the same time.
+	MultiCompile(context.Context, *MultiCompileRequest) (*MultiCompileResponse, error)
+	// Export the build of a sketch as a standalone project, with the sources
+	// used by the build and a Makefile or a CMakeLists.txt running the commands
+	// of the build.
+	ExportSketch(*ExportSketchRequest, ArduinoCoreService_ExportSketchServer) error
+	// Build the unit tests of a sketch for the host system, with a mock of the
+	// Arduino API, and run them.
+	Test(*TestRequest, ArduinoCoreService_TestServer) error
 	// Download and install a platform and its tool dependencies.
 	PlatformInstall(*PlatformInstallRequest, ArduinoCoreService_PlatformInstallServer) error
 	// Download a platform and its tool dependencies to the `staging/packages`
@@ -1046,6 +1282,11 @@ type ArduinoCoreServiceServer interface {
 	PlatformUpgrade(*PlatformUpgradeRequest, ArduinoCoreService_PlatformUpgradeServer) error
 	// Upload a compiled sketch to a board.
 	Upload(*UploadRequest, ArduinoCoreService_UploadServer) error
+	// Upload a compiled sketch to many boards at the same time.
+	MultiUpload(*MultiUploadRequest, ArduinoCoreService_MultiUploadServer) error
+	// Return the steps an upload would run, with the commands of the tools
+	// fully resolved, without running them.
+	UploadPlan(context.Context, *UploadPlanRequest) (*UploadPlanResponse, error)
 	// Upload a compiled sketch to a board using a programmer.
 	UploadUsingProgrammer(*UploadUsingProgrammerRequest, ArduinoCoreService_UploadUsingProgrammerServer) error
 	// Returns the list of users fields necessary to upload to that board
@@ -1079,6 +1320,15 @@ type ArduinoCoreServiceServer interface {
 	LibrarySearch(context.Context, *LibrarySearchRequest) (*LibrarySearchResponse, error)
 	// List the installed libraries.
 	LibraryList(context.Context, *LibraryListRequest) (*LibraryListResponse, error)
+	// List the libraries providing a header, with the priority computed for
+	// each of them, and the one chosen by the build.
+	LibraryResolve(context.Context, *LibraryResolveRequest) (*LibraryResolveResponse, error)
+	// Compile a library for one or more boards and package it, with the
+	// binaries laid out as a precompiled library, in a zip file.
+	LibraryPrecompile(*LibraryPrecompileRequest, ArduinoCoreService_LibraryPrecompileServer) error
+	// Merge firmware images, like the sketch and the bootloader, in a single
+	// image, or convert an image to another format.
+	ImageMerge(context.Context, *ImageMergeRequest) (*ImageMergeResponse, error)
 	// Open a monitor connection to a board port
 	Monitor(ArduinoCoreService_MonitorServer) error
 	// Returns the parameters that can be set in the MonitorRequest calls
@@ -1147,6 +1397,18 @@ func (UnimplementedArduinoCoreServiceServer) BoardListWatch(ArduinoCoreService_B
 func (UnimplementedArduinoCoreServiceServer) Compile(*CompileRequest, ArduinoCoreService_CompileServer) error {
 	return status.Errorf(codes.Unimplemented, "method Compile not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) CompileWatch(*CompileWatchRequest, ArduinoCoreService_CompileWatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method CompileWatch not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) MultiCompile(context.Context, *MultiCompileRequest) (*MultiCompileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiCompile not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) ExportSketch(*ExportSketchRequest, ArduinoCoreService_ExportSketchServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSketch not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) Test(*TestRequest, ArduinoCoreService_TestServer) error {
+	return status.Errorf(codes.Unimplemented, "method Test not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) PlatformInstall(*PlatformInstallRequest, ArduinoCoreService_PlatformInstallServer) error {
 	return status.Errorf(codes.Unimplemented, "method PlatformInstall not implemented")
 }
@@ -1162,6 +1424,12 @@ func (UnimplementedArduinoCoreServiceServer) PlatformUpgrade(*PlatformUpgradeReq
 func (UnimplementedArduinoCoreServiceServer) Upload(*UploadRequest, ArduinoCoreService_UploadServer) error {
 	return status.Errorf(codes.Unimplemented, "method Upload not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) MultiUpload(*MultiUploadRequest, ArduinoCoreService_MultiUploadServer) error {
+	return status.Errorf(codes.Unimplemented, "method MultiUpload not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) UploadPlan(context.Context, *UploadPlanRequest) (*UploadPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadPlan not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) UploadUsingProgrammer(*UploadUsingProgrammerRequest, ArduinoCoreService_UploadUsingProgrammerServer) error {
 	return status.Errorf(codes.Unimplemented, "method UploadUsingProgrammer not implemented")
 }
@@ -1207,6 +1475,15 @@ func (UnimplementedArduinoCoreServiceServer) LibrarySearch(context.Context, *Lib
 func (UnimplementedArduinoCoreServiceServer) LibraryList(context.Context, *LibraryListRequest) (*LibraryListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LibraryList not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) LibraryResolve(context.Context, *LibraryResolveRequest) (*LibraryResolveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LibraryResolve not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) LibraryPrecompile(*LibraryPrecompileRequest, ArduinoCoreService_LibraryPrecompileServer) error {
+	return status.Errorf(codes.Unimplemented, "method LibraryPrecompile not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) ImageMerge(context.Context, *ImageMergeRequest) (*ImageMergeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImageMerge not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) Monitor(ArduinoCoreService_MonitorServer) error {
 	return status.Errorf(codes.Unimplemented, "method Monitor not implemented")
 }
@@ -1597,6 +1874,87 @@ func (x *arduinoCoreServiceCompileServer) Send(m *CompileResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ArduinoCoreService_CompileWatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CompileWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).CompileWatch(m, &arduinoCoreServiceCompileWatchServer{stream})
+}
+
+type ArduinoCoreService_CompileWatchServer interface {
+	Send(*CompileWatchResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceCompileWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceCompileWatchServer) Send(m *CompileWatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCoreService_MultiCompile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiCompileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).MultiCompile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.v1.ArduinoCoreService/MultiCompile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).MultiCompile(ctx, req.(*MultiCompileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCoreService_ExportSketch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSketchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).ExportSketch(m, &arduinoCoreServiceExportSketchServer{stream})
+}
+
+type ArduinoCoreService_ExportSketchServer interface {
+	Send(*ExportSketchResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceExportSketchServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceExportSketchServer) Send(m *ExportSketchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCoreService_Test_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TestRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).Test(m, &arduinoCoreServiceTestServer{stream})
+}
+
+type ArduinoCoreService_TestServer interface {
+	Send(*TestResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceTestServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceTestServer) Send(m *TestResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ArduinoCoreService_PlatformInstall_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(PlatformInstallRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1702,6 +2060,45 @@ func (x *arduinoCoreServiceUploadServer) Send(m *UploadResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ArduinoCoreService_MultiUpload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(MultiUploadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).MultiUpload(m, &arduinoCoreServiceMultiUploadServer{stream})
+}
+
+type ArduinoCoreService_MultiUploadServer interface {
+	Send(*MultiUploadResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceMultiUploadServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceMultiUploadServer) Send(m *MultiUploadResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCoreService_UploadPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).UploadPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.v1.ArduinoCoreService/UploadPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).UploadPlan(ctx, req.(*UploadPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArduinoCoreService_UploadUsingProgrammer_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(UploadUsingProgrammerRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1996,6 +2393,63 @@ func _ArduinoCoreService_LibraryList_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArduinoCoreService_LibraryResolve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LibraryResolveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).LibraryResolve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryResolve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).LibraryResolve(ctx, req.(*LibraryResolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCoreService_LibraryPrecompile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LibraryPrecompileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).LibraryPrecompile(m, &arduinoCoreServiceLibraryPrecompileServer{stream})
+}
+
+type ArduinoCoreService_LibraryPrecompileServer interface {
+	Send(*LibraryPrecompileResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceLibraryPrecompileServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceLibraryPrecompileServer) Send(m *LibraryPrecompileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCoreService_ImageMerge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageMergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).ImageMerge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.v1.ArduinoCoreService/ImageMerge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).ImageMerge(ctx, req.(*ImageMergeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArduinoCoreService_Monitor_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(ArduinoCoreServiceServer).Monitor(&arduinoCoreServiceMonitorServer{stream})
 }
@@ -2091,6 +2545,14 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BoardSearch",
 			Handler:    _ArduinoCoreService_BoardSearch_Handler,
 		},
+		{
+			MethodName: "MultiCompile",
+			Handler:    _ArduinoCoreService_MultiCompile_Handler,
+		},
+		{
+			MethodName: "UploadPlan",
+			Handler:    _ArduinoCoreService_UploadPlan_Handler,
+		},
 		{
 			MethodName: "SupportedUserFields",
 			Handler:    _ArduinoCoreService_SupportedUserFields_Handler,
@@ -2119,6 +2581,14 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LibraryList",
 			Handler:    _ArduinoCoreService_LibraryList_Handler,
 		},
+		{
+			MethodName: "LibraryResolve",
+			Handler:    _ArduinoCoreService_LibraryResolve_Handler,
+		},
+		{
+			MethodName: "ImageMerge",
+			Handler:    _ArduinoCoreService_ImageMerge_Handler,
+		},
 		{
 			MethodName: "EnumerateMonitorPortSettings",
 			Handler:    _ArduinoCoreService_EnumerateMonitorPortSettings_Handler,
@@ -2166,6 +2636,21 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ArduinoCoreService_Compile_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "CompileWatch",
+			Handler:       _ArduinoCoreService_CompileWatch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportSketch",
+			Handler:       _ArduinoCoreService_ExportSketch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Test",
+			Handler:       _ArduinoCoreService_Test_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "PlatformInstall",
 			Handler:       _ArduinoCoreService_PlatformInstall_Handler,
@@ -2191,6 +2676,11 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ArduinoCoreService_Upload_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "MultiUpload",
+			Handler:       _ArduinoCoreService_MultiUpload_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "UploadUsingProgrammer",
 			Handler:       _ArduinoCoreService_UploadUsingProgrammer_Handler,
@@ -2231,6 +2721,11 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ArduinoCoreService_LibraryUpgradeAll_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "LibraryPrecompile",
+			Handler:       _ArduinoCoreService_LibraryPrecompile_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Monitor",
 			Handler:       _ArduinoCoreService_Monitor_Handler,